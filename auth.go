@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/config"
+	"github.com/zachdeibert/canvas-sync/credentials"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// passphraseEnv is the environment variable the passphrase of the encrypted credentials file is read from, instead of
+// asking for it
+const passphraseEnv = credentials.EnvPrefix + "PASSPHRASE"
+
+// oauthCredentials are saved after logging in with OAuth2, so the access token can be refreshed in later runs
+type oauthCredentials struct {
+	Config canvas.OAuthConfig `json:"config"`
+	Token  canvas.OAuthToken  `json:"token"`
+}
+
+// defaultStore gets where authentication tokens are kept when no store is chosen, which is the keyring if there is one,
+// or else the encrypted file.  Tokens are never saved unencrypted unless that is chosen.
+func defaultStore() string {
+	if (credentials.SecretTool{Command: "secret-tool"}).Available() {
+		return config.KeyringCredentials
+	}
+	return config.EncryptedCredentials
+}
+
+// createStore creates the store that authentication tokens are kept in.  Tokens in environment variables are always
+// used first.  Tokens that were saved in plain files before there were other stores are still used, but new ones are
+// only saved there if that store is chosen.
+func createStore(store string, filename string) credentials.Store {
+	if len(store) == 0 {
+		store = defaultStore()
+	}
+	plain := credentials.Plain{
+		Dir: ".",
+	}
+	switch store {
+	case config.PlainCredentials:
+		return credentials.Chain{
+			credentials.Env{},
+			plain,
+		}
+	case config.KeyringCredentials:
+		return credentials.Chain{
+			credentials.Env{},
+			credentials.CreateKeyringStore(),
+			credentials.Legacy{Store: plain},
+		}
+	default:
+		return credentials.Chain{
+			credentials.Env{},
+			credentials.CreateEncrypted(filename, readPassphrase),
+			credentials.Legacy{Store: plain},
+		}
+	}
+}
+
+// readSecret reads a line from the terminal without echoing it, or from standard input if it is not a terminal
+func readSecret(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && len(line) == 0 {
+			return nil, err
+		}
+		return []byte(strings.TrimSpace(line)), nil
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	secret, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	return secret, err
+}
+
+// readPassphrase gets the passphrase of the encrypted credentials file
+func readPassphrase(create bool) ([]byte, error) {
+	if p := os.Getenv(passphraseEnv); len(p) > 0 {
+		return []byte(p), nil
+	}
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, fmt.Errorf("the credentials file is encrypted but %s is not set", passphraseEnv)
+	}
+	if !create {
+		return readSecret("Passphrase for the credentials file: ")
+	}
+	p, err := readSecret("New passphrase for the credentials file: ")
+	if err != nil {
+		return nil, err
+	}
+	if len(p) == 0 {
+		return nil, errors.New("the passphrase cannot be empty")
+	}
+	confirm, err := readSecret("Repeat the passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(p, confirm) {
+		return nil, errors.New("the passphrases do not match")
+	}
+	return p, nil
+}
+
+// oauthConfig gets the developer key to log in with, preferring the command line over the configuration file
+func (o *options) oauthConfig(a config.Account) canvas.OAuthConfig {
+	c := canvas.OAuthConfig{
+		ClientID:     a.OAuth.ClientID,
+		ClientSecret: a.OAuth.ClientSecret,
+		RedirectURL:  a.OAuth.RedirectURL,
+	}
+	if len(o.clientID) > 0 {
+		c.ClientID = o.clientID
+	}
+	if len(o.clientSecret) > 0 {
+		c.ClientSecret = o.clientSecret
+	}
+	if len(o.redirectURL) > 0 {
+		c.RedirectURL = o.redirectURL
+	}
+	return c
+}
+
+// saveOAuth saves the OAuth2 credentials of an account in the credential store
+func (o *options) saveOAuth(name string, creds oauthCredentials) error {
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	return o.store.Set(name, credentials.OAuth, string(data))
+}
+
+// loadCredentials gets the personal access token or the OAuth2 credentials of an account.  A token given on the command
+// line the old way is used first, then a token file given on the command line or in the configuration file, then a
+// token in an environment variable, then OAuth2 credentials, and finally a token in the credential store.
+func (o *options) loadCredentials(a config.Account, name string) (string, *oauthCredentials, error) {
+	if len(o.token) > 0 {
+		return o.token, nil, nil
+	}
+	if len(a.TokenFile) > 0 {
+		token, err := o.readToken(a.TokenFile)
+		return token, nil, err
+	}
+	if token, err := (credentials.Env{}).Get(name, credentials.Token); err == nil {
+		return token, nil, nil
+	}
+	data, err := o.store.Get(name, credentials.OAuth)
+	if err == nil {
+		creds := &oauthCredentials{}
+		if err = json.Unmarshal([]byte(data), creds); err != nil {
+			return "", nil, fmt.Errorf("invalid OAuth2 credentials for '%s': %v", name, err)
+		}
+		return "", creds, nil
+	} else if err != credentials.ErrNotFound {
+		return "", nil, err
+	}
+	token, err := o.store.Get(name, credentials.Token)
+	if err == credentials.ErrNotFound || (err == nil && len(token) == 0) {
+		if o.offline {
+			return "", nil, nil
+		}
+		return "", nil, fmt.Errorf("no authentication token for '%s' (run '%s login' or set %s)", name, os.Args[0], credentials.EnvName(name, credentials.Token))
+	}
+	return token, nil, err
+}
+
+// readToken reads the personal access token of an account from a token file
+func (o *options) readToken(tokenFile string) (string, error) {
+	b, err := ioutil.ReadFile(tokenFile)
+	if err == nil {
+		token := strings.TrimSpace(string(b))
+		if len(token) == 0 && !o.offline {
+			return "", fmt.Errorf("authentication token file '%s' is empty", tokenFile)
+		}
+		return token, nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	} else if !o.offline {
+		return "", fmt.Errorf("no authentication token specified but file '%s' does not exist", tokenFile)
+	}
+	return "", nil
+}
+
+// openBrowser tries to open a URL in the default browser
+func openBrowser(u string) error {
+	switch runtime.GOOS {
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", u).Start()
+	case "darwin":
+		return exec.Command("open", u).Start()
+	default:
+		return exec.Command("xdg-open", u).Start()
+	}
+}
@@ -26,3 +26,31 @@ type DiscussionTopicFullView struct {
 	ForcedEntries []int                          `json:"forced_entries"`
 	View          []DiscussionTopicFullViewEntry `json:"view"`
 }
+
+// QuizSubmissionsResponse object
+type QuizSubmissionsResponse struct {
+	QuizSubmissions []QuizSubmission `json:"quiz_submissions"`
+	Quizzes         []Quiz           `json:"quizzes"`
+	Submissions     []Submission     `json:"submissions"`
+	Users           []User           `json:"users"`
+}
+
+// ConversationMessage object
+type ConversationMessage struct {
+	ID                   int                   `json:"id"`
+	CreatedAt            time.Time             `json:"created_at"`
+	Body                 string                `json:"body"`
+	AuthorID             int                   `json:"author_id"`
+	Generated            bool                  `json:"generated"`
+	MediaComment         *MediaComment         `json:"media_comment"`
+	ForwardedMessages    []ConversationMessage `json:"forwarded_messages"`
+	Attachments          []FileAttachment      `json:"attachments"`
+	ParticipatingUserIDs []int                 `json:"participating_user_ids"`
+}
+
+// SubmissionRubricAssessment object
+type SubmissionRubricAssessment struct {
+	RatingID string  `json:"rating_id"`
+	Points   float64 `json:"points"`
+	Comments string  `json:"comments"`
+}
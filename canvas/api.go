@@ -1,6 +1,7 @@
 package canvas
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -561,6 +562,56 @@ const (
 	ProgressWorkflowStateFailed ProgressWorkflowState = "failed"
 )
 
+// QuizSubmissionWorkflowState enumeration
+type QuizSubmissionWorkflowState string
+
+const (
+	// QuizSubmissionWorkflowStateUntaken enum value ("untaken")
+	QuizSubmissionWorkflowStateUntaken QuizSubmissionWorkflowState = "untaken"
+	// QuizSubmissionWorkflowStatePendingReview enum value ("pending_review")
+	QuizSubmissionWorkflowStatePendingReview QuizSubmissionWorkflowState = "pending_review"
+	// QuizSubmissionWorkflowStateComplete enum value ("complete")
+	QuizSubmissionWorkflowStateComplete QuizSubmissionWorkflowState = "complete"
+	// QuizSubmissionWorkflowStateSettingsOnly enum value ("settings_only")
+	QuizSubmissionWorkflowStateSettingsOnly QuizSubmissionWorkflowState = "settings_only"
+	// QuizSubmissionWorkflowStatePreview enum value ("preview")
+	QuizSubmissionWorkflowStatePreview QuizSubmissionWorkflowState = "preview"
+)
+
+// QuizHideResults enumeration
+type QuizHideResults string
+
+const (
+	// QuizHideResultsAlways enum value ("always")
+	QuizHideResultsAlways QuizHideResults = "always"
+	// QuizHideResultsUntilAfterLastAttempt enum value ("until_after_last_attempt")
+	QuizHideResultsUntilAfterLastAttempt QuizHideResults = "until_after_last_attempt"
+)
+
+// QuizQuizType enumeration
+type QuizQuizType string
+
+const (
+	// QuizQuizTypePracticeQuiz enum value ("practice_quiz")
+	QuizQuizTypePracticeQuiz QuizQuizType = "practice_quiz"
+	// QuizQuizTypeAssignment enum value ("assignment")
+	QuizQuizTypeAssignment QuizQuizType = "assignment"
+	// QuizQuizTypeGradedSurvey enum value ("graded_survey")
+	QuizQuizTypeGradedSurvey QuizQuizType = "graded_survey"
+	// QuizQuizTypeSurvey enum value ("survey")
+	QuizQuizTypeSurvey QuizQuizType = "survey"
+)
+
+// QuizScoringPolicy enumeration
+type QuizScoringPolicy string
+
+const (
+	// QuizScoringPolicyKeepHighest enum value ("keep_highest")
+	QuizScoringPolicyKeepHighest QuizScoringPolicy = "keep_highest"
+	// QuizScoringPolicyKeepLatest enum value ("keep_latest")
+	QuizScoringPolicyKeepLatest QuizScoringPolicy = "keep_latest"
+)
+
 // SisAssignmentSubmissionTypes enumeration
 type SisAssignmentSubmissionTypes string
 
@@ -1179,6 +1230,8 @@ const (
 	ConversationsListConversationsScopeStarred ConversationsListConversationsScope = "starred"
 	// ConversationsListConversationsScopeArchived enum value ("archived")
 	ConversationsListConversationsScopeArchived ConversationsListConversationsScope = "archived"
+	// ConversationsListConversationsScopeSent enum value ("sent")
+	ConversationsListConversationsScopeSent ConversationsListConversationsScope = "sent"
 )
 
 // ConversationsListConversationsFilter enumeration
@@ -2237,6 +2290,42 @@ const (
 	PlannerCreateAPlannerOverridePlannableTypePlannerNote PlannerCreateAPlannerOverridePlannableType = "planner_note"
 )
 
+// QuizSubmissionsGetAllQuizSubmissionsInclude enumeration
+type QuizSubmissionsGetAllQuizSubmissionsInclude string
+
+const (
+	// QuizSubmissionsGetAllQuizSubmissionsIncludeSubmission enum value ("submission")
+	QuizSubmissionsGetAllQuizSubmissionsIncludeSubmission QuizSubmissionsGetAllQuizSubmissionsInclude = "submission"
+	// QuizSubmissionsGetAllQuizSubmissionsIncludeQuiz enum value ("quiz")
+	QuizSubmissionsGetAllQuizSubmissionsIncludeQuiz QuizSubmissionsGetAllQuizSubmissionsInclude = "quiz"
+	// QuizSubmissionsGetAllQuizSubmissionsIncludeUser enum value ("user")
+	QuizSubmissionsGetAllQuizSubmissionsIncludeUser QuizSubmissionsGetAllQuizSubmissionsInclude = "user"
+)
+
+// QuizSubmissionsGetTheQuizSubmissionInclude enumeration
+type QuizSubmissionsGetTheQuizSubmissionInclude string
+
+const (
+	// QuizSubmissionsGetTheQuizSubmissionIncludeSubmission enum value ("submission")
+	QuizSubmissionsGetTheQuizSubmissionIncludeSubmission QuizSubmissionsGetTheQuizSubmissionInclude = "submission"
+	// QuizSubmissionsGetTheQuizSubmissionIncludeQuiz enum value ("quiz")
+	QuizSubmissionsGetTheQuizSubmissionIncludeQuiz QuizSubmissionsGetTheQuizSubmissionInclude = "quiz"
+	// QuizSubmissionsGetTheQuizSubmissionIncludeUser enum value ("user")
+	QuizSubmissionsGetTheQuizSubmissionIncludeUser QuizSubmissionsGetTheQuizSubmissionInclude = "user"
+)
+
+// QuizSubmissionsGetASingleQuizSubmissionInclude enumeration
+type QuizSubmissionsGetASingleQuizSubmissionInclude string
+
+const (
+	// QuizSubmissionsGetASingleQuizSubmissionIncludeSubmission enum value ("submission")
+	QuizSubmissionsGetASingleQuizSubmissionIncludeSubmission QuizSubmissionsGetASingleQuizSubmissionInclude = "submission"
+	// QuizSubmissionsGetASingleQuizSubmissionIncludeQuiz enum value ("quiz")
+	QuizSubmissionsGetASingleQuizSubmissionIncludeQuiz QuizSubmissionsGetASingleQuizSubmissionInclude = "quiz"
+	// QuizSubmissionsGetASingleQuizSubmissionIncludeUser enum value ("user")
+	QuizSubmissionsGetASingleQuizSubmissionIncludeUser QuizSubmissionsGetASingleQuizSubmissionInclude = "user"
+)
+
 // RolesListRolesState enumeration
 type RolesListRolesState string
 
@@ -3411,7 +3500,7 @@ type CalendarEvent struct {
 	// AllDay field: Boolean indicating whether this is an all-day event (midnight to midnight)
 	AllDay bool `json:"all_day"`
 	// AllDayDate field: The date of this event
-	AllDayDate time.Time `json:"all_day_date"`
+	AllDayDate string `json:"all_day_date"`
 	// AppointmentGroupID field: Various Appointment-Group-related fields.These fields are only pertinent to time slots
 	// (appointments) and reservations of those time slots. See the Appointment Groups API. The id of the appointment
 	// group
@@ -3918,6 +4007,10 @@ type Conversation struct {
 	Visible bool `json:"visible"`
 	// WorkflowState field: The current state of the conversation (read, unread or archived).
 	WorkflowState string `json:"workflow_state"`
+	// LastMessageAt field
+	LastMessageAt time.Time `json:"last_message_at"`
+	// Messages field
+	Messages []ConversationMessage `json:"messages"`
 }
 
 // ConversationParticipant model object
@@ -5476,6 +5569,162 @@ type ProvisionalGrade struct {
 	SpeedgraderURL string `json:"speedgrader_url"`
 }
 
+// QuizSubmission model object
+type QuizSubmission struct {
+	// Attempt field: For quizzes that allow multiple attempts, this field specifies the quiz submission attempt number.
+	Attempt int `json:"attempt"`
+	// EndAt field: The time at which the quiz submission will be overdue, and be flagged as a late submission.
+	EndAt time.Time `json:"end_at"`
+	// ExtraAttempts field: Number of times the student was allowed to re-take the quiz over the multiple-attempt limit.
+	ExtraAttempts int `json:"extra_attempts"`
+	// ExtraTime field: Amount of extra time allowed for the quiz submission, in minutes.
+	ExtraTime int `json:"extra_time"`
+	// FinishedAt field: The time at which the student submitted the quiz submission.
+	FinishedAt time.Time `json:"finished_at"`
+	// FudgePoints field: Number of points the quiz submission's score was fudged by.
+	FudgePoints float64 `json:"fudge_points"`
+	// HasSeenResults field: Whether the student has viewed their results to the quiz.
+	HasSeenResults bool `json:"has_seen_results"`
+	// ID field: The ID of the quiz submission.
+	ID int `json:"id"`
+	// KeptScore field: For quizzes that allow multiple attempts, this is the score that will be used, which might be
+	// the score of the latest, or the highest, quiz submission.
+	KeptScore float64 `json:"kept_score"`
+	// ManuallyUnlocked field: The student can take the quiz even if it's locked for everyone else
+	ManuallyUnlocked bool `json:"manually_unlocked"`
+	// OverdueAndNeedsSubmission field: Indicates whether the quiz submission is overdue and needs submission
+	OverdueAndNeedsSubmission bool `json:"overdue_and_needs_submission"`
+	// QuizID field: The ID of the Quiz the quiz submission belongs to.
+	QuizID int `json:"quiz_id"`
+	// Score field: The score of the quiz submission, if graded.
+	Score float64 `json:"score"`
+	// ScoreBeforeRegrade field: The original score of the quiz submission prior to any re-grading.
+	ScoreBeforeRegrade float64 `json:"score_before_regrade"`
+	// StartedAt field: The time at which the student started the quiz submission.
+	StartedAt time.Time `json:"started_at"`
+	// SubmissionID field: The ID of the Submission the quiz submission represents.
+	SubmissionID int `json:"submission_id"`
+	// TimeSpent field: Amount of time spent, in seconds.
+	TimeSpent int `json:"time_spent"`
+	// UserID field: The ID of the Student that made the quiz submission.
+	UserID int `json:"user_id"`
+	// WorkflowState field: The current state of the quiz submission. Possible values:
+	// ['untaken'|'pending_review'|'complete'|'settings_only'|'preview'].
+	WorkflowState *QuizSubmissionWorkflowState `json:"workflow_state"`
+}
+
+// Quiz model object
+type Quiz struct {
+	// AccessCode field: access code to restrict quiz access
+	AccessCode string `json:"access_code"`
+	// AllDates field: list of due dates for the quiz
+	AllDates []AssignmentDate `json:"all_dates"`
+	// AllowedAttempts field: how many times a student can take the quiz -1 = unlimited attempts
+	AllowedAttempts int `json:"allowed_attempts"`
+	// AnonymousSubmissions field: Whether survey submissions will be kept anonymous (only applicable to
+	// 'graded_survey', 'survey' quiz types)
+	AnonymousSubmissions bool `json:"anonymous_submissions"`
+	// AssignmentGroupID field: the ID of the quiz's assignment group:
+	AssignmentGroupID int `json:"assignment_group_id"`
+	// CantGoBack field: lock questions after answering? only valid if one_question_at_a_time=true
+	CantGoBack bool `json:"cant_go_back"`
+	// Description field: the description of the quiz
+	Description string `json:"description"`
+	// DueAt field: when the quiz is due
+	DueAt time.Time `json:"due_at"`
+	// HideCorrectAnswersAt field: prevent the students from seeing correct answers after the specified date has passed.
+	// only valid if show_correct_answers=true
+	HideCorrectAnswersAt time.Time `json:"hide_correct_answers_at"`
+	// HideResults field: let students see their quiz responses? possible values: null, 'always',
+	// 'until_after_last_attempt'
+	HideResults *QuizHideResults `json:"hide_results"`
+	// HTMLURL field: the HTTP/HTTPS URL to the quiz
+	HTMLURL string `json:"html_url"`
+	// ID field: the ID of the quiz
+	ID int `json:"id"`
+	// IPFilter field: IP address or range that quiz access is limited to
+	IPFilter string `json:"ip_filter"`
+	// LockAt field: when to lock the quiz
+	LockAt time.Time `json:"lock_at"`
+	// LockExplanation field: (Optional) An explanation of why this is locked for the user. Present when locked_for_user
+	// is true.
+	LockExplanation string `json:"lock_explanation"`
+	// LockInfo field: (Optional) Information for the user about the lock. Present when locked_for_user is true.
+	LockInfo *LockInfo `json:"lock_info"`
+	// LockedForUser field: Whether or not this is locked for the user.
+	LockedForUser bool `json:"locked_for_user"`
+	// MobileURL field: a url suitable for loading the quiz in a mobile webview.  it will persiste the headless session
+	// and, for quizzes in public courses, will force the user to login
+	MobileURL string `json:"mobile_url"`
+	// OneQuestionAtATime field: show one question at a time?
+	OneQuestionAtATime bool `json:"one_question_at_a_time"`
+	// OneTimeResults field: prevent the students from seeing their results more than once (right after they submit the
+	// quiz)
+	OneTimeResults bool `json:"one_time_results"`
+	// Permissions field: Permissions the user has for the quiz
+	Permissions *QuizPermissions `json:"permissions"`
+	// PointsPossible field: The total point value given to the quiz
+	PointsPossible float64 `json:"points_possible"`
+	// PreviewURL field: A url that can be visited in the browser with a POST request to preview a quiz as the teacher.
+	// Only present when the user may grade
+	PreviewURL string `json:"preview_url"`
+	// Published field: whether the quiz has a published or unpublished draft state.
+	Published bool `json:"published"`
+	// QuestionCount field: the number of questions in the quiz
+	QuestionCount int `json:"question_count"`
+	// QuestionTypes field: List of question types in the quiz
+	QuestionTypes []string `json:"question_types"`
+	// QuizExtensionsURL field: Link to endpoint to send extensions for this quiz.
+	QuizExtensionsURL string `json:"quiz_extensions_url"`
+	// QuizType field: type of quiz possible values: 'practice_quiz', 'assignment', 'graded_survey', 'survey'
+	QuizType *QuizQuizType `json:"quiz_type"`
+	// ScoringPolicy field: which quiz score to keep (only if allowed_attempts != 1) possible values: 'keep_highest',
+	// 'keep_latest'
+	ScoringPolicy *QuizScoringPolicy `json:"scoring_policy"`
+	// ShowCorrectAnswers field: show which answers were correct when results are shown? only valid if hide_results=null
+	ShowCorrectAnswers bool `json:"show_correct_answers"`
+	// ShowCorrectAnswersAt field: when should the correct answers be visible by students? only valid if
+	// show_correct_answers=true
+	ShowCorrectAnswersAt time.Time `json:"show_correct_answers_at"`
+	// ShowCorrectAnswersLastAttempt field: restrict the show_correct_answers option above to apply only to the last
+	// submitted attempt of a quiz that allows multiple attempts. only valid if show_correct_answers=true and
+	// allowed_attempts > 1
+	ShowCorrectAnswersLastAttempt bool `json:"show_correct_answers_last_attempt"`
+	// ShuffleAnswers field: shuffle answers for students?
+	ShuffleAnswers bool `json:"shuffle_answers"`
+	// SpeedgraderURL field: Link to SpeedGrader for this quiz. Will not be present if quiz is unpublished
+	SpeedgraderURL string `json:"speedgrader_url"`
+	// TimeLimit field: quiz time limit in minutes
+	TimeLimit int `json:"time_limit"`
+	// Title field: the title of the quiz
+	Title string `json:"title"`
+	// UnlockAt field: when to unlock the quiz
+	UnlockAt time.Time `json:"unlock_at"`
+	// Unpublishable field: Whether the assignment's 'published' state can be changed to false. Will be false if there
+	// are student submissions for the quiz.
+	Unpublishable bool `json:"unpublishable"`
+	// VersionNumber field: Current version number of the quiz
+	VersionNumber int `json:"version_number"`
+}
+
+// QuizPermissions model object: Permissions the user has for the quiz
+type QuizPermissions struct {
+	// Create field: whether the user may create a new quiz
+	Create bool `json:"create"`
+	// Manage field: whether the user may edit, update, or delete the quiz
+	Manage bool `json:"manage"`
+	// Read field: whether the user can view the quiz
+	Read bool `json:"read"`
+	// ReadStatistics field: whether the user may view quiz statistics for this quiz
+	ReadStatistics bool `json:"read_statistics"`
+	// ReviewGrades field: whether the user may review grades for all quiz submissions for this quiz
+	ReviewGrades bool `json:"review_grades"`
+	// Submit field: whether the user may submit a submission for the quiz
+	Submit bool `json:"submit"`
+	// Update field: whether the user may update the quiz
+	Update bool `json:"update"`
+}
+
 // RolePermissions model object
 type RolePermissions struct {
 	// AppliesToDescendants field: Whether the permission cascades down to sub accounts of the account this role is in.
@@ -6074,6 +6323,8 @@ type Submission struct {
 	WorkflowState *SubmissionWorkflowState `json:"workflow_state"`
 	// Attachments field
 	Attachments []FileAttachment `json:"attachments"`
+	// RubricAssessment field
+	RubricAssessment map[string]SubmissionRubricAssessment `json:"rubric_assessment"`
 }
 
 // MediaComment model object
@@ -6311,7 +6562,7 @@ type PageRevision struct {
 
 // AccountNotificationsIndexOfActiveGlobalNotificationForTheUser API call: Returns a list of all global notifications in
 // the account for the current user Any notifications that have been closed by the user will not be returned
-func (c *Canvas) AccountNotificationsIndexOfActiveGlobalNotificationForTheUser(progress *task.Progress) ([]AccountNotification, error) {
+func (c *Canvas) AccountNotificationsIndexOfActiveGlobalNotificationForTheUser(ctx context.Context, progress *task.Progress) ([]AccountNotification, error) {
 	endpoint := fmt.Sprintf("accounts/2/users/self/account_notifications")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6323,7 +6574,7 @@ func (c *Canvas) AccountNotificationsIndexOfActiveGlobalNotificationForTheUser(p
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6331,7 +6582,7 @@ func (c *Canvas) AccountNotificationsIndexOfActiveGlobalNotificationForTheUser(p
 
 // AccountNotificationsShowAGlobalNotification API call: Returns a global notification for the current user A
 // notification that has been closed by the user will not be returned
-func (c *Canvas) AccountNotificationsShowAGlobalNotification(progress *task.Progress) (*AccountNotification, error) {
+func (c *Canvas) AccountNotificationsShowAGlobalNotification(ctx context.Context, progress *task.Progress) (*AccountNotification, error) {
 	endpoint := fmt.Sprintf("accounts/2/users/self/account_notifications/4")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6342,7 +6593,7 @@ func (c *Canvas) AccountNotificationsShowAGlobalNotification(progress *task.Prog
 		res = obj.(*AccountNotification)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6350,7 +6601,7 @@ func (c *Canvas) AccountNotificationsShowAGlobalNotification(progress *task.Prog
 
 // AccountNotificationsCloseNotificationForUser API call: If the current user no long wants to see this notification it
 // can be excused with this call
-func (c *Canvas) AccountNotificationsCloseNotificationForUser(progress *task.Progress) (*AccountNotification, error) {
+func (c *Canvas) AccountNotificationsCloseNotificationForUser(ctx context.Context, progress *task.Progress) (*AccountNotification, error) {
 	endpoint := fmt.Sprintf("accounts/2/users/self/account_notifications/4")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6361,14 +6612,14 @@ func (c *Canvas) AccountNotificationsCloseNotificationForUser(progress *task.Pro
 		res = obj.(*AccountNotification)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountNotificationsCreateAGlobalNotification API call: Create and return a new global notification for an account.
-func (c *Canvas) AccountNotificationsCreateAGlobalNotification(progress *task.Progress, accountNotification *string, accountNotificationRoles *string) (*map[string]interface{}, error) {
+func (c *Canvas) AccountNotificationsCreateAGlobalNotification(ctx context.Context, progress *task.Progress, accountNotification *string, accountNotificationRoles *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/2/account_notifications")
 	params := map[string]interface{}{}
 	if accountNotification != nil {
@@ -6385,14 +6636,14 @@ func (c *Canvas) AccountNotificationsCreateAGlobalNotification(progress *task.Pr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountNotificationsUpdateAGlobalNotification API call: Update global notification for an account.
-func (c *Canvas) AccountNotificationsUpdateAGlobalNotification(progress *task.Progress, accountNotification *string, accountNotificationRoles *string) (*map[string]interface{}, error) {
+func (c *Canvas) AccountNotificationsUpdateAGlobalNotification(ctx context.Context, progress *task.Progress, accountNotification *string, accountNotificationRoles *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/2/account_notifications/1")
 	params := map[string]interface{}{}
 	if accountNotification != nil {
@@ -6409,14 +6660,14 @@ func (c *Canvas) AccountNotificationsUpdateAGlobalNotification(progress *task.Pr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountReportsListAvailableReports API call: Returns a paginated list of reports for the current context.
-func (c *Canvas) AccountReportsListAvailableReports(progress *task.Progress, accountID string) (*map[string]interface{}, error) {
+func (c *Canvas) AccountReportsListAvailableReports(ctx context.Context, progress *task.Progress, accountID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/%s/reports/", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6427,7 +6678,7 @@ func (c *Canvas) AccountReportsListAvailableReports(progress *task.Progress, acc
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6437,7 +6688,7 @@ func (c *Canvas) AccountReportsListAvailableReports(progress *task.Progress, acc
 // must match one of the available report names. To fetch a list of available report names and parameters for each
 // report (including whether or not those parameters are required), see {api:AccountReportsController#available_reports
 // List Available Reports}.
-func (c *Canvas) AccountReportsStartAReport(progress *task.Progress, parameters *interface{}) (*Report, error) {
+func (c *Canvas) AccountReportsStartAReport(ctx context.Context, progress *task.Progress, parameters *interface{}) (*Report, error) {
 	endpoint := fmt.Sprintf("accounts/1/reports/provisioning_csv")
 	params := map[string]interface{}{}
 	if parameters != nil {
@@ -6451,14 +6702,14 @@ func (c *Canvas) AccountReportsStartAReport(progress *task.Progress, parameters
 		res = obj.(*Report)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountReportsIndexOfReports API call: Shows all reports that have been run for the account of a specific type.
-func (c *Canvas) AccountReportsIndexOfReports(progress *task.Progress, accountID string, reportType string) ([]Report, error) {
+func (c *Canvas) AccountReportsIndexOfReports(ctx context.Context, progress *task.Progress, accountID string, reportType string) ([]Report, error) {
 	endpoint := fmt.Sprintf("accounts/%s/reports/%s", accountID, reportType)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6470,14 +6721,14 @@ func (c *Canvas) AccountReportsIndexOfReports(progress *task.Progress, accountID
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountReportsStatusOfAReport API call: Returns the status of a report.
-func (c *Canvas) AccountReportsStatusOfAReport(progress *task.Progress, accountID string, reportType string, reportID string) (*Report, error) {
+func (c *Canvas) AccountReportsStatusOfAReport(ctx context.Context, progress *task.Progress, accountID string, reportType string, reportID string) (*Report, error) {
 	endpoint := fmt.Sprintf("accounts/%s/reports/%s/%s", accountID, reportType, reportID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6488,14 +6739,14 @@ func (c *Canvas) AccountReportsStatusOfAReport(progress *task.Progress, accountI
 		res = obj.(*Report)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountReportsDeleteAReport API call: Deletes a generated report instance.
-func (c *Canvas) AccountReportsDeleteAReport(progress *task.Progress, accountID string, reportType string, id string) (*Report, error) {
+func (c *Canvas) AccountReportsDeleteAReport(ctx context.Context, progress *task.Progress, accountID string, reportType string, id string) (*Report, error) {
 	endpoint := fmt.Sprintf("accounts/%s/reports/%s/%s", accountID, reportType, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6506,7 +6757,7 @@ func (c *Canvas) AccountReportsDeleteAReport(progress *task.Progress, accountID
 		res = obj.(*Report)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6515,7 +6766,7 @@ func (c *Canvas) AccountReportsDeleteAReport(progress *task.Progress, accountID
 // AccountsListAccounts API call: A paginated list of accounts that the current user can view or manage. Typically,
 // students and even teachers will get an empty list in response, only account admins can view the accounts that they
 // are in.
-func (c *Canvas) AccountsListAccounts(progress *task.Progress, include *AccountsListAccountsInclude) ([]Account, error) {
+func (c *Canvas) AccountsListAccounts(ctx context.Context, progress *task.Progress, include *AccountsListAccountsInclude) ([]Account, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -6530,7 +6781,7 @@ func (c *Canvas) AccountsListAccounts(progress *task.Progress, include *Accounts
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6539,7 +6790,7 @@ func (c *Canvas) AccountsListAccounts(progress *task.Progress, include *Accounts
 // AccountsListAccountsForCourseAdmins API call: A paginated list of accounts that the current user can view through
 // their admin course enrollments. (Teacher, TA, or designer enrollments). Only returns "id", "name", "workflow_state",
 // "root_account_id" and "parent_account_id"
-func (c *Canvas) AccountsListAccountsForCourseAdmins(progress *task.Progress) ([]Account, error) {
+func (c *Canvas) AccountsListAccountsForCourseAdmins(ctx context.Context, progress *task.Progress) ([]Account, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6551,14 +6802,14 @@ func (c *Canvas) AccountsListAccountsForCourseAdmins(progress *task.Progress) ([
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsGetASingleAccount API call: Retrieve information on an individual account, given by id or sis sis_account_id.
-func (c *Canvas) AccountsGetASingleAccount(progress *task.Progress) (*Account, error) {
+func (c *Canvas) AccountsGetASingleAccount(ctx context.Context, progress *task.Progress) (*Account, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6569,7 +6820,7 @@ func (c *Canvas) AccountsGetASingleAccount(progress *task.Progress) (*Account, e
 		res = obj.(*Account)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6579,7 +6830,7 @@ func (c *Canvas) AccountsGetASingleAccount(progress *task.Progress) (*Account, e
 // `self` as the account id to check permissions against the domain root account. The caller must have an account role
 // or admin (teacher/TA/designer) enrollment in a course in the account. See also the {api:CoursesController#permissions
 // Course} and {api:GroupsController#permissions Group} counterparts.
-func (c *Canvas) AccountsPermissions(progress *task.Progress, permissions *string) (*map[string]interface{}, error) {
+func (c *Canvas) AccountsPermissions(ctx context.Context, progress *task.Progress, permissions *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/self/permissions")
 	params := map[string]interface{}{}
 	if permissions != nil {
@@ -6593,14 +6844,14 @@ func (c *Canvas) AccountsPermissions(progress *task.Progress, permissions *strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsGetTheSubAccountsOfAnAccount API call: List accounts that are sub-accounts of the given account.
-func (c *Canvas) AccountsGetTheSubAccountsOfAnAccount(progress *task.Progress, recursive *interface{}, accountID string) ([]Account, error) {
+func (c *Canvas) AccountsGetTheSubAccountsOfAnAccount(ctx context.Context, progress *task.Progress, recursive *interface{}, accountID string) ([]Account, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sub_accounts", accountID)
 	params := map[string]interface{}{}
 	if recursive != nil {
@@ -6615,14 +6866,14 @@ func (c *Canvas) AccountsGetTheSubAccountsOfAnAccount(progress *task.Progress, r
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsGetTheTermsOfService API call: Returns the terms of service for that account
-func (c *Canvas) AccountsGetTheTermsOfService(progress *task.Progress) (*TermsOfService, error) {
+func (c *Canvas) AccountsGetTheTermsOfService(ctx context.Context, progress *task.Progress) (*TermsOfService, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6633,14 +6884,14 @@ func (c *Canvas) AccountsGetTheTermsOfService(progress *task.Progress) (*TermsOf
 		res = obj.(*TermsOfService)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsGetHelpLinks API call: Returns the help links for that account
-func (c *Canvas) AccountsGetHelpLinks(progress *task.Progress) (*HelpLinks, error) {
+func (c *Canvas) AccountsGetHelpLinks(ctx context.Context, progress *task.Progress) (*HelpLinks, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6651,14 +6902,14 @@ func (c *Canvas) AccountsGetHelpLinks(progress *task.Progress) (*HelpLinks, erro
 		res = obj.(*HelpLinks)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsListActiveCoursesInAnAccount API call: Retrieve a paginated list of courses in this account.
-func (c *Canvas) AccountsListActiveCoursesInAnAccount(progress *task.Progress, withEnrollments *bool, enrollmentType *AccountsListActiveCoursesInAnAccountEnrollmentType, published *bool, completed *bool, blueprint *bool, blueprintAssociated *bool, byTeachers *int, bySubaccounts *int, hideEnrollmentlessCourses *bool, state *AccountsListActiveCoursesInAnAccountState, enrollmentTermID *int, searchTerm *string, include *AccountsListActiveCoursesInAnAccountInclude, sort *AccountsListActiveCoursesInAnAccountSort, order *AccountsListActiveCoursesInAnAccountOrder, searchBy *AccountsListActiveCoursesInAnAccountSearchBy, startsBefore *time.Time, endsAfter *time.Time) ([]Course, error) {
+func (c *Canvas) AccountsListActiveCoursesInAnAccount(ctx context.Context, progress *task.Progress, withEnrollments *bool, enrollmentType *AccountsListActiveCoursesInAnAccountEnrollmentType, published *bool, completed *bool, blueprint *bool, blueprintAssociated *bool, byTeachers *int, bySubaccounts *int, hideEnrollmentlessCourses *bool, state *AccountsListActiveCoursesInAnAccountState, enrollmentTermID *int, searchTerm *string, include *AccountsListActiveCoursesInAnAccountInclude, sort *AccountsListActiveCoursesInAnAccountSort, order *AccountsListActiveCoursesInAnAccountOrder, searchBy *AccountsListActiveCoursesInAnAccountSearchBy, startsBefore *time.Time, endsAfter *time.Time) ([]Course, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if withEnrollments != nil {
@@ -6724,14 +6975,14 @@ func (c *Canvas) AccountsListActiveCoursesInAnAccount(progress *task.Progress, w
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsUpdateAnAccount API call: Update an existing account.
-func (c *Canvas) AccountsUpdateAnAccount(progress *task.Progress, account *string, accountID string) (*Account, error) {
+func (c *Canvas) AccountsUpdateAnAccount(ctx context.Context, progress *task.Progress, account *string, accountID string) (*Account, error) {
 	endpoint := fmt.Sprintf("accounts/%s", accountID)
 	params := map[string]interface{}{}
 	if account != nil {
@@ -6745,7 +6996,7 @@ func (c *Canvas) AccountsUpdateAnAccount(progress *task.Progress, account *strin
 		res = obj.(*Account)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6755,7 +7006,7 @@ func (c *Canvas) AccountsUpdateAnAccount(progress *task.Progress, account *strin
 // associated with multiple root accounts (in a multi-tenant instance of Canvas), this action will NOT remove them from
 // the other accounts. WARNING: This API will allow a user to remove themselves from the account. If they do this, they
 // won't be able to make API calls or log into Canvas at that account.
-func (c *Canvas) AccountsDeleteAUserFromTheRootAccount(progress *task.Progress) (*User, error) {
+func (c *Canvas) AccountsDeleteAUserFromTheRootAccount(ctx context.Context, progress *task.Progress) (*User, error) {
 	endpoint := fmt.Sprintf("accounts/3/users/5")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -6766,15 +7017,15 @@ func (c *Canvas) AccountsDeleteAUserFromTheRootAccount(progress *task.Progress)
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AdminsMakeAnAccountAdmin API call: Flag an existing user as an admin within the account.
-func (c *Canvas) AdminsMakeAnAccountAdmin(progress *task.Progress, userID *int, role *string, roleID *int, sendConfirmation *bool) (*Admin, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AdminsMakeAnAccountAdmin(ctx context.Context, progress *task.Progress, userID *int, role *string, roleID *int, sendConfirmation *bool, accountID string) (*Admin, error) {
+	endpoint := fmt.Sprintf("accounts/%s/admins", accountID)
 	params := map[string]interface{}{}
 	if userID != nil {
 		params["user_id"] = *userID
@@ -6796,15 +7047,15 @@ func (c *Canvas) AdminsMakeAnAccountAdmin(progress *task.Progress, userID *int,
 		res = obj.(*Admin)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AdminsRemoveAccountAdmin API call: Remove the rights associated with an account admin role from a user.
-func (c *Canvas) AdminsRemoveAccountAdmin(progress *task.Progress, role *string, roleID *int) (*Admin, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AdminsRemoveAccountAdmin(ctx context.Context, progress *task.Progress, role *string, roleID *int, accountID string, userID string) (*Admin, error) {
+	endpoint := fmt.Sprintf("accounts/%s/admins/%s", accountID, userID)
 	params := map[string]interface{}{}
 	if role != nil {
 		params["role"] = *role
@@ -6820,14 +7071,14 @@ func (c *Canvas) AdminsRemoveAccountAdmin(progress *task.Progress, role *string,
 		res = obj.(*Admin)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AdminsListAccountAdmins API call: A paginated list of the admins in the account
-func (c *Canvas) AdminsListAccountAdmins(progress *task.Progress, userID *interface{}) ([]Admin, error) {
+func (c *Canvas) AdminsListAccountAdmins(ctx context.Context, progress *task.Progress, userID *interface{}) ([]Admin, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if userID != nil {
@@ -6842,7 +7093,7 @@ func (c *Canvas) AdminsListAccountAdmins(progress *task.Progress, userID *interf
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6851,7 +7102,7 @@ func (c *Canvas) AdminsListAccountAdmins(progress *task.Progress, userID *interf
 // AnnouncementsListAnnouncements API call: Returns the paginated list of announcements for the given courses and date
 // range.  Note that a +context_code+ field is added to the responses so you can tell which course each announcement
 // belongs to.
-func (c *Canvas) AnnouncementsListAnnouncements(progress *task.Progress, contextCodes []string, startDate *time.Time, endDate *time.Time, activeOnly *bool, include []interface{}) ([]DiscussionTopic, error) {
+func (c *Canvas) AnnouncementsListAnnouncements(ctx context.Context, progress *task.Progress, contextCodes []string, startDate *time.Time, endDate *time.Time, activeOnly *bool, include []interface{}) ([]DiscussionTopic, error) {
 	endpoint := fmt.Sprintf("announcements")
 	params := map[string]interface{}{}
 	if contextCodes != nil && len(contextCodes) > 0 {
@@ -6878,7 +7129,7 @@ func (c *Canvas) AnnouncementsListAnnouncements(progress *task.Progress, context
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6886,7 +7137,7 @@ func (c *Canvas) AnnouncementsListAnnouncements(progress *task.Progress, context
 
 // ModeratedGradingShowProvisionalGradeStatusForAStudent API call: Determine whether or not the student's submission
 // needs one or more provisional grades.
-func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent(progress *task.Progress, anonymousID *string) (*map[string]interface{}, error) {
+func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent(ctx context.Context, progress *task.Progress, anonymousID *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/anonymous_provisional_grades/status")
 	params := map[string]interface{}{}
 	if anonymousID != nil {
@@ -6900,7 +7151,7 @@ func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent(progress
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6908,7 +7159,7 @@ func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent(progress
 
 // AppointmentGroupsListAppointmentGroups API call: Retrieve the paginated list of appointment groups that can be
 // reserved or managed by the current user.
-func (c *Canvas) AppointmentGroupsListAppointmentGroups(progress *task.Progress, scope *AppointmentGroupsListAppointmentGroupsScope, contextCodes *string, includePastAppointments *bool, include *AppointmentGroupsListAppointmentGroupsInclude) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsListAppointmentGroups(ctx context.Context, progress *task.Progress, scope *AppointmentGroupsListAppointmentGroupsScope, contextCodes *string, includePastAppointments *bool, include *AppointmentGroupsListAppointmentGroupsInclude) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if scope != nil {
@@ -6931,7 +7182,7 @@ func (c *Canvas) AppointmentGroupsListAppointmentGroups(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6940,7 +7191,7 @@ func (c *Canvas) AppointmentGroupsListAppointmentGroups(progress *task.Progress,
 // AppointmentGroupsCreateAnAppointmentGroup API call: Create and return a new appointment group. If new_appointments
 // are specified, the response will return a new_appointments array (same format as appointments array, see "List
 // appointment groups" action)
-func (c *Canvas) AppointmentGroupsCreateAnAppointmentGroup(progress *task.Progress, appointmentGroup *string) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsCreateAnAppointmentGroup(ctx context.Context, progress *task.Progress, appointmentGroup *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("appointment_groups.json")
 	params := map[string]interface{}{}
 	if appointmentGroup != nil {
@@ -6954,14 +7205,14 @@ func (c *Canvas) AppointmentGroupsCreateAnAppointmentGroup(progress *task.Progre
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AppointmentGroupsGetASingleAppointmentGroup API call: Returns information for a single appointment group
-func (c *Canvas) AppointmentGroupsGetASingleAppointmentGroup(progress *task.Progress, include *AppointmentGroupsGetASingleAppointmentGroupInclude) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsGetASingleAppointmentGroup(ctx context.Context, progress *task.Progress, include *AppointmentGroupsGetASingleAppointmentGroupInclude) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -6975,7 +7226,7 @@ func (c *Canvas) AppointmentGroupsGetASingleAppointmentGroup(progress *task.Prog
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -6984,7 +7235,7 @@ func (c *Canvas) AppointmentGroupsGetASingleAppointmentGroup(progress *task.Prog
 // AppointmentGroupsUpdateAnAppointmentGroup API call: Update and return an appointment group. If new_appointments are
 // specified, the response will return a new_appointments array (same format as appointments array, see "List
 // appointment groups" action).
-func (c *Canvas) AppointmentGroupsUpdateAnAppointmentGroup(progress *task.Progress, appointmentGroup *string) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsUpdateAnAppointmentGroup(ctx context.Context, progress *task.Progress, appointmentGroup *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("appointment_groups/543.json")
 	params := map[string]interface{}{}
 	if appointmentGroup != nil {
@@ -6998,7 +7249,7 @@ func (c *Canvas) AppointmentGroupsUpdateAnAppointmentGroup(progress *task.Progre
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7006,7 +7257,7 @@ func (c *Canvas) AppointmentGroupsUpdateAnAppointmentGroup(progress *task.Progre
 
 // AppointmentGroupsDeleteAnAppointmentGroup API call: Delete an appointment group (and associated time slots and
 // reservations) and return the deleted group
-func (c *Canvas) AppointmentGroupsDeleteAnAppointmentGroup(progress *task.Progress, cancelReason *string) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsDeleteAnAppointmentGroup(ctx context.Context, progress *task.Progress, cancelReason *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("appointment_groups/543.json")
 	params := map[string]interface{}{}
 	if cancelReason != nil {
@@ -7020,7 +7271,7 @@ func (c *Canvas) AppointmentGroupsDeleteAnAppointmentGroup(progress *task.Progre
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7029,7 +7280,7 @@ func (c *Canvas) AppointmentGroupsDeleteAnAppointmentGroup(progress *task.Progre
 // AppointmentGroupsListUserParticipants API call: A paginated list of users that are (or may be) participating in this
 // appointment group.  Refer to the Users API for the response fields. Returns no results for appointment groups with
 // the "Group" participant_type.
-func (c *Canvas) AppointmentGroupsListUserParticipants(progress *task.Progress, registrationStatus *AppointmentGroupsListUserParticipantsRegistrationStatus) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsListUserParticipants(ctx context.Context, progress *task.Progress, registrationStatus *AppointmentGroupsListUserParticipantsRegistrationStatus) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if registrationStatus != nil {
@@ -7043,7 +7294,7 @@ func (c *Canvas) AppointmentGroupsListUserParticipants(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7052,7 +7303,7 @@ func (c *Canvas) AppointmentGroupsListUserParticipants(progress *task.Progress,
 // AppointmentGroupsListStudentGroupParticipants API call: A paginated list of student groups that are (or may be)
 // participating in this appointment group. Refer to the Groups API for the response fields. Returns no results for
 // appointment groups with the "User" participant_type.
-func (c *Canvas) AppointmentGroupsListStudentGroupParticipants(progress *task.Progress, registrationStatus *AppointmentGroupsListStudentGroupParticipantsRegistrationStatus) (*map[string]interface{}, error) {
+func (c *Canvas) AppointmentGroupsListStudentGroupParticipants(ctx context.Context, progress *task.Progress, registrationStatus *AppointmentGroupsListStudentGroupParticipantsRegistrationStatus) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if registrationStatus != nil {
@@ -7066,7 +7317,7 @@ func (c *Canvas) AppointmentGroupsListStudentGroupParticipants(progress *task.Pr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7074,7 +7325,7 @@ func (c *Canvas) AppointmentGroupsListStudentGroupParticipants(progress *task.Pr
 
 // AppointmentGroupsGetNextAppointment API call: Return the next appointment available to sign up for. The appointment
 // is returned in a one-element array. If no future appointments are available, an empty array is returned.
-func (c *Canvas) AppointmentGroupsGetNextAppointment(progress *task.Progress, appointmentGroupIds *string) ([]CalendarEvent, error) {
+func (c *Canvas) AppointmentGroupsGetNextAppointment(ctx context.Context, progress *task.Progress, appointmentGroupIds *string) ([]CalendarEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if appointmentGroupIds != nil {
@@ -7089,15 +7340,15 @@ func (c *Canvas) AppointmentGroupsGetNextAppointment(progress *task.Progress, ap
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentExtensionsSetExtensionsForStudentAssignmentSubmissions API call
-func (c *Canvas) AssignmentExtensionsSetExtensionsForStudentAssignmentSubmissions(progress *task.Progress, assignmentExtensions *int) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentExtensionsSetExtensionsForStudentAssignmentSubmissions(ctx context.Context, progress *task.Progress, assignmentExtensions *int, courseID string, assignmentID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/extensions", courseID, assignmentID)
 	params := map[string]interface{}{}
 	if assignmentExtensions != nil {
 		params["assignment_extensions"] = *assignmentExtensions
@@ -7110,14 +7361,14 @@ func (c *Canvas) AssignmentExtensionsSetExtensionsForStudentAssignmentSubmission
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentGroupsGetAnAssignmentGroup API call: Returns the assignment group with the given id.
-func (c *Canvas) AssignmentGroupsGetAnAssignmentGroup(progress *task.Progress, include *AssignmentGroupsGetAnAssignmentGroupInclude, overrideAssignmentDates *bool, gradingPeriodID *int) (*AssignmentGroup, error) {
+func (c *Canvas) AssignmentGroupsGetAnAssignmentGroup(ctx context.Context, progress *task.Progress, include *AssignmentGroupsGetAnAssignmentGroupInclude, overrideAssignmentDates *bool, gradingPeriodID *int) (*AssignmentGroup, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -7137,15 +7388,15 @@ func (c *Canvas) AssignmentGroupsGetAnAssignmentGroup(progress *task.Progress, i
 		res = obj.(*AssignmentGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentGroupsCreateAnAssignmentGroup API call: Create a new assignment group for this course.
-func (c *Canvas) AssignmentGroupsCreateAnAssignmentGroup(progress *task.Progress, name *string, position *int, groupWeight *float64, sisSourceID *string, integrationData map[string]interface{}, rules *interface{}) (*AssignmentGroup, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentGroupsCreateAnAssignmentGroup(ctx context.Context, progress *task.Progress, name *string, position *int, groupWeight *float64, sisSourceID *string, integrationData map[string]interface{}, rules *interface{}, courseID string) (*AssignmentGroup, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignment_groups", courseID)
 	params := map[string]interface{}{}
 	if name != nil {
 		params["name"] = *name
@@ -7173,7 +7424,7 @@ func (c *Canvas) AssignmentGroupsCreateAnAssignmentGroup(progress *task.Progress
 		res = obj.(*AssignmentGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7181,8 +7432,8 @@ func (c *Canvas) AssignmentGroupsCreateAnAssignmentGroup(progress *task.Progress
 
 // AssignmentGroupsEditAnAssignmentGroup API call: Modify an existing Assignment Group. Accepts the same parameters as
 // Assignment Group creation
-func (c *Canvas) AssignmentGroupsEditAnAssignmentGroup(progress *task.Progress) (*AssignmentGroup, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentGroupsEditAnAssignmentGroup(ctx context.Context, progress *task.Progress, courseID string, assignmentGroupID string) (*AssignmentGroup, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignment_groups/%s", courseID, assignmentGroupID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &AssignmentGroup{}
@@ -7192,15 +7443,15 @@ func (c *Canvas) AssignmentGroupsEditAnAssignmentGroup(progress *task.Progress)
 		res = obj.(*AssignmentGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentGroupsDestroyAnAssignmentGroup API call: Deletes the assignment group with the given id.
-func (c *Canvas) AssignmentGroupsDestroyAnAssignmentGroup(progress *task.Progress, moveAssignmentsTo *int) (*AssignmentGroup, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentGroupsDestroyAnAssignmentGroup(ctx context.Context, progress *task.Progress, moveAssignmentsTo *int, courseID string, assignmentGroupID string) (*AssignmentGroup, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignment_groups/%s", courseID, assignmentGroupID)
 	params := map[string]interface{}{}
 	if moveAssignmentsTo != nil {
 		params["move_assignments_to"] = *moveAssignmentsTo
@@ -7213,7 +7464,7 @@ func (c *Canvas) AssignmentGroupsDestroyAnAssignmentGroup(progress *task.Progres
 		res = obj.(*AssignmentGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7221,7 +7472,7 @@ func (c *Canvas) AssignmentGroupsDestroyAnAssignmentGroup(progress *task.Progres
 
 // AssignmentGroupsListAssignmentGroups API call: Returns the paginated list of assignment groups for the current
 // context. The returned groups are sorted by their position field.
-func (c *Canvas) AssignmentGroupsListAssignmentGroups(progress *task.Progress, include []AssignmentGroupsListAssignmentGroupsInclude, excludeAssignmentSubmissionTypes *AssignmentGroupsListAssignmentGroupsExcludeAssignmentSubmissionTypes, overrideAssignmentDates *bool, gradingPeriodID *int, scopeAssignmentsToStudent *bool, courseID string) ([]AssignmentGroup, error) {
+func (c *Canvas) AssignmentGroupsListAssignmentGroups(ctx context.Context, progress *task.Progress, include []AssignmentGroupsListAssignmentGroupsInclude, excludeAssignmentSubmissionTypes *AssignmentGroupsListAssignmentGroupsExcludeAssignmentSubmissionTypes, overrideAssignmentDates *bool, gradingPeriodID *int, scopeAssignmentsToStudent *bool, courseID string) ([]AssignmentGroup, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignment_groups", courseID)
 	params := map[string]interface{}{}
 	if include != nil && len(include) > 0 {
@@ -7248,7 +7499,7 @@ func (c *Canvas) AssignmentGroupsListAssignmentGroups(progress *task.Progress, i
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7256,7 +7507,7 @@ func (c *Canvas) AssignmentGroupsListAssignmentGroups(progress *task.Progress, i
 
 // AssignmentsListAssignmentOverrides API call: Returns the paginated list of overrides for this assignment that target
 // sections/groups/students visible to the current user.
-func (c *Canvas) AssignmentsListAssignmentOverrides(progress *task.Progress) ([]AssignmentOverride, error) {
+func (c *Canvas) AssignmentsListAssignmentOverrides(ctx context.Context, progress *task.Progress) ([]AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7268,14 +7519,14 @@ func (c *Canvas) AssignmentsListAssignmentOverrides(progress *task.Progress) ([]
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsGetASingleAssignmentOverride API call: Returns details of the the override with the given id.
-func (c *Canvas) AssignmentsGetASingleAssignmentOverride(progress *task.Progress) (*AssignmentOverride, error) {
+func (c *Canvas) AssignmentsGetASingleAssignmentOverride(ctx context.Context, progress *task.Progress) (*AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7286,7 +7537,7 @@ func (c *Canvas) AssignmentsGetASingleAssignmentOverride(progress *task.Progress
 		res = obj.(*AssignmentOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7294,7 +7545,7 @@ func (c *Canvas) AssignmentsGetASingleAssignmentOverride(progress *task.Progress
 
 // AssignmentsRedirectToTheAssignmentOverrideForAGroup API call: Responds with a redirect to the override for the given
 // group, if any (404 otherwise).
-func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForAGroup(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForAGroup(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7305,7 +7556,7 @@ func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForAGroup(progress *t
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7313,7 +7564,7 @@ func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForAGroup(progress *t
 
 // AssignmentsRedirectToTheAssignmentOverrideForASection API call: Responds with a redirect to the override for the
 // given section, if any (404 otherwise).
-func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForASection(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForASection(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7324,14 +7575,14 @@ func (c *Canvas) AssignmentsRedirectToTheAssignmentOverrideForASection(progress
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsCreateAnAssignmentOverride API call
-func (c *Canvas) AssignmentsCreateAnAssignmentOverride(progress *task.Progress, assignmentOverride *interface{}) (*AssignmentOverride, error) {
+func (c *Canvas) AssignmentsCreateAnAssignmentOverride(ctx context.Context, progress *task.Progress, assignmentOverride *interface{}) (*AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/overrides.json")
 	params := map[string]interface{}{}
 	if assignmentOverride != nil {
@@ -7345,14 +7596,14 @@ func (c *Canvas) AssignmentsCreateAnAssignmentOverride(progress *task.Progress,
 		res = obj.(*AssignmentOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsUpdateAnAssignmentOverride API call
-func (c *Canvas) AssignmentsUpdateAnAssignmentOverride(progress *task.Progress, assignmentOverride *interface{}) (*AssignmentOverride, error) {
+func (c *Canvas) AssignmentsUpdateAnAssignmentOverride(ctx context.Context, progress *task.Progress, assignmentOverride *interface{}) (*AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/overrides/3.json")
 	params := map[string]interface{}{}
 	if assignmentOverride != nil {
@@ -7366,14 +7617,14 @@ func (c *Canvas) AssignmentsUpdateAnAssignmentOverride(progress *task.Progress,
 		res = obj.(*AssignmentOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsDeleteAnAssignmentOverride API call: Deletes an override and returns its former details.
-func (c *Canvas) AssignmentsDeleteAnAssignmentOverride(progress *task.Progress) (*AssignmentOverride, error) {
+func (c *Canvas) AssignmentsDeleteAnAssignmentOverride(ctx context.Context, progress *task.Progress) (*AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/overrides/3.json")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7384,7 +7635,7 @@ func (c *Canvas) AssignmentsDeleteAnAssignmentOverride(progress *task.Progress)
 		res = obj.(*AssignmentOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7393,7 +7644,7 @@ func (c *Canvas) AssignmentsDeleteAnAssignmentOverride(progress *task.Progress)
 // AssignmentsBatchRetrieveOverridesInACourse API call: Returns a list of specified overrides in this course, providing
 // they target sections/groups/students visible to the current user. Returns null elements in the list for requests that
 // were not found.
-func (c *Canvas) AssignmentsBatchRetrieveOverridesInACourse(progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
+func (c *Canvas) AssignmentsBatchRetrieveOverridesInACourse(ctx context.Context, progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/12/assignments/overrides.json")
 	params := map[string]interface{}{}
 	if assignmentOverrides != nil {
@@ -7408,7 +7659,7 @@ func (c *Canvas) AssignmentsBatchRetrieveOverridesInACourse(progress *task.Progr
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7420,7 +7671,7 @@ func (c *Canvas) AssignmentsBatchRetrieveOverridesInACourse(progress *task.Progr
 // then group_id, then course_section_id) is used and any others are ignored. Errors are reported in an errors
 // attribute, an array of errors corresponding to inputs.  Global errors will be reported as a single element errors
 // array
-func (c *Canvas) AssignmentsBatchCreateOverridesInACourse(progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
+func (c *Canvas) AssignmentsBatchCreateOverridesInACourse(ctx context.Context, progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/12/assignments/overrides.json")
 	params := map[string]interface{}{}
 	if assignmentOverrides != nil {
@@ -7435,7 +7686,7 @@ func (c *Canvas) AssignmentsBatchCreateOverridesInACourse(progress *task.Progres
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7449,7 +7700,7 @@ func (c *Canvas) AssignmentsBatchCreateOverridesInACourse(progress *task.Progres
 // target override set is unchanged. Target override sets cannot be changed for group or section overrides. Errors are
 // reported in an errors attribute, an array of errors corresponding to inputs.  Global errors will be reported as a
 // single element errors array
-func (c *Canvas) AssignmentsBatchUpdateOverridesInACourse(progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
+func (c *Canvas) AssignmentsBatchUpdateOverridesInACourse(ctx context.Context, progress *task.Progress, assignmentOverrides *interface{}) ([]AssignmentOverride, error) {
 	endpoint := fmt.Sprintf("courses/12/assignments/overrides.json")
 	params := map[string]interface{}{}
 	if assignmentOverrides != nil {
@@ -7464,7 +7715,7 @@ func (c *Canvas) AssignmentsBatchUpdateOverridesInACourse(progress *task.Progres
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7472,7 +7723,7 @@ func (c *Canvas) AssignmentsBatchUpdateOverridesInACourse(progress *task.Progres
 
 // AssignmentsListAssignments API call: Returns the paginated list of assignments for the current course or assignment
 // group.
-func (c *Canvas) AssignmentsListAssignments(progress *task.Progress, include []AssignmentsListAssignmentsInclude, searchTerm *string, overrideAssignmentDates *bool, needsGradingCountBySection *bool, bucket *AssignmentsListAssignmentsBucket, assignmentIds *interface{}, orderBy *AssignmentsListAssignmentsOrderBy, postToSis *bool, courseID string) ([]Assignment, error) {
+func (c *Canvas) AssignmentsListAssignments(ctx context.Context, progress *task.Progress, include []AssignmentsListAssignmentsInclude, searchTerm *string, overrideAssignmentDates *bool, needsGradingCountBySection *bool, bucket *AssignmentsListAssignmentsBucket, assignmentIds *interface{}, orderBy *AssignmentsListAssignmentsOrderBy, postToSis *bool, courseID string) ([]Assignment, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignments", courseID)
 	params := map[string]interface{}{}
 	if include != nil && len(include) > 0 {
@@ -7508,7 +7759,7 @@ func (c *Canvas) AssignmentsListAssignments(progress *task.Progress, include []A
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7516,7 +7767,7 @@ func (c *Canvas) AssignmentsListAssignments(progress *task.Progress, include []A
 
 // AssignmentsListAssignmentsForUser API call: Returns the paginated list of assignments for the specified user if the
 // current user has rights to view. See {api:AssignmentsApiController#index List assignments} for valid arguments.
-func (c *Canvas) AssignmentsListAssignmentsForUser(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) AssignmentsListAssignmentsForUser(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7527,14 +7778,14 @@ func (c *Canvas) AssignmentsListAssignmentsForUser(progress *task.Progress) (*ma
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsGetASingleAssignment API call: Returns the assignment with the given id.
-func (c *Canvas) AssignmentsGetASingleAssignment(progress *task.Progress, include *AssignmentsGetASingleAssignmentInclude, overrideAssignmentDates *bool, needsGradingCountBySection *bool, allDates *bool) (*Assignment, error) {
+func (c *Canvas) AssignmentsGetASingleAssignment(ctx context.Context, progress *task.Progress, include *AssignmentsGetASingleAssignmentInclude, overrideAssignmentDates *bool, needsGradingCountBySection *bool, allDates *bool) (*Assignment, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -7557,7 +7808,7 @@ func (c *Canvas) AssignmentsGetASingleAssignment(progress *task.Progress, includ
 		res = obj.(*Assignment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7565,8 +7816,8 @@ func (c *Canvas) AssignmentsGetASingleAssignment(progress *task.Progress, includ
 
 // AssignmentsCreateAnAssignment API call: Create a new assignment for this course. The assignment is created in the
 // active state.
-func (c *Canvas) AssignmentsCreateAnAssignment(progress *task.Progress, assignment *interface{}) (*Assignment, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentsCreateAnAssignment(ctx context.Context, progress *task.Progress, assignment *interface{}, courseID string) (*Assignment, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments", courseID)
 	params := map[string]interface{}{}
 	if assignment != nil {
 		params["assignment"] = *assignment
@@ -7579,15 +7830,15 @@ func (c *Canvas) AssignmentsCreateAnAssignment(progress *task.Progress, assignme
 		res = obj.(*Assignment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsEditAnAssignment API call: Modify an existing assignment.
-func (c *Canvas) AssignmentsEditAnAssignment(progress *task.Progress, assignment *interface{}) (*Assignment, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AssignmentsEditAnAssignment(ctx context.Context, progress *task.Progress, assignment *interface{}, courseID string, id string) (*Assignment, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s", courseID, id)
 	params := map[string]interface{}{}
 	if assignment != nil {
 		params["assignment"] = *assignment
@@ -7600,7 +7851,7 @@ func (c *Canvas) AssignmentsEditAnAssignment(progress *task.Progress, assignment
 		res = obj.(*Assignment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7615,7 +7866,7 @@ func (c *Canvas) AssignmentsEditAnAssignment(progress *task.Progress, assignment
 // date, specify null explicitly. All referenced assignments will be validated before any are saved. A list of errors
 // will be returned if any provided dates are invalid, and no changes will be saved. The bulk update is performed in a
 // background job, use the {api:ProgressController#show Progress API} to check its status.
-func (c *Canvas) AssignmentsBulkUpdateAssignmentDates(progress *task.Progress) (*Progress, error) {
+func (c *Canvas) AssignmentsBulkUpdateAssignmentDates(ctx context.Context, progress *task.Progress) (*Progress, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/bulk_update")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7626,14 +7877,14 @@ func (c *Canvas) AssignmentsBulkUpdateAssignmentDates(progress *task.Progress) (
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AssignmentsDeleteAnAssignment API call: Delete the given assignment.
-func (c *Canvas) AssignmentsDeleteAnAssignment(progress *task.Progress, courseID string, assignmentID string) (*Assignment, error) {
+func (c *Canvas) AssignmentsDeleteAnAssignment(ctx context.Context, progress *task.Progress, courseID string, assignmentID string) (*Assignment, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignments/%s", courseID, assignmentID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7644,14 +7895,14 @@ func (c *Canvas) AssignmentsDeleteAnAssignment(progress *task.Progress, courseID
 		res = obj.(*Assignment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationsLogQueryByLogin API call: List authentication events for a given login.
-func (c *Canvas) AuthenticationsLogQueryByLogin(progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
+func (c *Canvas) AuthenticationsLogQueryByLogin(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -7668,14 +7919,14 @@ func (c *Canvas) AuthenticationsLogQueryByLogin(progress *task.Progress, startTi
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationsLogQueryByAccount API call: List authentication events for a given account.
-func (c *Canvas) AuthenticationsLogQueryByAccount(progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
+func (c *Canvas) AuthenticationsLogQueryByAccount(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -7692,14 +7943,14 @@ func (c *Canvas) AuthenticationsLogQueryByAccount(progress *task.Progress, start
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationsLogQueryByUser API call: List authentication events for a given user.
-func (c *Canvas) AuthenticationsLogQueryByUser(progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
+func (c *Canvas) AuthenticationsLogQueryByUser(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -7716,14 +7967,14 @@ func (c *Canvas) AuthenticationsLogQueryByUser(progress *task.Progress, startTim
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationProvidersListAuthenticationProviders API call: Returns a paginated list of authentication providers
-func (c *Canvas) AuthenticationProvidersListAuthenticationProviders(progress *task.Progress, accountID string) ([]AuthenticationProvider, error) {
+func (c *Canvas) AuthenticationProvidersListAuthenticationProviders(ctx context.Context, progress *task.Progress, accountID string) ([]AuthenticationProvider, error) {
 	endpoint := fmt.Sprintf("accounts/%s/authentication_providers", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7735,7 +7986,7 @@ func (c *Canvas) AuthenticationProvidersListAuthenticationProviders(progress *ta
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7838,7 +8089,7 @@ func (c *Canvas) AuthenticationProvidersListAuthenticationProviders(progress *ta
 // Accepts a boolean value, true designates the authentication service for use on parent registrations.  Only one
 // service can be selected at a time so if set to true all others will be set to false - federated_attributes [Optional]
 // See FederatedAttributesConfig. Valid provider attributes are 'name', 'screen_name', 'time_zone', and 'user_id'.
-func (c *Canvas) AuthenticationProvidersAddAuthenticationProvider(progress *task.Progress, accountID string) (*AuthenticationProvider, error) {
+func (c *Canvas) AuthenticationProvidersAddAuthenticationProvider(ctx context.Context, progress *task.Progress, accountID string) (*AuthenticationProvider, error) {
 	endpoint := fmt.Sprintf("accounts/%s/authentication_providers", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7849,7 +8100,7 @@ func (c *Canvas) AuthenticationProvidersAddAuthenticationProvider(progress *task
 		res = obj.(*AuthenticationProvider)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7857,7 +8108,7 @@ func (c *Canvas) AuthenticationProvidersAddAuthenticationProvider(progress *task
 
 // AuthenticationProvidersUpdateAuthenticationProvider API call: Update an authentication provider using the same
 // options as the create endpoint. You can not update an existing provider to a new authentication type.
-func (c *Canvas) AuthenticationProvidersUpdateAuthenticationProvider(progress *task.Progress, accountID string, id string) (*AuthenticationProvider, error) {
+func (c *Canvas) AuthenticationProvidersUpdateAuthenticationProvider(ctx context.Context, progress *task.Progress, accountID string, id string) (*AuthenticationProvider, error) {
 	endpoint := fmt.Sprintf("accounts/%s/authentication_providers/%s", accountID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7868,14 +8119,14 @@ func (c *Canvas) AuthenticationProvidersUpdateAuthenticationProvider(progress *t
 		res = obj.(*AuthenticationProvider)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationProvidersGetAuthenticationProvider API call: Get the specified authentication provider
-func (c *Canvas) AuthenticationProvidersGetAuthenticationProvider(progress *task.Progress, accountID string, id string) (*AuthenticationProvider, error) {
+func (c *Canvas) AuthenticationProvidersGetAuthenticationProvider(ctx context.Context, progress *task.Progress, accountID string, id string) (*AuthenticationProvider, error) {
 	endpoint := fmt.Sprintf("accounts/%s/authentication_providers/%s", accountID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7886,14 +8137,14 @@ func (c *Canvas) AuthenticationProvidersGetAuthenticationProvider(progress *task
 		res = obj.(*AuthenticationProvider)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AuthenticationProvidersDeleteAuthenticationProvider API call: Delete the config
-func (c *Canvas) AuthenticationProvidersDeleteAuthenticationProvider(progress *task.Progress, accountID string, id string) (*map[string]interface{}, error) {
+func (c *Canvas) AuthenticationProvidersDeleteAuthenticationProvider(ctx context.Context, progress *task.Progress, accountID string, id string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/%s/authentication_providers/%s", accountID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7904,7 +8155,7 @@ func (c *Canvas) AuthenticationProvidersDeleteAuthenticationProvider(progress *t
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7913,7 +8164,7 @@ func (c *Canvas) AuthenticationProvidersDeleteAuthenticationProvider(progress *t
 // AuthenticationProvidersShowAccountAuthSettings API call: The way to get the current state of each account level
 // setting that's relevant to Single Sign On configuration You can list the current state of each setting with
 // "update_sso_settings"
-func (c *Canvas) AuthenticationProvidersShowAccountAuthSettings(progress *task.Progress, accountID string) (*SSOSettings, error) {
+func (c *Canvas) AuthenticationProvidersShowAccountAuthSettings(ctx context.Context, progress *task.Progress, accountID string) (*SSOSettings, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sso_settings", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7924,7 +8175,7 @@ func (c *Canvas) AuthenticationProvidersShowAccountAuthSettings(progress *task.P
 		res = obj.(*SSOSettings)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7935,7 +8186,7 @@ func (c *Canvas) AuthenticationProvidersShowAccountAuthSettings(progress *task.P
 // PUT request to set several possible account settings. All setting are optional on each request, any that are not
 // provided at all are simply retained as is.  Any that provide the key but a null-ish value (blank string, null,
 // undefined) will be UN-set. You can list the current state of each setting with "show_sso_settings"
-func (c *Canvas) AuthenticationProvidersUpdateAccountAuthSettings(progress *task.Progress, accountID string) (*SSOSettings, error) {
+func (c *Canvas) AuthenticationProvidersUpdateAccountAuthSettings(ctx context.Context, progress *task.Progress, accountID string) (*SSOSettings, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sso_settings", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7946,7 +8197,7 @@ func (c *Canvas) AuthenticationProvidersUpdateAccountAuthSettings(progress *task
 		res = obj.(*SSOSettings)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7955,7 +8206,7 @@ func (c *Canvas) AuthenticationProvidersUpdateAccountAuthSettings(progress *task
 // BrandConfigsGetTheBrandConfigVariablesThatShouldBeUsedForThisDomain API call: Will redirect to a static json file
 // that has all of the brand variables used by this account. Even though this is a redirect, do not store the redirected
 // url since if the account makes any changes it will redirect to a new url. Needs no authentication.
-func (c *Canvas) BrandConfigsGetTheBrandConfigVariablesThatShouldBeUsedForThisDomain(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) BrandConfigsGetTheBrandConfigVariablesThatShouldBeUsedForThisDomain(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("brand_variables")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -7966,7 +8217,7 @@ func (c *Canvas) BrandConfigsGetTheBrandConfigVariablesThatShouldBeUsedForThisDo
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -7974,8 +8225,8 @@ func (c *Canvas) BrandConfigsGetTheBrandConfigVariablesThatShouldBeUsedForThisDo
 
 // CalendarEventsListCalendarEvents API call: Retrieve the paginated list of calendar events or assignments for the
 // current user
-func (c *Canvas) CalendarEventsListCalendarEvents(progress *task.Progress, typeName *interface{}, startDate *time.Time, endDate *time.Time, undated *bool, allEvents *bool, contextCodes *string, excludes []interface{}) ([]CalendarEvent, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CalendarEventsListCalendarEvents(ctx context.Context, progress *task.Progress, typeName *string, startDate *time.Time, endDate *time.Time, undated *bool, allEvents *bool, contextCodes []string, excludes []interface{}) ([]CalendarEvent, error) {
+	endpoint := fmt.Sprintf("calendar_events")
 	params := map[string]interface{}{}
 	if typeName != nil {
 		params["type"] = *typeName
@@ -7992,8 +8243,8 @@ func (c *Canvas) CalendarEventsListCalendarEvents(progress *task.Progress, typeN
 	if allEvents != nil {
 		params["all_events"] = *allEvents
 	}
-	if contextCodes != nil {
-		params["context_codes"] = *contextCodes
+	if contextCodes != nil && len(contextCodes) > 0 {
+		params["context_codes"] = contextCodes
 	}
 	if excludes != nil && len(excludes) > 0 {
 		params["excludes"] = excludes
@@ -8007,7 +8258,7 @@ func (c *Canvas) CalendarEventsListCalendarEvents(progress *task.Progress, typeN
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8016,7 +8267,7 @@ func (c *Canvas) CalendarEventsListCalendarEvents(progress *task.Progress, typeN
 // CalendarEventsListCalendarEventsForAUser API call: Retrieve the paginated list of calendar events or assignments for
 // the specified user. To view calendar events for a user other than yourself, you must either be an observer of that
 // user or an administrator.
-func (c *Canvas) CalendarEventsListCalendarEventsForAUser(progress *task.Progress, typeName *interface{}, startDate *time.Time, endDate *time.Time, undated *bool, allEvents *bool, contextCodes *string, excludes []interface{}, submissionTypes []interface{}, excludeSubmissionTypes []interface{}) ([]CalendarEvent, error) {
+func (c *Canvas) CalendarEventsListCalendarEventsForAUser(ctx context.Context, progress *task.Progress, typeName *interface{}, startDate *time.Time, endDate *time.Time, undated *bool, allEvents *bool, contextCodes *string, excludes []interface{}, submissionTypes []interface{}, excludeSubmissionTypes []interface{}) ([]CalendarEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if typeName != nil {
@@ -8055,14 +8306,14 @@ func (c *Canvas) CalendarEventsListCalendarEventsForAUser(progress *task.Progres
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CalendarEventsCreateACalendarEvent API call: Create and return a new calendar event
-func (c *Canvas) CalendarEventsCreateACalendarEvent(progress *task.Progress, calendarEvent *string) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsCreateACalendarEvent(ctx context.Context, progress *task.Progress, calendarEvent *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("calendar_events.json")
 	params := map[string]interface{}{}
 	if calendarEvent != nil {
@@ -8076,14 +8327,14 @@ func (c *Canvas) CalendarEventsCreateACalendarEvent(progress *task.Progress, cal
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CalendarEventsGetASingleCalendarEventOrAssignment API call
-func (c *Canvas) CalendarEventsGetASingleCalendarEventOrAssignment(progress *task.Progress) (*CalendarEvent, error) {
+func (c *Canvas) CalendarEventsGetASingleCalendarEventOrAssignment(ctx context.Context, progress *task.Progress) (*CalendarEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8094,14 +8345,14 @@ func (c *Canvas) CalendarEventsGetASingleCalendarEventOrAssignment(progress *tas
 		res = obj.(*CalendarEvent)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CalendarEventsReserveATimeSlot API call: Reserves a particular time slot and return the new reservation
-func (c *Canvas) CalendarEventsReserveATimeSlot(progress *task.Progress, participantID *string, comments *string, cancelExisting *bool) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsReserveATimeSlot(ctx context.Context, progress *task.Progress, participantID *string, comments *string, cancelExisting *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("calendar_events/345/reservations.json")
 	params := map[string]interface{}{}
 	if participantID != nil {
@@ -8121,14 +8372,14 @@ func (c *Canvas) CalendarEventsReserveATimeSlot(progress *task.Progress, partici
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CalendarEventsUpdateACalendarEvent API call: Update and return a calendar event
-func (c *Canvas) CalendarEventsUpdateACalendarEvent(progress *task.Progress, calendarEvent *string) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsUpdateACalendarEvent(ctx context.Context, progress *task.Progress, calendarEvent *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("calendar_events/234.json")
 	params := map[string]interface{}{}
 	if calendarEvent != nil {
@@ -8142,14 +8393,14 @@ func (c *Canvas) CalendarEventsUpdateACalendarEvent(progress *task.Progress, cal
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CalendarEventsDeleteACalendarEvent API call: Delete an event from the calendar and return the deleted event
-func (c *Canvas) CalendarEventsDeleteACalendarEvent(progress *task.Progress, cancelReason *string) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsDeleteACalendarEvent(ctx context.Context, progress *task.Progress, cancelReason *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("calendar_events/234.json")
 	params := map[string]interface{}{}
 	if cancelReason != nil {
@@ -8163,7 +8414,7 @@ func (c *Canvas) CalendarEventsDeleteACalendarEvent(progress *task.Progress, can
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8173,7 +8424,7 @@ func (c *Canvas) CalendarEventsDeleteACalendarEvent(progress *task.Progress, can
 // generate a series of calendar events based on simple schedules (e.g. "Monday and Wednesday at 2:00pm" ) Existing
 // timetable events for the course and course sections will be updated if they still are part of the timetable.
 // Otherwise, they will be deleted.
-func (c *Canvas) CalendarEventsSetACourseTimetable(progress *task.Progress, timetables []interface{}) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsSetACourseTimetable(ctx context.Context, progress *task.Progress, timetables []interface{}) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("calendar_events/timetable")
 	params := map[string]interface{}{}
 	if timetables != nil && len(timetables) > 0 {
@@ -8187,7 +8438,7 @@ func (c *Canvas) CalendarEventsSetACourseTimetable(progress *task.Progress, time
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8195,7 +8446,7 @@ func (c *Canvas) CalendarEventsSetACourseTimetable(progress *task.Progress, time
 
 // CalendarEventsGetCourseTimetable API call: Returns the last timetable set by the
 // {api:CalendarEventsApiController#set_course_timetable Set a course timetable} endpoint
-func (c *Canvas) CalendarEventsGetCourseTimetable(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CalendarEventsGetCourseTimetable(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8206,7 +8457,7 @@ func (c *Canvas) CalendarEventsGetCourseTimetable(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8216,8 +8467,8 @@ func (c *Canvas) CalendarEventsGetCourseTimetable(progress *task.Progress) (*map
 // course or course section. Similar to {api:CalendarEventsApiController#set_course_timetable setting a course
 // timetable}, but instead of generating a list of events based on a timetable schedule, this endpoint expects a
 // complete list of events.
-func (c *Canvas) CalendarEventsCreateOrUpdateEventsDirectlyForACourseTimetable(progress *task.Progress, courseSectionID *string, events []interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CalendarEventsCreateOrUpdateEventsDirectlyForACourseTimetable(ctx context.Context, progress *task.Progress, courseSectionID *string, events []interface{}, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/calendar_events/timetable_events", courseID)
 	params := map[string]interface{}{}
 	if courseSectionID != nil {
 		params["course_section_id"] = *courseSectionID
@@ -8233,7 +8484,7 @@ func (c *Canvas) CalendarEventsCreateOrUpdateEventsDirectlyForACourseTimetable(p
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8242,7 +8493,7 @@ func (c *Canvas) CalendarEventsCreateOrUpdateEventsDirectlyForACourseTimetable(p
 // CollaborationsListCollaborations API call: A paginated list of collaborations the current user has access to in the
 // context of the course provided in the url. NOTE: this only returns ExternalToolCollaboration type collaborations.
 // curl https://<canvas>/api/v1/courses/1/collaborations/
-func (c *Canvas) CollaborationsListCollaborations(progress *task.Progress) ([]Collaboration, error) {
+func (c *Canvas) CollaborationsListCollaborations(ctx context.Context, progress *task.Progress) ([]Collaboration, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8254,14 +8505,14 @@ func (c *Canvas) CollaborationsListCollaborations(progress *task.Progress) ([]Co
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CollaborationsListMembersOfACollaboration API call: A paginated list of the collaborators of a given collaboration
-func (c *Canvas) CollaborationsListMembersOfACollaboration(progress *task.Progress, include *CollaborationsListMembersOfACollaborationInclude) ([]Collaborator, error) {
+func (c *Canvas) CollaborationsListMembersOfACollaboration(ctx context.Context, progress *task.Progress, include *CollaborationsListMembersOfACollaborationInclude) ([]Collaborator, error) {
 	endpoint := fmt.Sprintf("courses/1/collaborations/1/members")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -8276,7 +8527,7 @@ func (c *Canvas) CollaborationsListMembersOfACollaboration(progress *task.Progre
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8285,7 +8536,7 @@ func (c *Canvas) CollaborationsListMembersOfACollaboration(progress *task.Progre
 // CollaborationsListPotentialMembers API call: A paginated list of the users who can potentially be added to a
 // collaboration in the given context. For courses, this consists of all enrolled users.  For groups, it is comprised of
 // the group members plus the admins of the course containing the group.
-func (c *Canvas) CollaborationsListPotentialMembers(progress *task.Progress) ([]User, error) {
+func (c *Canvas) CollaborationsListPotentialMembers(ctx context.Context, progress *task.Progress) ([]User, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8297,14 +8548,14 @@ func (c *Canvas) CollaborationsListPotentialMembers(progress *task.Progress) ([]
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CommMessagesListOfCommMessagesForAUser API call: Retrieve a paginated list of messages sent to a user.
-func (c *Canvas) CommMessagesListOfCommMessagesForAUser(progress *task.Progress, userID *string, startTime *time.Time, endTime *time.Time) ([]CommMessage, error) {
+func (c *Canvas) CommMessagesListOfCommMessagesForAUser(ctx context.Context, progress *task.Progress, userID *string, startTime *time.Time, endTime *time.Time) ([]CommMessage, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if userID != nil {
@@ -8325,7 +8576,7 @@ func (c *Canvas) CommMessagesListOfCommMessagesForAUser(progress *task.Progress,
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8333,7 +8584,7 @@ func (c *Canvas) CommMessagesListOfCommMessagesForAUser(progress *task.Progress,
 
 // CommunicationChannelsListUserCommunicationChannels API call: Returns a paginated list of communication channels for
 // the specified user, sorted by position.
-func (c *Canvas) CommunicationChannelsListUserCommunicationChannels(progress *task.Progress) ([]CommunicationChannel, error) {
+func (c *Canvas) CommunicationChannelsListUserCommunicationChannels(ctx context.Context, progress *task.Progress) ([]CommunicationChannel, error) {
 	endpoint := fmt.Sprintf("users/12345/communication_channels")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8345,7 +8596,7 @@ func (c *Canvas) CommunicationChannelsListUserCommunicationChannels(progress *ta
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8353,7 +8604,7 @@ func (c *Canvas) CommunicationChannelsListUserCommunicationChannels(progress *ta
 
 // CommunicationChannelsCreateACommunicationChannel API call: Creates a new communication channel for the specified
 // user.
-func (c *Canvas) CommunicationChannelsCreateACommunicationChannel(progress *task.Progress, communicationChannel *string, skipConfirmation *bool) (*CommunicationChannel, error) {
+func (c *Canvas) CommunicationChannelsCreateACommunicationChannel(ctx context.Context, progress *task.Progress, communicationChannel *string, skipConfirmation *bool) (*CommunicationChannel, error) {
 	endpoint := fmt.Sprintf("users/1/communication_channels")
 	params := map[string]interface{}{}
 	if communicationChannel != nil {
@@ -8370,14 +8621,14 @@ func (c *Canvas) CommunicationChannelsCreateACommunicationChannel(progress *task
 		res = obj.(*CommunicationChannel)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CommunicationChannelsDeleteACommunicationChannel API call: Delete an existing communication channel.
-func (c *Canvas) CommunicationChannelsDeleteACommunicationChannel(progress *task.Progress) (*CommunicationChannel, error) {
+func (c *Canvas) CommunicationChannelsDeleteACommunicationChannel(ctx context.Context, progress *task.Progress) (*CommunicationChannel, error) {
 	endpoint := fmt.Sprintf("users/5/communication_channels/3")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8388,14 +8639,14 @@ func (c *Canvas) CommunicationChannelsDeleteACommunicationChannel(progress *task
 		res = obj.(*CommunicationChannel)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CommunicationChannelsDeleteAPushNotificationEndpoint API call
-func (c *Canvas) CommunicationChannelsDeleteAPushNotificationEndpoint(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CommunicationChannelsDeleteAPushNotificationEndpoint(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/communication_channels/push")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8406,7 +8657,7 @@ func (c *Canvas) CommunicationChannelsDeleteAPushNotificationEndpoint(progress *
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8414,7 +8665,7 @@ func (c *Canvas) CommunicationChannelsDeleteAPushNotificationEndpoint(progress *
 
 // ConferencesListConferences API call: Retrieve the paginated list of conferences for this context This API returns a
 // JSON object containing the list of conferences, the key for the list of conferences is "conferences"
-func (c *Canvas) ConferencesListConferences(progress *task.Progress, courseID string) ([]Conference, error) {
+func (c *Canvas) ConferencesListConferences(ctx context.Context, progress *task.Progress, courseID string) ([]Conference, error) {
 	endpoint := fmt.Sprintf("courses/%s/conferences", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8426,7 +8677,7 @@ func (c *Canvas) ConferencesListConferences(progress *task.Progress, courseID st
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8435,7 +8686,7 @@ func (c *Canvas) ConferencesListConferences(progress *task.Progress, courseID st
 // ConferencesListConferencesForTheCurrentUser API call: Retrieve the paginated list of conferences for all courses and
 // groups the current user belongs to This API returns a JSON object containing the list of conferences. The key for the
 // list of conferences is "conferences".
-func (c *Canvas) ConferencesListConferencesForTheCurrentUser(progress *task.Progress, state *string) ([]Conference, error) {
+func (c *Canvas) ConferencesListConferencesForTheCurrentUser(ctx context.Context, progress *task.Progress, state *string) ([]Conference, error) {
 	endpoint := fmt.Sprintf("conferences")
 	params := map[string]interface{}{}
 	if state != nil {
@@ -8450,7 +8701,7 @@ func (c *Canvas) ConferencesListConferencesForTheCurrentUser(progress *task.Prog
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8458,7 +8709,7 @@ func (c *Canvas) ConferencesListConferencesForTheCurrentUser(progress *task.Prog
 
 // ContentExportsListContentExports API call: A paginated list of the past and pending content export jobs for a course,
 // group, or user. Exports are returned newest first.
-func (c *Canvas) ContentExportsListContentExports(progress *task.Progress) ([]ContentExport, error) {
+func (c *Canvas) ContentExportsListContentExports(ctx context.Context, progress *task.Progress) ([]ContentExport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8470,14 +8721,14 @@ func (c *Canvas) ContentExportsListContentExports(progress *task.Progress) ([]Co
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentExportsShowContentExport API call: Get information about a single content export.
-func (c *Canvas) ContentExportsShowContentExport(progress *task.Progress) (*ContentExport, error) {
+func (c *Canvas) ContentExportsShowContentExport(ctx context.Context, progress *task.Progress) (*ContentExport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8488,7 +8739,7 @@ func (c *Canvas) ContentExportsShowContentExport(progress *task.Progress) (*Cont
 		res = obj.(*ContentExport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8498,8 +8749,8 @@ func (c *Canvas) ContentExportsShowContentExport(progress *task.Progress) (*Cont
 // {api:ProgressController#show Progress API} to track the progress of the export. The migration's progress is linked to
 // with the _progress_url_ value. When the export completes, use the {api:ContentExportsApiController#show Show content
 // export} endpoint to retrieve a download URL for the exported content.
-func (c *Canvas) ContentExportsExportContent(progress *task.Progress, exportType *ContentExportsExportContentExportType, skipNotifications *bool, selectField *ContentExportsExportContentSelect) (*ContentExport, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentExportsExportContent(ctx context.Context, progress *task.Progress, exportType *ContentExportsExportContentExportType, skipNotifications *bool, selectField *ContentExportsExportContentSelect, courseID string) (*ContentExport, error) {
+	endpoint := fmt.Sprintf("courses/%s/content_exports", courseID)
 	params := map[string]interface{}{}
 	if exportType != nil {
 		params["export_type"] = *exportType
@@ -8518,7 +8769,7 @@ func (c *Canvas) ContentExportsExportContent(progress *task.Progress, exportType
 		res = obj.(*ContentExport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8526,7 +8777,7 @@ func (c *Canvas) ContentExportsExportContent(progress *task.Progress, exportType
 
 // CoursesGetCourseCopyStatus API call: DEPRECATED: Please use the {api:ContentMigrationsController#create Content
 // Migrations API} Retrieve the status of a course copy
-func (c *Canvas) CoursesGetCourseCopyStatus(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesGetCourseCopyStatus(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8537,7 +8788,7 @@ func (c *Canvas) CoursesGetCourseCopyStatus(progress *task.Progress) (*map[strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8546,8 +8797,8 @@ func (c *Canvas) CoursesGetCourseCopyStatus(progress *task.Progress) (*map[strin
 // CoursesCopyCourseContent API call: DEPRECATED: Please use the {api:ContentMigrationsController#create Content
 // Migrations API} Copies content from one course into another. The default is to copy all course content. You can
 // control specific types to copy by using either the 'except' option or the 'only' option.
-func (c *Canvas) CoursesCopyCourseContent(progress *task.Progress, sourceCourse *string, except *CoursesCopyCourseContentExcept, only *CoursesCopyCourseContentOnly) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CoursesCopyCourseContent(ctx context.Context, progress *task.Progress, sourceCourse *string, except *CoursesCopyCourseContentExcept, only *CoursesCopyCourseContentOnly, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/course_copy", courseID)
 	params := map[string]interface{}{}
 	if sourceCourse != nil {
 		params["source_course"] = *sourceCourse
@@ -8566,14 +8817,14 @@ func (c *Canvas) CoursesCopyCourseContent(progress *task.Progress, sourceCourse
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentMigrationsListContentMigrations API call: Returns paginated content migrations
-func (c *Canvas) ContentMigrationsListContentMigrations(progress *task.Progress, courseID string) ([]ContentMigration, error) {
+func (c *Canvas) ContentMigrationsListContentMigrations(ctx context.Context, progress *task.Progress, courseID string) ([]ContentMigration, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8585,14 +8836,14 @@ func (c *Canvas) ContentMigrationsListContentMigrations(progress *task.Progress,
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentMigrationsGetAContentMigration API call: Returns data on an individual content migration
-func (c *Canvas) ContentMigrationsGetAContentMigration(progress *task.Progress, courseID string, id string) (*ContentMigration, error) {
+func (c *Canvas) ContentMigrationsGetAContentMigration(ctx context.Context, progress *task.Progress, courseID string, id string) (*ContentMigration, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8603,7 +8854,7 @@ func (c *Canvas) ContentMigrationsGetAContentMigration(progress *task.Progress,
 		res = obj.(*ContentMigration)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8620,7 +8871,7 @@ func (c *Canvas) ContentMigrationsGetAContentMigration(progress *task.Progress,
 // *pre_attachment* 2. Do {file:file_uploads.html file upload processing} using the data in the *pre_attachment* data 3.
 // {api:ContentMigrationsController#show GET} the ContentMigration 4. Use the {api:ProgressController#show Progress}
 // specified in _progress_url_ to monitor progress
-func (c *Canvas) ContentMigrationsCreateAContentMigration(progress *task.Progress, migrationType *string, preAttachment *string, settings *interface{}, dateShiftOptions *bool, selectiveImport *bool, selectField *ContentMigrationsCreateAContentMigrationSelect, courseID string) (*ContentMigration, error) {
+func (c *Canvas) ContentMigrationsCreateAContentMigration(ctx context.Context, progress *task.Progress, migrationType *string, preAttachment *string, settings *interface{}, dateShiftOptions *bool, selectiveImport *bool, selectField *ContentMigrationsCreateAContentMigrationSelect, courseID string) (*ContentMigration, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations", courseID)
 	params := map[string]interface{}{}
 	if migrationType != nil {
@@ -8649,7 +8900,7 @@ func (c *Canvas) ContentMigrationsCreateAContentMigration(progress *task.Progres
 		res = obj.(*ContentMigration)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8660,8 +8911,8 @@ func (c *Canvas) ContentMigrationsCreateAContentMigration(progress *task.Progres
 // most settings after the migration process has started will not do anything. Generally updating the content migration
 // will be used when there is a file upload problem, or when importing content selectively. If the first upload has a
 // problem you can supply new _pre_attachment_ values to start the process again.
-func (c *Canvas) ContentMigrationsUpdateAContentMigration(progress *task.Progress) (*ContentMigration, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentMigrationsUpdateAContentMigration(ctx context.Context, progress *task.Progress, courseID string, id string) (*ContentMigration, error) {
+	endpoint := fmt.Sprintf("courses/%s/content_migrations/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &ContentMigration{}
@@ -8671,7 +8922,7 @@ func (c *Canvas) ContentMigrationsUpdateAContentMigration(progress *task.Progres
 		res = obj.(*ContentMigration)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8679,7 +8930,7 @@ func (c *Canvas) ContentMigrationsUpdateAContentMigration(progress *task.Progres
 
 // ContentMigrationsListMigrationSystems API call: Lists the currently available migration types. These values may
 // change.
-func (c *Canvas) ContentMigrationsListMigrationSystems(progress *task.Progress) ([]Migrator, error) {
+func (c *Canvas) ContentMigrationsListMigrationSystems(ctx context.Context, progress *task.Progress) ([]Migrator, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8691,7 +8942,7 @@ func (c *Canvas) ContentMigrationsListMigrationSystems(progress *task.Progress)
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8704,7 +8955,7 @@ func (c *Canvas) ContentMigrationsListMigrationSystems(progress *task.Progress)
 // obtain copy parameters for a subset of the resources in a given node. If no +type+ is sent you will get a list of the
 // top-level sections in the content. It will look something like this: [{ "type": "course_settings", "property":
 // "copy[all_course_settings]", "title": "Course Settings" },
-func (c *Canvas) ContentMigrationsListItemsForSelectiveImport(progress *task.Progress, typeName *ContentMigrationsListItemsForSelectiveImportType) (*map[string]interface{}, error) {
+func (c *Canvas) ContentMigrationsListItemsForSelectiveImport(ctx context.Context, progress *task.Progress, typeName *ContentMigrationsListItemsForSelectiveImportType) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if typeName != nil {
@@ -8718,14 +8969,14 @@ func (c *Canvas) ContentMigrationsListItemsForSelectiveImport(progress *task.Pro
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentSharesCreateAContentShare API call: Share content directly between two or more users
-func (c *Canvas) ContentSharesCreateAContentShare(progress *task.Progress, receiverIds []interface{}, contentType *ContentSharesCreateAContentShareContentType, contentID *int) (*ContentShare, error) {
+func (c *Canvas) ContentSharesCreateAContentShare(ctx context.Context, progress *task.Progress, receiverIds []interface{}, contentType *ContentSharesCreateAContentShareContentType, contentID *int) (*ContentShare, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares")
 	params := map[string]interface{}{}
 	if receiverIds != nil && len(receiverIds) > 0 {
@@ -8745,7 +8996,7 @@ func (c *Canvas) ContentSharesCreateAContentShare(progress *task.Progress, recei
 		res = obj.(*ContentShare)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8754,7 +9005,7 @@ func (c *Canvas) ContentSharesCreateAContentShare(progress *task.Progress, recei
 // ContentSharesListContentShares API call: Return a paginated list of content shares a user has sent or received. Use
 // +self+ as the user_id to retrieve your own content shares. Only linked observers and administrators may view other
 // users' content shares.
-func (c *Canvas) ContentSharesListContentShares(progress *task.Progress) ([]ContentShare, error) {
+func (c *Canvas) ContentSharesListContentShares(ctx context.Context, progress *task.Progress) ([]ContentShare, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/received")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8766,7 +9017,7 @@ func (c *Canvas) ContentSharesListContentShares(progress *task.Progress) ([]Cont
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8775,7 +9026,7 @@ func (c *Canvas) ContentSharesListContentShares(progress *task.Progress) ([]Cont
 // ContentSharesGetUnreadSharesCount API call: Return the number of content shares a user has received that have not yet
 // been read. Use +self+ as the user_id to retrieve your own content shares. Only linked observers and administrators
 // may view other users' content shares.
-func (c *Canvas) ContentSharesGetUnreadSharesCount(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ContentSharesGetUnreadSharesCount(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/unread_count")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8786,7 +9037,7 @@ func (c *Canvas) ContentSharesGetUnreadSharesCount(progress *task.Progress) (*ma
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8794,7 +9045,7 @@ func (c *Canvas) ContentSharesGetUnreadSharesCount(progress *task.Progress) (*ma
 
 // ContentSharesGetContentShare API call: Return information about a single content share. You may use +self+ as the
 // user_id to retrieve your own content share.
-func (c *Canvas) ContentSharesGetContentShare(progress *task.Progress) (*ContentShare, error) {
+func (c *Canvas) ContentSharesGetContentShare(ctx context.Context, progress *task.Progress) (*ContentShare, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/123")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8805,7 +9056,7 @@ func (c *Canvas) ContentSharesGetContentShare(progress *task.Progress) (*Content
 		res = obj.(*ContentShare)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8813,7 +9064,7 @@ func (c *Canvas) ContentSharesGetContentShare(progress *task.Progress) (*Content
 
 // ContentSharesRemoveContentShare API call: Remove a content share from your list. Use +self+ as the user_id. Note that
 // this endpoint does not delete other users' copies of the content share.
-func (c *Canvas) ContentSharesRemoveContentShare(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ContentSharesRemoveContentShare(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/123")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -8824,14 +9075,14 @@ func (c *Canvas) ContentSharesRemoveContentShare(progress *task.Progress) (*map[
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentSharesAddUsersToContentShare API call: Send a previously created content share to additional users
-func (c *Canvas) ContentSharesAddUsersToContentShare(progress *task.Progress, receiverIds []interface{}) (*ContentShare, error) {
+func (c *Canvas) ContentSharesAddUsersToContentShare(ctx context.Context, progress *task.Progress, receiverIds []interface{}) (*ContentShare, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/123/add_users")
 	params := map[string]interface{}{}
 	if receiverIds != nil && len(receiverIds) > 0 {
@@ -8845,14 +9096,14 @@ func (c *Canvas) ContentSharesAddUsersToContentShare(progress *task.Progress, re
 		res = obj.(*ContentShare)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentSharesUpdateAContentShare API call: Mark a content share read or unread
-func (c *Canvas) ContentSharesUpdateAContentShare(progress *task.Progress, readState *ContentSharesUpdateAContentShareReadState) (*ContentShare, error) {
+func (c *Canvas) ContentSharesUpdateAContentShare(ctx context.Context, progress *task.Progress, readState *ContentSharesUpdateAContentShareReadState) (*ContentShare, error) {
 	endpoint := fmt.Sprintf("users/self/content_shares/123")
 	params := map[string]interface{}{}
 	if readState != nil {
@@ -8866,14 +9117,14 @@ func (c *Canvas) ContentSharesUpdateAContentShare(progress *task.Progress, readS
 		res = obj.(*ContentShare)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesListModuleItems API call: A paginated list of the items in a module
-func (c *Canvas) ModulesListModuleItems(progress *task.Progress, include *ModulesListModuleItemsInclude, searchTerm *string, studentID *interface{}) ([]ModuleItem, error) {
+func (c *Canvas) ModulesListModuleItems(ctx context.Context, progress *task.Progress, include *ModulesListModuleItemsInclude, searchTerm *string, studentID *interface{}) ([]ModuleItem, error) {
 	endpoint := fmt.Sprintf("courses/222/modules/123/items")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -8894,14 +9145,14 @@ func (c *Canvas) ModulesListModuleItems(progress *task.Progress, include *Module
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesShowModuleItem API call: Get information about a single module item
-func (c *Canvas) ModulesShowModuleItem(progress *task.Progress, include *ModulesShowModuleItemInclude, studentID *interface{}) (*ModuleItem, error) {
+func (c *Canvas) ModulesShowModuleItem(ctx context.Context, progress *task.Progress, include *ModulesShowModuleItemInclude, studentID *interface{}) (*ModuleItem, error) {
 	endpoint := fmt.Sprintf("courses/222/modules/123/items/768")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -8918,14 +9169,14 @@ func (c *Canvas) ModulesShowModuleItem(progress *task.Progress, include *Modules
 		res = obj.(*ModuleItem)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesCreateAModuleItem API call: Create and return a new module item
-func (c *Canvas) ModulesCreateAModuleItem(progress *task.Progress, moduleItem *string, courseID string, moduleID string) (*ModuleItem, error) {
+func (c *Canvas) ModulesCreateAModuleItem(ctx context.Context, progress *task.Progress, moduleItem *string, courseID string, moduleID string) (*ModuleItem, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items", courseID, moduleID)
 	params := map[string]interface{}{}
 	if moduleItem != nil {
@@ -8939,14 +9190,14 @@ func (c *Canvas) ModulesCreateAModuleItem(progress *task.Progress, moduleItem *s
 		res = obj.(*ModuleItem)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesUpdateAModuleItem API call: Update and return an existing module item
-func (c *Canvas) ModulesUpdateAModuleItem(progress *task.Progress, moduleItem *string, courseID string, moduleID string, itemID string) (*ModuleItem, error) {
+func (c *Canvas) ModulesUpdateAModuleItem(ctx context.Context, progress *task.Progress, moduleItem *string, courseID string, moduleID string, itemID string) (*ModuleItem, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items/%s", courseID, moduleID, itemID)
 	params := map[string]interface{}{}
 	if moduleItem != nil {
@@ -8960,7 +9211,7 @@ func (c *Canvas) ModulesUpdateAModuleItem(progress *task.Progress, moduleItem *s
 		res = obj.(*ModuleItem)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -8969,7 +9220,7 @@ func (c *Canvas) ModulesUpdateAModuleItem(progress *task.Progress, moduleItem *s
 // ModulesSelectAMasteryPath API call: Select a mastery path when module item includes several possible paths. Requires
 // Mastery Paths feature to be enabled.  Returns a compound document with the assignments included in the given path and
 // any module items related to those assignments
-func (c *Canvas) ModulesSelectAMasteryPath(progress *task.Progress, assignmentSetID *interface{}, studentID *interface{}, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
+func (c *Canvas) ModulesSelectAMasteryPath(ctx context.Context, progress *task.Progress, assignmentSetID *interface{}, studentID *interface{}, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items/%s/select_master_path", courseID, moduleID, itemID)
 	params := map[string]interface{}{}
 	if assignmentSetID != nil {
@@ -8986,14 +9237,14 @@ func (c *Canvas) ModulesSelectAMasteryPath(progress *task.Progress, assignmentSe
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesDeleteModuleItem API call: Delete a module item
-func (c *Canvas) ModulesDeleteModuleItem(progress *task.Progress, courseID string, moduleID string, itemID string) (*ModuleItem, error) {
+func (c *Canvas) ModulesDeleteModuleItem(ctx context.Context, progress *task.Progress, courseID string, moduleID string, itemID string) (*ModuleItem, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items/%s", courseID, moduleID, itemID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9004,7 +9255,7 @@ func (c *Canvas) ModulesDeleteModuleItem(progress *task.Progress, courseID strin
 		res = obj.(*ModuleItem)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9012,7 +9263,7 @@ func (c *Canvas) ModulesDeleteModuleItem(progress *task.Progress, courseID strin
 
 // ModulesMarkModuleItemAsDoneNotDone API call: Mark a module item as done/not done. Use HTTP method PUT to mark as
 // done, and DELETE to mark as not done.
-func (c *Canvas) ModulesMarkModuleItemAsDoneNotDone(progress *task.Progress, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
+func (c *Canvas) ModulesMarkModuleItemAsDoneNotDone(ctx context.Context, progress *task.Progress, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items/%s/done", courseID, moduleID, itemID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9023,7 +9274,7 @@ func (c *Canvas) ModulesMarkModuleItemAsDoneNotDone(progress *task.Progress, cou
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9031,7 +9282,7 @@ func (c *Canvas) ModulesMarkModuleItemAsDoneNotDone(progress *task.Progress, cou
 
 // ModulesGetModuleItemSequence API call: Given an asset in a course, find the ModuleItem it belongs to, the previous
 // and next Module Items in the course sequence, and also any applicable mastery path rules
-func (c *Canvas) ModulesGetModuleItemSequence(progress *task.Progress, assetType *ModulesGetModuleItemSequenceAssetType, assetID *int, courseID string) (*ModuleItemSequence, error) {
+func (c *Canvas) ModulesGetModuleItemSequence(ctx context.Context, progress *task.Progress, assetType *ModulesGetModuleItemSequenceAssetType, assetID *int, courseID string) (*ModuleItemSequence, error) {
 	endpoint := fmt.Sprintf("courses/%s/module_item_sequence", courseID)
 	params := map[string]interface{}{}
 	if assetType != nil {
@@ -9048,7 +9299,7 @@ func (c *Canvas) ModulesGetModuleItemSequence(progress *task.Progress, assetType
 		res = obj.(*ModuleItemSequence)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9058,7 +9309,7 @@ func (c *Canvas) ModulesGetModuleItemSequence(progress *task.Progress, assetType
 // to do this explicitly, but it is provided for applications that need to access external content directly (bypassing
 // the html_url redirect that normally allows Canvas to fulfill "must view" requirements). This endpoint cannot be used
 // to complete requirements on locked or unpublished module items.
-func (c *Canvas) ModulesMarkModuleItemRead(progress *task.Progress, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
+func (c *Canvas) ModulesMarkModuleItemRead(ctx context.Context, progress *task.Progress, courseID string, moduleID string, itemID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/items/%s/mark_read", courseID, moduleID, itemID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9069,7 +9320,7 @@ func (c *Canvas) ModulesMarkModuleItemRead(progress *task.Progress, courseID str
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9077,7 +9328,7 @@ func (c *Canvas) ModulesMarkModuleItemRead(progress *task.Progress, courseID str
 
 // ModulesDuplicateModuleItem API call: Makes a copy of an assignment, discussion or wiki page module item, within the
 // same module. It also creates a duplicate copy of the assignment, discussion, or wiki page.
-func (c *Canvas) ModulesDuplicateModuleItem(progress *task.Progress, courseID string, itemID string) (*map[string]interface{}, error) {
+func (c *Canvas) ModulesDuplicateModuleItem(ctx context.Context, progress *task.Progress, courseID string, itemID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/items/%s/duplicate", courseID, itemID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9088,14 +9339,14 @@ func (c *Canvas) ModulesDuplicateModuleItem(progress *task.Progress, courseID st
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesListModules API call: A paginated list of the modules in a course
-func (c *Canvas) ModulesListModules(progress *task.Progress, include []ModulesListModulesInclude, searchTerm *string, studentID *interface{}, courseID string) ([]Module, error) {
+func (c *Canvas) ModulesListModules(ctx context.Context, progress *task.Progress, include []ModulesListModulesInclude, searchTerm *string, studentID *interface{}, courseID string) ([]Module, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules", courseID)
 	params := map[string]interface{}{}
 	if include != nil && len(include) > 0 {
@@ -9116,14 +9367,14 @@ func (c *Canvas) ModulesListModules(progress *task.Progress, include []ModulesLi
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesShowModule API call: Get information about a single module
-func (c *Canvas) ModulesShowModule(progress *task.Progress, include *ModulesShowModuleInclude, studentID *interface{}) (*Module, error) {
+func (c *Canvas) ModulesShowModule(ctx context.Context, progress *task.Progress, include *ModulesShowModuleInclude, studentID *interface{}) (*Module, error) {
 	endpoint := fmt.Sprintf("courses/222/modules/123")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -9140,14 +9391,14 @@ func (c *Canvas) ModulesShowModule(progress *task.Progress, include *ModulesShow
 		res = obj.(*Module)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesCreateAModule API call: Create and return a new module
-func (c *Canvas) ModulesCreateAModule(progress *task.Progress, module *string, courseID string) (*Module, error) {
+func (c *Canvas) ModulesCreateAModule(ctx context.Context, progress *task.Progress, module *string, courseID string) (*Module, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules", courseID)
 	params := map[string]interface{}{}
 	if module != nil {
@@ -9161,14 +9412,14 @@ func (c *Canvas) ModulesCreateAModule(progress *task.Progress, module *string, c
 		res = obj.(*Module)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesUpdateAModule API call: Update and return an existing module
-func (c *Canvas) ModulesUpdateAModule(progress *task.Progress, module *string, courseID string, moduleID string) (*Module, error) {
+func (c *Canvas) ModulesUpdateAModule(ctx context.Context, progress *task.Progress, module *string, courseID string, moduleID string) (*Module, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s", courseID, moduleID)
 	params := map[string]interface{}{}
 	if module != nil {
@@ -9182,14 +9433,14 @@ func (c *Canvas) ModulesUpdateAModule(progress *task.Progress, module *string, c
 		res = obj.(*Module)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModulesDeleteModule API call: Delete a module
-func (c *Canvas) ModulesDeleteModule(progress *task.Progress, courseID string, moduleID string) (*Module, error) {
+func (c *Canvas) ModulesDeleteModule(ctx context.Context, progress *task.Progress, courseID string, moduleID string) (*Module, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s", courseID, moduleID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9200,7 +9451,7 @@ func (c *Canvas) ModulesDeleteModule(progress *task.Progress, courseID string, m
 		res = obj.(*Module)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9209,7 +9460,7 @@ func (c *Canvas) ModulesDeleteModule(progress *task.Progress, courseID string, m
 // ModulesReLockModuleProgressions API call: Resets module progressions to their default locked state and recalculates
 // them based on the current requirements. Adding progression requirements to an active course will not lock students
 // out of modules they have already unlocked unless this action is called.
-func (c *Canvas) ModulesReLockModuleProgressions(progress *task.Progress, courseID string, moduleID string) (*Module, error) {
+func (c *Canvas) ModulesReLockModuleProgressions(ctx context.Context, progress *task.Progress, courseID string, moduleID string) (*Module, error) {
 	endpoint := fmt.Sprintf("courses/%s/modules/%s/relock", courseID, moduleID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9220,7 +9471,7 @@ func (c *Canvas) ModulesReLockModuleProgressions(progress *task.Progress, course
 		res = obj.(*Module)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9228,8 +9479,8 @@ func (c *Canvas) ModulesReLockModuleProgressions(progress *task.Progress, course
 
 // ConversationsListConversations API call: Returns the paginated list of conversations for the current user, most
 // recent ones first.
-func (c *Canvas) ConversationsListConversations(progress *task.Progress, scope *ConversationsListConversationsScope, filter *ConversationsListConversationsFilter, filterMode *ConversationsListConversationsFilterMode, interleaveSubmissions *interface{}, includeAllConversationIds *interface{}, include *ConversationsListConversationsInclude) ([]Conversation, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsListConversations(ctx context.Context, progress *task.Progress, scope *ConversationsListConversationsScope, filter *ConversationsListConversationsFilter, filterMode *ConversationsListConversationsFilterMode, interleaveSubmissions *interface{}, includeAllConversationIds *interface{}, include *ConversationsListConversationsInclude) ([]Conversation, error) {
+	endpoint := fmt.Sprintf("conversations")
 	params := map[string]interface{}{}
 	if scope != nil {
 		params["scope"] = *scope
@@ -9258,7 +9509,7 @@ func (c *Canvas) ConversationsListConversations(progress *task.Progress, scope *
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9266,8 +9517,8 @@ func (c *Canvas) ConversationsListConversations(progress *task.Progress, scope *
 
 // ConversationsCreateAConversation API call: Create a new conversation with one or more recipients. If there is already
 // an existing private conversation with the given recipients, it will be reused.
-func (c *Canvas) ConversationsCreateAConversation(progress *task.Progress, recipients *string, subject *string, body *string, forceNew *bool, groupConversation *bool, attachmentIds *string, mediaCommentID *string, mediaCommentType *ConversationsCreateAConversationMediaCommentType, userNote *bool, mode *ConversationsCreateAConversationMode, scope *ConversationsCreateAConversationScope, filter *ConversationsCreateAConversationFilter, filterMode *ConversationsCreateAConversationFilterMode, contextCode *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsCreateAConversation(ctx context.Context, progress *task.Progress, recipients *string, subject *string, body *string, forceNew *bool, groupConversation *bool, attachmentIds *string, mediaCommentID *string, mediaCommentType *ConversationsCreateAConversationMediaCommentType, userNote *bool, mode *ConversationsCreateAConversationMode, scope *ConversationsCreateAConversationScope, filter *ConversationsCreateAConversationFilter, filterMode *ConversationsCreateAConversationFilterMode, contextCode *string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations")
 	params := map[string]interface{}{}
 	if recipients != nil {
 		params["recipients"] = *recipients
@@ -9319,7 +9570,7 @@ func (c *Canvas) ConversationsCreateAConversation(progress *task.Progress, recip
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9328,7 +9579,7 @@ func (c *Canvas) ConversationsCreateAConversation(progress *task.Progress, recip
 // ConversationsGetRunningBatches API call: Returns any currently running conversation batches for the current user.
 // Conversation batches are created when a bulk private message is sent asynchronously (see the mode argument to the
 // {api:ConversationsController#create create API action}).
-func (c *Canvas) ConversationsGetRunningBatches(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ConversationsGetRunningBatches(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9339,7 +9590,7 @@ func (c *Canvas) ConversationsGetRunningBatches(progress *task.Progress) (*map[s
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9348,8 +9599,8 @@ func (c *Canvas) ConversationsGetRunningBatches(progress *task.Progress) (*map[s
 // ConversationsGetASingleConversation API call: Returns information for a single conversation for the current user.
 // Response includes all fields that are present in the list/index action as well as messages and extended participant
 // information.
-func (c *Canvas) ConversationsGetASingleConversation(progress *task.Progress, interleaveSubmissions *interface{}, scope *ConversationsGetASingleConversationScope, filter *ConversationsGetASingleConversationFilter, filterMode *ConversationsGetASingleConversationFilterMode, autoMarkAsRead *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsGetASingleConversation(ctx context.Context, progress *task.Progress, interleaveSubmissions *interface{}, scope *ConversationsGetASingleConversationScope, filter *ConversationsGetASingleConversationFilter, filterMode *ConversationsGetASingleConversationFilterMode, autoMarkAsRead *interface{}, id string) (*Conversation, error) {
+	endpoint := fmt.Sprintf("conversations/%s", id)
 	params := map[string]interface{}{}
 	if interleaveSubmissions != nil {
 		params["interleave_submissions"] = *interleaveSubmissions
@@ -9367,22 +9618,22 @@ func (c *Canvas) ConversationsGetASingleConversation(progress *task.Progress, in
 		params["auto_mark_as_read"] = *autoMarkAsRead
 	}
 	responseCtor := func() interface{} {
-		return &map[string]interface{}{}
+		return &Conversation{}
 	}
-	var res *map[string]interface{}
+	var res *Conversation
 	callback := func(obj interface{}) error {
-		res = obj.(*map[string]interface{})
+		res = obj.(*Conversation)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ConversationsEditAConversation API call: Updates attributes for a single conversation.
-func (c *Canvas) ConversationsEditAConversation(progress *task.Progress, conversation *ConversationsEditAConversationConversation, scope *ConversationsEditAConversationScope, filter *ConversationsEditAConversationFilter, filterMode *ConversationsEditAConversationFilterMode) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsEditAConversation(ctx context.Context, progress *task.Progress, conversation *ConversationsEditAConversationConversation, scope *ConversationsEditAConversationScope, filter *ConversationsEditAConversationFilter, filterMode *ConversationsEditAConversationFilterMode, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/%s", id)
 	params := map[string]interface{}{}
 	if conversation != nil {
 		params["conversation"] = *conversation
@@ -9404,15 +9655,15 @@ func (c *Canvas) ConversationsEditAConversation(progress *task.Progress, convers
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ConversationsMarkAllAsRead API call: Mark all conversations as read.
-func (c *Canvas) ConversationsMarkAllAsRead(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsMarkAllAsRead(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/mark_all_as_read")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -9422,7 +9673,7 @@ func (c *Canvas) ConversationsMarkAllAsRead(progress *task.Progress) (*map[strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9430,8 +9681,8 @@ func (c *Canvas) ConversationsMarkAllAsRead(progress *task.Progress) (*map[strin
 
 // ConversationsDeleteAConversation API call: Delete this conversation and its messages. Note that this only deletes
 // this user's view of the conversation. Response includes same fields as UPDATE action
-func (c *Canvas) ConversationsDeleteAConversation(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsDeleteAConversation(ctx context.Context, progress *task.Progress, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/%s", id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -9441,7 +9692,7 @@ func (c *Canvas) ConversationsDeleteAConversation(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9449,8 +9700,8 @@ func (c *Canvas) ConversationsDeleteAConversation(progress *task.Progress) (*map
 
 // ConversationsAddRecipients API call: Add recipients to an existing group conversation. Response is similar to the
 // GET/show action, except that only includes the latest message (e.g. "joe was added to the conversation by bob")
-func (c *Canvas) ConversationsAddRecipients(progress *task.Progress, recipients *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsAddRecipients(ctx context.Context, progress *task.Progress, recipients *string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/%s/add_recipients", id)
 	params := map[string]interface{}{}
 	if recipients != nil {
 		params["recipients"] = *recipients
@@ -9463,7 +9714,7 @@ func (c *Canvas) ConversationsAddRecipients(progress *task.Progress, recipients
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9471,8 +9722,8 @@ func (c *Canvas) ConversationsAddRecipients(progress *task.Progress, recipients
 
 // ConversationsAddAMessage API call: Add a message to an existing conversation. Response is similar to the GET/show
 // action, except that only includes the latest message (i.e. what we just sent)
-func (c *Canvas) ConversationsAddAMessage(progress *task.Progress, body *string, attachmentIds *string, mediaCommentID *string, mediaCommentType *ConversationsAddAMessageMediaCommentType, recipients *string, includedMessages *string, userNote *bool) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsAddAMessage(ctx context.Context, progress *task.Progress, body *string, attachmentIds *string, mediaCommentID *string, mediaCommentType *ConversationsAddAMessageMediaCommentType, recipients *string, includedMessages *string, userNote *bool, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/%s/add_message", id)
 	params := map[string]interface{}{}
 	if body != nil {
 		params["body"] = *body
@@ -9503,7 +9754,7 @@ func (c *Canvas) ConversationsAddAMessage(progress *task.Progress, body *string,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9511,8 +9762,8 @@ func (c *Canvas) ConversationsAddAMessage(progress *task.Progress, body *string,
 
 // ConversationsDeleteAMessage API call: Delete messages from this conversation. Note that this only affects this user's
 // view of the conversation. If all messages are deleted, the conversation will be as well (equivalent to DELETE)
-func (c *Canvas) ConversationsDeleteAMessage(progress *task.Progress, remove *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ConversationsDeleteAMessage(ctx context.Context, progress *task.Progress, remove *string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("conversations/%s/remove_messages", id)
 	params := map[string]interface{}{}
 	if remove != nil {
 		params["remove"] = *remove
@@ -9525,7 +9776,7 @@ func (c *Canvas) ConversationsDeleteAMessage(progress *task.Progress, remove *st
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9533,7 +9784,7 @@ func (c *Canvas) ConversationsDeleteAMessage(progress *task.Progress, remove *st
 
 // ConversationsBatchUpdateConversations API call: Perform a change on a set of conversations. Operates asynchronously;
 // use the {api:ProgressController#show progress endpoint} to query the status of an operation.
-func (c *Canvas) ConversationsBatchUpdateConversations(progress *task.Progress, conversationIds *string, event *ConversationsBatchUpdateConversationsEvent) (*Progress, error) {
+func (c *Canvas) ConversationsBatchUpdateConversations(ctx context.Context, progress *task.Progress, conversationIds *string, event *ConversationsBatchUpdateConversationsEvent) (*Progress, error) {
 	endpoint := fmt.Sprintf("conversations")
 	params := map[string]interface{}{}
 	if conversationIds != nil {
@@ -9550,7 +9801,7 @@ func (c *Canvas) ConversationsBatchUpdateConversations(progress *task.Progress,
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9558,7 +9809,7 @@ func (c *Canvas) ConversationsBatchUpdateConversations(progress *task.Progress,
 
 // ConversationsFindRecipients API call: Deprecated, see the {api:SearchController#recipients Find recipients endpoint}
 // in the Search API
-func (c *Canvas) ConversationsFindRecipients(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ConversationsFindRecipients(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9569,14 +9820,14 @@ func (c *Canvas) ConversationsFindRecipients(progress *task.Progress) (*map[stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ConversationsUnreadCount API call: Get the number of unread conversations for the current user
-func (c *Canvas) ConversationsUnreadCount(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ConversationsUnreadCount(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9587,14 +9838,14 @@ func (c *Canvas) ConversationsUnreadCount(progress *task.Progress) (*map[string]
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CourseAuditLogQueryByCourse API call: List course change events for a given course.
-func (c *Canvas) CourseAuditLogQueryByCourse(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]CourseEvent, error) {
+func (c *Canvas) CourseAuditLogQueryByCourse(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]CourseEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -9612,14 +9863,14 @@ func (c *Canvas) CourseAuditLogQueryByCourse(progress *task.Progress, startTime
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CourseAuditLogQueryByAccount API call: List course change events for a given account.
-func (c *Canvas) CourseAuditLogQueryByAccount(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]CourseEvent, error) {
+func (c *Canvas) CourseAuditLogQueryByAccount(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]CourseEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -9637,14 +9888,14 @@ func (c *Canvas) CourseAuditLogQueryByAccount(progress *task.Progress, startTime
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersListCourseNicknames API call: Returns all course nicknames you have set.
-func (c *Canvas) UsersListCourseNicknames(progress *task.Progress) ([]CourseNickname, error) {
+func (c *Canvas) UsersListCourseNicknames(ctx context.Context, progress *task.Progress) ([]CourseNickname, error) {
 	endpoint := fmt.Sprintf("users/self/course_nicknames")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9656,14 +9907,14 @@ func (c *Canvas) UsersListCourseNicknames(progress *task.Progress) ([]CourseNick
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersGetCourseNickname API call: Returns the nickname for a specific course.
-func (c *Canvas) UsersGetCourseNickname(progress *task.Progress, courseID string) (*CourseNickname, error) {
+func (c *Canvas) UsersGetCourseNickname(ctx context.Context, progress *task.Progress, courseID string) (*CourseNickname, error) {
 	endpoint := fmt.Sprintf("users/self/course_nicknames/%s", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9674,7 +9925,7 @@ func (c *Canvas) UsersGetCourseNickname(progress *task.Progress, courseID string
 		res = obj.(*CourseNickname)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9682,7 +9933,7 @@ func (c *Canvas) UsersGetCourseNickname(progress *task.Progress, courseID string
 
 // UsersSetCourseNickname API call: Set a nickname for the given course. This will replace the course's name in output
 // of API calls you make subsequently, as well as in selected places in the Canvas web user interface.
-func (c *Canvas) UsersSetCourseNickname(progress *task.Progress, nickname *string, courseID string) (*CourseNickname, error) {
+func (c *Canvas) UsersSetCourseNickname(ctx context.Context, progress *task.Progress, nickname *string, courseID string) (*CourseNickname, error) {
 	endpoint := fmt.Sprintf("users/self/course_nicknames/%s", courseID)
 	params := map[string]interface{}{}
 	if nickname != nil {
@@ -9696,7 +9947,7 @@ func (c *Canvas) UsersSetCourseNickname(progress *task.Progress, nickname *strin
 		res = obj.(*CourseNickname)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9704,7 +9955,7 @@ func (c *Canvas) UsersSetCourseNickname(progress *task.Progress, nickname *strin
 
 // UsersRemoveCourseNickname API call: Remove the nickname for the given course. Subsequent course API calls will return
 // the actual name for the course.
-func (c *Canvas) UsersRemoveCourseNickname(progress *task.Progress, courseID string) (*CourseNickname, error) {
+func (c *Canvas) UsersRemoveCourseNickname(ctx context.Context, progress *task.Progress, courseID string) (*CourseNickname, error) {
 	endpoint := fmt.Sprintf("users/self/course_nicknames/%s", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9715,14 +9966,14 @@ func (c *Canvas) UsersRemoveCourseNickname(progress *task.Progress, courseID str
 		res = obj.(*CourseNickname)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersClearCourseNicknames API call: Remove all stored course nicknames.
-func (c *Canvas) UsersClearCourseNicknames(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) UsersClearCourseNicknames(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/course_nicknames")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9733,14 +9984,14 @@ func (c *Canvas) UsersClearCourseNicknames(progress *task.Progress) (*map[string
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesListYourCourses API call: Returns the paginated list of active courses for the current user.
-func (c *Canvas) CoursesListYourCourses(progress *task.Progress, enrollmentType *CoursesListYourCoursesEnrollmentType, enrollmentRole *interface{}, enrollmentRoleID *int, enrollmentState *CoursesListYourCoursesEnrollmentState, excludeBlueprintCourses *bool, include *CoursesListYourCoursesInclude, state *CoursesListYourCoursesState) ([]Course, error) {
+func (c *Canvas) CoursesListYourCourses(ctx context.Context, progress *task.Progress, enrollmentType *CoursesListYourCoursesEnrollmentType, enrollmentRole *interface{}, enrollmentRoleID *int, enrollmentState *CoursesListYourCoursesEnrollmentState, excludeBlueprintCourses *bool, include *CoursesListYourCoursesInclude, state *CoursesListYourCoursesState) ([]Course, error) {
 	endpoint := fmt.Sprintf("courses")
 	params := map[string]interface{}{}
 	if enrollmentType != nil {
@@ -9773,7 +10024,7 @@ func (c *Canvas) CoursesListYourCourses(progress *task.Progress, enrollmentType
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9781,7 +10032,7 @@ func (c *Canvas) CoursesListYourCourses(progress *task.Progress, enrollmentType
 
 // CoursesListCoursesForAUser API call: Returns a paginated list of active courses for this user. To view the course
 // list for a user other than yourself, you must be either an observer of that user or an administrator.
-func (c *Canvas) CoursesListCoursesForAUser(progress *task.Progress, include *CoursesListCoursesForAUserInclude, state *CoursesListCoursesForAUserState, enrollmentState *CoursesListCoursesForAUserEnrollmentState) ([]Course, error) {
+func (c *Canvas) CoursesListCoursesForAUser(ctx context.Context, progress *task.Progress, include *CoursesListCoursesForAUserInclude, state *CoursesListCoursesForAUserState, enrollmentState *CoursesListCoursesForAUserEnrollmentState) ([]Course, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -9802,15 +10053,15 @@ func (c *Canvas) CoursesListCoursesForAUser(progress *task.Progress, include *Co
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesCreateANewCourse API call: Create a new course
-func (c *Canvas) CoursesCreateANewCourse(progress *task.Progress, course *string, offer *bool, enrollMe *bool, enableSisReactivation *bool) (*Course, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CoursesCreateANewCourse(ctx context.Context, progress *task.Progress, course *string, offer *bool, enrollMe *bool, enableSisReactivation *bool, accountID string) (*Course, error) {
+	endpoint := fmt.Sprintf("accounts/%s/courses", accountID)
 	params := map[string]interface{}{}
 	if course != nil {
 		params["course"] = *course
@@ -9832,7 +10083,7 @@ func (c *Canvas) CoursesCreateANewCourse(progress *task.Progress, course *string
 		res = obj.(*Course)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9842,8 +10093,8 @@ func (c *Canvas) CoursesCreateANewCourse(progress *task.Progress, course *string
 // a course. See the {file:file_uploads.html File Upload Documentation} for details on the file upload workflow. Only
 // those with the "Manage Files" permission on a course can upload files to the course. By default, this is Teachers,
 // TAs and Designers.
-func (c *Canvas) CoursesUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CoursesUploadAFile(ctx context.Context, progress *task.Progress, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/files", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -9853,7 +10104,7 @@ func (c *Canvas) CoursesUploadAFile(progress *task.Progress) (*map[string]interf
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9861,7 +10112,7 @@ func (c *Canvas) CoursesUploadAFile(progress *task.Progress) (*map[string]interf
 
 // CoursesListStudents API call: Returns the paginated list of students enrolled in this course. DEPRECATED: Please use
 // the {api:CoursesController#users course users} endpoint and pass "student" as the enrollment_type.
-func (c *Canvas) CoursesListStudents(progress *task.Progress) ([]User, error) {
+func (c *Canvas) CoursesListStudents(ctx context.Context, progress *task.Progress) ([]User, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9873,7 +10124,7 @@ func (c *Canvas) CoursesListStudents(progress *task.Progress) ([]User, error) {
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9881,7 +10132,7 @@ func (c *Canvas) CoursesListStudents(progress *task.Progress) ([]User, error) {
 
 // CoursesListUsersInCourse API call: Returns the paginated list of users in this course. And optionally the user's
 // enrollments in the course.
-func (c *Canvas) CoursesListUsersInCourse(progress *task.Progress, searchTerm *string, sort *CoursesListUsersInCourseSort, enrollmentType *CoursesListUsersInCourseEnrollmentType, enrollmentRole *interface{}, enrollmentRoleID *int, include []CoursesListUsersInCourseInclude, userID *string, userIds *int, enrollmentState *CoursesListUsersInCourseEnrollmentState, courseID string) ([]User, error) {
+func (c *Canvas) CoursesListUsersInCourse(ctx context.Context, progress *task.Progress, searchTerm *string, sort *CoursesListUsersInCourseSort, enrollmentType *CoursesListUsersInCourseEnrollmentType, enrollmentRole *interface{}, enrollmentRoleID *int, include []CoursesListUsersInCourseInclude, userID *string, userIds *int, enrollmentState *CoursesListUsersInCourseEnrollmentState, courseID string) ([]User, error) {
 	endpoint := fmt.Sprintf("courses/%s/users", courseID)
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -9920,7 +10171,7 @@ func (c *Canvas) CoursesListUsersInCourse(progress *task.Progress, searchTerm *s
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9929,7 +10180,7 @@ func (c *Canvas) CoursesListUsersInCourse(progress *task.Progress, searchTerm *s
 // CoursesListRecentlyLoggedInStudents API call: Returns the paginated list of users in this course, ordered by how
 // recently they have logged in. The records include the 'last_login' field which contains a timestamp of the last time
 // that user logged into canvas.  The querying user must have the 'View usage reports' permission.
-func (c *Canvas) CoursesListRecentlyLoggedInStudents(progress *task.Progress, courseID string) ([]User, error) {
+func (c *Canvas) CoursesListRecentlyLoggedInStudents(ctx context.Context, progress *task.Progress, courseID string) ([]User, error) {
 	endpoint := fmt.Sprintf("courses/%s/recent_users", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9941,7 +10192,7 @@ func (c *Canvas) CoursesListRecentlyLoggedInStudents(progress *task.Progress, co
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9949,7 +10200,7 @@ func (c *Canvas) CoursesListRecentlyLoggedInStudents(progress *task.Progress, co
 
 // CoursesGetSingleUser API call: Return information on a single user. Accepts the same include[] parameters as the
 // :users: action, and returns a single user with the same fields as that action.
-func (c *Canvas) CoursesGetSingleUser(progress *task.Progress) (*User, error) {
+func (c *Canvas) CoursesGetSingleUser(ctx context.Context, progress *task.Progress) (*User, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -9960,7 +10211,7 @@ func (c *Canvas) CoursesGetSingleUser(progress *task.Progress) (*User, error) {
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -9968,7 +10219,7 @@ func (c *Canvas) CoursesGetSingleUser(progress *task.Progress) (*User, error) {
 
 // CoursesSearchForContentShareUsers API call: Returns a paginated list of users you can share content with.  Requires
 // the content share feature and the user must have the manage content permission for the course.
-func (c *Canvas) CoursesSearchForContentShareUsers(progress *task.Progress, searchTerm *string, courseID string) ([]User, error) {
+func (c *Canvas) CoursesSearchForContentShareUsers(ctx context.Context, progress *task.Progress, searchTerm *string, courseID string) ([]User, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_share_users", courseID)
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -9983,14 +10234,14 @@ func (c *Canvas) CoursesSearchForContentShareUsers(progress *task.Progress, sear
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesPreviewProcessedHTML API call: Preview html content processed for this course
-func (c *Canvas) CoursesPreviewProcessedHTML(progress *task.Progress, html *interface{}, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesPreviewProcessedHTML(ctx context.Context, progress *task.Progress, html *interface{}, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/preview_html", courseID)
 	params := map[string]interface{}{}
 	if html != nil {
@@ -10004,7 +10255,7 @@ func (c *Canvas) CoursesPreviewProcessedHTML(progress *task.Progress, html *inte
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10012,7 +10263,7 @@ func (c *Canvas) CoursesPreviewProcessedHTML(progress *task.Progress, html *inte
 
 // CoursesCourseActivityStream API call: Returns the current user's course-specific activity stream, paginated. For full
 // documentation, see the API documentation for the user activity stream, in the user api.
-func (c *Canvas) CoursesCourseActivityStream(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesCourseActivityStream(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10023,7 +10274,7 @@ func (c *Canvas) CoursesCourseActivityStream(progress *task.Progress) (*map[stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10031,7 +10282,7 @@ func (c *Canvas) CoursesCourseActivityStream(progress *task.Progress) (*map[stri
 
 // CoursesCourseActivityStreamSummary API call: Returns a summary of the current user's course-specific activity stream.
 // For full documentation, see the API documentation for the user activity stream summary, in the user api.
-func (c *Canvas) CoursesCourseActivityStreamSummary(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesCourseActivityStreamSummary(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10042,7 +10293,7 @@ func (c *Canvas) CoursesCourseActivityStreamSummary(progress *task.Progress) (*m
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10050,7 +10301,7 @@ func (c *Canvas) CoursesCourseActivityStreamSummary(progress *task.Progress) (*m
 
 // CoursesCourseTODOItems API call: Returns the current user's course-specific todo items. For full documentation, see
 // the API documentation for the user todo items, in the user api.
-func (c *Canvas) CoursesCourseTODOItems(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesCourseTODOItems(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10061,15 +10312,15 @@ func (c *Canvas) CoursesCourseTODOItems(progress *task.Progress) (*map[string]in
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesDeleteConcludeACourse API call: Delete or conclude an existing course
-func (c *Canvas) CoursesDeleteConcludeACourse(progress *task.Progress, event *CoursesDeleteConcludeACourseEvent) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CoursesDeleteConcludeACourse(ctx context.Context, progress *task.Progress, event *CoursesDeleteConcludeACourseEvent, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s", id)
 	params := map[string]interface{}{}
 	if event != nil {
 		params["event"] = *event
@@ -10082,14 +10333,14 @@ func (c *Canvas) CoursesDeleteConcludeACourse(progress *task.Progress, event *Co
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesGetCourseSettings API call: Returns some of a course's settings.
-func (c *Canvas) CoursesGetCourseSettings(progress *task.Progress, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesGetCourseSettings(ctx context.Context, progress *task.Progress, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/settings", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10100,14 +10351,14 @@ func (c *Canvas) CoursesGetCourseSettings(progress *task.Progress, courseID stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CoursesUpdateCourseSettings API call: Can update the following course settings:
-func (c *Canvas) CoursesUpdateCourseSettings(progress *task.Progress, allowStudentDiscussionTopics *bool, allowStudentForumAttachments *bool, allowStudentDiscussionEditing *bool, allowStudentOrganizedGroups *bool, filterSpeedGraderByStudentGroup *bool, hideFinalGrades *bool, hideDistributionGraphs *bool, lockAllAnnouncements *bool, usageRightsRequired *bool, restrictStudentPastView *bool, restrictStudentFutureView *bool, showAnnouncementsOnHomePage *bool, homePageAnnouncementLimit *int, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesUpdateCourseSettings(ctx context.Context, progress *task.Progress, allowStudentDiscussionTopics *bool, allowStudentForumAttachments *bool, allowStudentDiscussionEditing *bool, allowStudentOrganizedGroups *bool, filterSpeedGraderByStudentGroup *bool, hideFinalGrades *bool, hideDistributionGraphs *bool, lockAllAnnouncements *bool, usageRightsRequired *bool, restrictStudentPastView *bool, restrictStudentFutureView *bool, showAnnouncementsOnHomePage *bool, homePageAnnouncementLimit *int, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/settings", courseID)
 	params := map[string]interface{}{}
 	if allowStudentDiscussionTopics != nil {
@@ -10157,7 +10408,7 @@ func (c *Canvas) CoursesUpdateCourseSettings(progress *task.Progress, allowStude
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10166,7 +10417,7 @@ func (c *Canvas) CoursesUpdateCourseSettings(progress *task.Progress, allowStude
 // CoursesReturnTestStudentForCourse API call: Returns information for a test student in this course. Creates a test
 // student if one does not already exist for the course. The caller must have permission to access the course's student
 // view.
-func (c *Canvas) CoursesReturnTestStudentForCourse(progress *task.Progress, courseID string) (*User, error) {
+func (c *Canvas) CoursesReturnTestStudentForCourse(ctx context.Context, progress *task.Progress, courseID string) (*User, error) {
 	endpoint := fmt.Sprintf("courses/%s/student_view_student", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10177,7 +10428,7 @@ func (c *Canvas) CoursesReturnTestStudentForCourse(progress *task.Progress, cour
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10185,7 +10436,7 @@ func (c *Canvas) CoursesReturnTestStudentForCourse(progress *task.Progress, cour
 
 // CoursesGetASingleCourse API call: Return information on a single course. Accepts the same include[] parameters as the
 // list action plus:
-func (c *Canvas) CoursesGetASingleCourse(progress *task.Progress, include *CoursesGetASingleCourseInclude, teacherLimit *int) (*Course, error) {
+func (c *Canvas) CoursesGetASingleCourse(ctx context.Context, progress *task.Progress, include *CoursesGetASingleCourseInclude, teacherLimit *int) (*Course, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -10202,7 +10453,7 @@ func (c *Canvas) CoursesGetASingleCourse(progress *task.Progress, include *Cours
 		res = obj.(*Course)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10211,7 +10462,7 @@ func (c *Canvas) CoursesGetASingleCourse(progress *task.Progress, include *Cours
 // CoursesUpdateACourse API call: Update an existing course. Arguments are the same as Courses#create, with a few
 // exceptions (enroll_me). If a user has content management rights, but not full course editing rights, the only
 // attribute editable through this endpoint will be "syllabus_body"
-func (c *Canvas) CoursesUpdateACourse(progress *task.Progress, course *int, offer *bool, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesUpdateACourse(ctx context.Context, progress *task.Progress, course *int, offer *bool, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s", courseID)
 	params := map[string]interface{}{}
 	if course != nil {
@@ -10228,7 +10479,7 @@ func (c *Canvas) CoursesUpdateACourse(progress *task.Progress, course *int, offe
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10236,7 +10487,7 @@ func (c *Canvas) CoursesUpdateACourse(progress *task.Progress, course *int, offe
 
 // CoursesUpdateCourses API call: Update multiple courses in an account.  Operates asynchronously; use the
 // {api:ProgressController#show progress endpoint} to query the status of an operation.
-func (c *Canvas) CoursesUpdateCourses(progress *task.Progress, courseIds *interface{}, event *CoursesUpdateCoursesEvent, accountID string) (*Progress, error) {
+func (c *Canvas) CoursesUpdateCourses(ctx context.Context, progress *task.Progress, courseIds *interface{}, event *CoursesUpdateCoursesEvent, accountID string) (*Progress, error) {
 	endpoint := fmt.Sprintf("accounts/%s/courses", accountID)
 	params := map[string]interface{}{}
 	if courseIds != nil {
@@ -10253,7 +10504,7 @@ func (c *Canvas) CoursesUpdateCourses(progress *task.Progress, courseIds *interf
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10261,8 +10512,8 @@ func (c *Canvas) CoursesUpdateCourses(progress *task.Progress, courseIds *interf
 
 // CoursesResetACourse API call: Deletes the current course, and creates a new equivalent course with no content, but
 // all sections and users moved over.
-func (c *Canvas) CoursesResetACourse(progress *task.Progress) (*Course, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CoursesResetACourse(ctx context.Context, progress *task.Progress, courseID string) (*Course, error) {
+	endpoint := fmt.Sprintf("courses/%s/reset_content", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &Course{}
@@ -10272,7 +10523,7 @@ func (c *Canvas) CoursesResetACourse(progress *task.Progress) (*Course, error) {
 		res = obj.(*Course)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10283,7 +10534,7 @@ func (c *Canvas) CoursesResetACourse(progress *task.Progress) (*Course, error) {
 // IDs and values as a collection containing keys representing student IDs and values representing the student's
 // effective due_at, the grading_period_id of which the due_at falls in, and whether or not the grading period is closed
 // (in_closed_grading_period)
-func (c *Canvas) CoursesGetEffectiveDueDates(progress *task.Progress, assignmentIds *string, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesGetEffectiveDueDates(ctx context.Context, progress *task.Progress, assignmentIds *string, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/effective_due_dates", courseID)
 	params := map[string]interface{}{}
 	if assignmentIds != nil {
@@ -10297,7 +10548,7 @@ func (c *Canvas) CoursesGetEffectiveDueDates(progress *task.Progress, assignment
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10305,7 +10556,7 @@ func (c *Canvas) CoursesGetEffectiveDueDates(progress *task.Progress, assignment
 
 // CoursesPermissions API call: Returns permission information for the calling user in the given course. See also the
 // {api:AccountsController#permissions Account} and {api:GroupsController#permissions Group} counterparts.
-func (c *Canvas) CoursesPermissions(progress *task.Progress, permissions *string, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) CoursesPermissions(ctx context.Context, progress *task.Progress, permissions *string, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/permissions", courseID)
 	params := map[string]interface{}{}
 	if permissions != nil {
@@ -10319,14 +10570,14 @@ func (c *Canvas) CoursesPermissions(progress *task.Progress, permissions *string
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentSecurityPolicySettingsGetCurrentSettingsForAccountOrCourse API call: Update multiple modules in an account.
-func (c *Canvas) ContentSecurityPolicySettingsGetCurrentSettingsForAccountOrCourse(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ContentSecurityPolicySettingsGetCurrentSettingsForAccountOrCourse(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10337,7 +10588,7 @@ func (c *Canvas) ContentSecurityPolicySettingsGetCurrentSettingsForAccountOrCour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10346,8 +10597,8 @@ func (c *Canvas) ContentSecurityPolicySettingsGetCurrentSettingsForAccountOrCour
 // ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSetting API call: Either explicitly sets CSP to be on or
 // off for courses and sub-accounts, or clear the explicit settings to default to those set by a parent account Note: If
 // "inherited" and "settings_locked" are both true for this account or course, then the CSP setting cannot be modified.
-func (c *Canvas) ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSetting(progress *task.Progress, status *ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSettingStatus) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSetting(ctx context.Context, progress *task.Progress, status *ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSettingStatus, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/csp_settings", courseID)
 	params := map[string]interface{}{}
 	if status != nil {
 		params["status"] = *status
@@ -10360,7 +10611,7 @@ func (c *Canvas) ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSet
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10368,8 +10619,8 @@ func (c *Canvas) ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSet
 
 // ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForSubAccountsAndCourses API call: Can only be set if CSP
 // is explicitly enabled or disabled on this account (i.e. "inherited" is false).
-func (c *Canvas) ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForSubAccountsAndCourses(progress *task.Progress, settingsLocked *bool) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForSubAccountsAndCourses(ctx context.Context, progress *task.Progress, settingsLocked *bool, accountID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("accounts/%s/csp_settings/lock", accountID)
 	params := map[string]interface{}{}
 	if settingsLocked != nil {
 		params["settings_locked"] = *settingsLocked
@@ -10382,7 +10633,7 @@ func (c *Canvas) ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForS
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10390,8 +10641,8 @@ func (c *Canvas) ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForS
 
 // ContentSecurityPolicySettingsAddADomainToAccountWhitelist API call: Adds a domain to the whitelist for the current
 // account. Note: this will not take effect unless CSP is explicitly enabled on this account.
-func (c *Canvas) ContentSecurityPolicySettingsAddADomainToAccountWhitelist(progress *task.Progress, domain *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentSecurityPolicySettingsAddADomainToAccountWhitelist(ctx context.Context, progress *task.Progress, domain *string, accountID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("accounts/%s/csp_settings/domains", accountID)
 	params := map[string]interface{}{}
 	if domain != nil {
 		params["domain"] = *domain
@@ -10404,7 +10655,7 @@ func (c *Canvas) ContentSecurityPolicySettingsAddADomainToAccountWhitelist(progr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10412,8 +10663,8 @@ func (c *Canvas) ContentSecurityPolicySettingsAddADomainToAccountWhitelist(progr
 
 // ContentSecurityPolicySettingsAddMultipleDomainsToAccountWhitelist API call: Adds multiple domains to the whitelist
 // for the current account. Note: this will not take effect unless CSP is explicitly enabled on this account.
-func (c *Canvas) ContentSecurityPolicySettingsAddMultipleDomainsToAccountWhitelist(progress *task.Progress, domains []interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentSecurityPolicySettingsAddMultipleDomainsToAccountWhitelist(ctx context.Context, progress *task.Progress, domains []interface{}, accountID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("accounts/%s/csp_settings/domains/batch_create", accountID)
 	params := map[string]interface{}{}
 	if domains != nil && len(domains) > 0 {
 		params["domains"] = domains
@@ -10426,14 +10677,14 @@ func (c *Canvas) ContentSecurityPolicySettingsAddMultipleDomainsToAccountWhiteli
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentSecurityPolicySettingsRetrieveReportedCSPViolationsForAccount API call: Must be called on a root account.
-func (c *Canvas) ContentSecurityPolicySettingsRetrieveReportedCSPViolationsForAccount(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ContentSecurityPolicySettingsRetrieveReportedCSPViolationsForAccount(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10444,7 +10695,7 @@ func (c *Canvas) ContentSecurityPolicySettingsRetrieveReportedCSPViolationsForAc
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10452,8 +10703,8 @@ func (c *Canvas) ContentSecurityPolicySettingsRetrieveReportedCSPViolationsForAc
 
 // ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist API call: Removes a domain from the whitelist for the
 // current account.
-func (c *Canvas) ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist(progress *task.Progress, domain *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist(ctx context.Context, progress *task.Progress, domain *string, accountID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("accounts/%s/csp_settings/domains", accountID)
 	params := map[string]interface{}{}
 	if domain != nil {
 		params["domain"] = *domain
@@ -10466,7 +10717,7 @@ func (c *Canvas) ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist(
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10482,7 +10733,7 @@ func (c *Canvas) ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist(
 // multipart/form-data or Content-Type application/x-www-form-urlencoded can only be used to store strings. Example PUT
 // with multipart/form-data data: curl 'https://<canvas>/api/v1/users/<user_id>/custom_data/telephone' \ -X PUT \ -F
 // 'ns=com.my-organization.canvas-app' \ -F 'data=555-1234' \ -H 'Authorization: Bearer <token>' Response: !!!javascript
-func (c *Canvas) UsersStoreCustomData(progress *task.Progress, ns *string, data map[string]interface{}, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersStoreCustomData(ctx context.Context, progress *task.Progress, ns *string, data map[string]interface{}, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/custom_data/food_app", userID)
 	params := map[string]interface{}{}
 	if ns != nil {
@@ -10499,7 +10750,7 @@ func (c *Canvas) UsersStoreCustomData(progress *task.Progress, ns *string, data
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10510,7 +10761,7 @@ func (c *Canvas) UsersStoreCustomData(progress *task.Progress, ns *string, data
 // details and examples. On success, this endpoint returns an object containing the data that was requested. Responds
 // with status code 400 if the namespace parameter, +ns+, is missing or invalid, or if the specified scope does not
 // contain any data.
-func (c *Canvas) UsersLoadCustomData(progress *task.Progress, ns *string, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersLoadCustomData(ctx context.Context, progress *task.Progress, ns *string, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/custom_data/food_app/favorites/dessert", userID)
 	params := map[string]interface{}{}
 	if ns != nil {
@@ -10524,7 +10775,7 @@ func (c *Canvas) UsersLoadCustomData(progress *task.Progress, ns *string, userID
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10537,7 +10788,7 @@ func (c *Canvas) UsersLoadCustomData(progress *task.Progress, ns *string, userID
 // valid JSON data: curl 'https://<canvas>/api/v1/users/<user_id>/custom_data' \ -X PUT \ -F
 // 'ns=com.my-organization.canvas-app' \ -F 'data[fruit][apple]=so tasty' \ -F 'data[fruit][kiwi]=a bit sour' \ -F
 // 'data[veggies][root][onion]=tear-jerking' \ -H 'Authorization: Bearer <token>' Response: !!!javascript
-func (c *Canvas) UsersDeleteCustomData(progress *task.Progress, ns *string, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersDeleteCustomData(ctx context.Context, progress *task.Progress, ns *string, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/custom_data/fruit/kiwi", userID)
 	params := map[string]interface{}{}
 	if ns != nil {
@@ -10551,7 +10802,7 @@ func (c *Canvas) UsersDeleteCustomData(progress *task.Progress, ns *string, user
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10559,7 +10810,7 @@ func (c *Canvas) UsersDeleteCustomData(progress *task.Progress, ns *string, user
 
 // CustomGradebookColumnsListEntriesForAColumn API call: This does not list entries for students without associated
 // data.
-func (c *Canvas) CustomGradebookColumnsListEntriesForAColumn(progress *task.Progress, includeHidden *bool) ([]ColumnDatum, error) {
+func (c *Canvas) CustomGradebookColumnsListEntriesForAColumn(ctx context.Context, progress *task.Progress, includeHidden *bool) ([]ColumnDatum, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if includeHidden != nil {
@@ -10574,15 +10825,15 @@ func (c *Canvas) CustomGradebookColumnsListEntriesForAColumn(progress *task.Prog
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CustomGradebookColumnsUpdateColumnData API call: Set the content of a custom column
-func (c *Canvas) CustomGradebookColumnsUpdateColumnData(progress *task.Progress, columnData *string) (*ColumnDatum, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsUpdateColumnData(ctx context.Context, progress *task.Progress, columnData *string, courseID string, id string, userID string) (*ColumnDatum, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_columns/%s/data/%s", courseID, id, userID)
 	params := map[string]interface{}{}
 	if columnData != nil {
 		params["column_data"] = *columnData
@@ -10595,15 +10846,15 @@ func (c *Canvas) CustomGradebookColumnsUpdateColumnData(progress *task.Progress,
 		res = obj.(*ColumnDatum)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CustomGradebookColumnsBulkUpdateColumnData API call: Set the content of custom columns
-func (c *Canvas) CustomGradebookColumnsBulkUpdateColumnData(progress *task.Progress, columnData []interface{}) (*Progress, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsBulkUpdateColumnData(ctx context.Context, progress *task.Progress, columnData []interface{}, courseID string) (*Progress, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_column_data", courseID)
 	params := map[string]interface{}{}
 	if columnData != nil && len(columnData) > 0 {
 		params["column_data"] = columnData
@@ -10616,7 +10867,7 @@ func (c *Canvas) CustomGradebookColumnsBulkUpdateColumnData(progress *task.Progr
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10624,7 +10875,7 @@ func (c *Canvas) CustomGradebookColumnsBulkUpdateColumnData(progress *task.Progr
 
 // CustomGradebookColumnsListCustomGradebookColumns API call: A paginated list of all custom gradebook columns for a
 // course
-func (c *Canvas) CustomGradebookColumnsListCustomGradebookColumns(progress *task.Progress, includeHidden *bool) ([]CustomColumn, error) {
+func (c *Canvas) CustomGradebookColumnsListCustomGradebookColumns(ctx context.Context, progress *task.Progress, includeHidden *bool) ([]CustomColumn, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if includeHidden != nil {
@@ -10639,15 +10890,15 @@ func (c *Canvas) CustomGradebookColumnsListCustomGradebookColumns(progress *task
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CustomGradebookColumnsCreateACustomGradebookColumn API call: Create a custom gradebook column
-func (c *Canvas) CustomGradebookColumnsCreateACustomGradebookColumn(progress *task.Progress, column *string) (*CustomColumn, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsCreateACustomGradebookColumn(ctx context.Context, progress *task.Progress, column *string, courseID string) (*CustomColumn, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_columns", courseID)
 	params := map[string]interface{}{}
 	if column != nil {
 		params["column"] = *column
@@ -10660,7 +10911,7 @@ func (c *Canvas) CustomGradebookColumnsCreateACustomGradebookColumn(progress *ta
 		res = obj.(*CustomColumn)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10668,8 +10919,8 @@ func (c *Canvas) CustomGradebookColumnsCreateACustomGradebookColumn(progress *ta
 
 // CustomGradebookColumnsUpdateACustomGradebookColumn API call: Accepts the same parameters as custom gradebook column
 // creation
-func (c *Canvas) CustomGradebookColumnsUpdateACustomGradebookColumn(progress *task.Progress) (*CustomColumn, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsUpdateACustomGradebookColumn(ctx context.Context, progress *task.Progress, courseID string, id string) (*CustomColumn, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_columns/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &CustomColumn{}
@@ -10679,7 +10930,7 @@ func (c *Canvas) CustomGradebookColumnsUpdateACustomGradebookColumn(progress *ta
 		res = obj.(*CustomColumn)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10687,8 +10938,8 @@ func (c *Canvas) CustomGradebookColumnsUpdateACustomGradebookColumn(progress *ta
 
 // CustomGradebookColumnsDeleteACustomGradebookColumn API call: Permanently deletes a custom column and its associated
 // data
-func (c *Canvas) CustomGradebookColumnsDeleteACustomGradebookColumn(progress *task.Progress) (*CustomColumn, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsDeleteACustomGradebookColumn(ctx context.Context, progress *task.Progress, courseID string, id string) (*CustomColumn, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_columns/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &CustomColumn{}
@@ -10698,15 +10949,15 @@ func (c *Canvas) CustomGradebookColumnsDeleteACustomGradebookColumn(progress *ta
 		res = obj.(*CustomColumn)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // CustomGradebookColumnsReorderCustomColumns API call: Puts the given columns in the specified order
-func (c *Canvas) CustomGradebookColumnsReorderCustomColumns(progress *task.Progress, order *int) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) CustomGradebookColumnsReorderCustomColumns(ctx context.Context, progress *task.Progress, order *int, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/custom_gradebook_columns/reorder", courseID)
 	params := map[string]interface{}{}
 	if order != nil {
 		params["order"] = *order
@@ -10719,7 +10970,7 @@ func (c *Canvas) CustomGradebookColumnsReorderCustomColumns(progress *task.Progr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10728,8 +10979,8 @@ func (c *Canvas) CustomGradebookColumnsReorderCustomColumns(progress *task.Progr
 // DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding API call: Create a new Developer Key Account Binding.
 // The developer key specified in the request URL must be available in the requested account or the requeted account's
 // account chain. If the binding already exists for the specified account/key combination it will be updated.
-func (c *Canvas) DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding(progress *task.Progress, workflowState *string) (*DeveloperKeyAccountBinding, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding(ctx context.Context, progress *task.Progress, workflowState *string, accountID string, developerKeyID string) (*DeveloperKeyAccountBinding, error) {
+	endpoint := fmt.Sprintf("accounts/%s/developer_keys/%s/developer_key_account_bindings", accountID, developerKeyID)
 	params := map[string]interface{}{}
 	if workflowState != nil {
 		params["workflow_state"] = *workflowState
@@ -10742,7 +10993,7 @@ func (c *Canvas) DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding(pr
 		res = obj.(*DeveloperKeyAccountBinding)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10750,7 +11001,7 @@ func (c *Canvas) DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding(pr
 
 // DeveloperKeyAccountBindingsListDeveloperKeyAccountBinding API call: List all Developer Key Account Bindings in the
 // requested account
-func (c *Canvas) DeveloperKeyAccountBindingsListDeveloperKeyAccountBinding(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) DeveloperKeyAccountBindingsListDeveloperKeyAccountBinding(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10761,7 +11012,7 @@ func (c *Canvas) DeveloperKeyAccountBindingsListDeveloperKeyAccountBinding(progr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10769,12 +11020,9 @@ func (c *Canvas) DeveloperKeyAccountBindingsListDeveloperKeyAccountBinding(progr
 
 // SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportToSIS API call: Disable all assignments flagged as
 // "post_to_sis", with the option of making it specific to a grading period, in a course.
-func (c *Canvas) SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportToSIS(progress *task.Progress, courseID *interface{}, gradingPeriodID *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportToSIS(ctx context.Context, progress *task.Progress, gradingPeriodID *interface{}, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("/api/sis/courses/%s/disable_post_to_sis", courseID)
 	params := map[string]interface{}{}
-	if courseID != nil {
-		params["course_id"] = *courseID
-	}
 	if gradingPeriodID != nil {
 		params["grading_period_id"] = *gradingPeriodID
 	}
@@ -10786,7 +11034,7 @@ func (c *Canvas) SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportT
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10795,7 +11043,7 @@ func (c *Canvas) SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportT
 // DiscussionTopicsUpdateAnEntry API call: Update an existing discussion entry. The entry must have been created by the
 // current user, or the current user must have admin rights to the discussion. If the edit is not allowed, a 401 will be
 // returned.
-func (c *Canvas) DiscussionTopicsUpdateAnEntry(progress *task.Progress, message *interface{}, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsUpdateAnEntry(ctx context.Context, progress *task.Progress, message *interface{}, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	if message != nil {
@@ -10809,7 +11057,7 @@ func (c *Canvas) DiscussionTopicsUpdateAnEntry(progress *task.Progress, message
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10818,7 +11066,7 @@ func (c *Canvas) DiscussionTopicsUpdateAnEntry(progress *task.Progress, message
 // DiscussionTopicsDeleteAnEntry API call: Delete a discussion entry. The entry must have been created by the current
 // user, or the current user must have admin rights to the discussion. If the delete is not allowed, a 401 will be
 // returned. The discussion will be marked deleted, and the user_id and message will be cleared out.
-func (c *Canvas) DiscussionTopicsDeleteAnEntry(progress *task.Progress, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsDeleteAnEntry(ctx context.Context, progress *task.Progress, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10829,7 +11077,7 @@ func (c *Canvas) DiscussionTopicsDeleteAnEntry(progress *task.Progress, courseID
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10837,7 +11085,7 @@ func (c *Canvas) DiscussionTopicsDeleteAnEntry(progress *task.Progress, courseID
 
 // DiscussionTopicsGetASingleTopic API call: Returns data on an individual discussion topic. See the List action for the
 // response formatting.
-func (c *Canvas) DiscussionTopicsGetASingleTopic(progress *task.Progress, include *DiscussionTopicsGetASingleTopicInclude, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsGetASingleTopic(ctx context.Context, progress *task.Progress, include *DiscussionTopicsGetASingleTopicInclude, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s", courseID, topicID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -10851,7 +11099,7 @@ func (c *Canvas) DiscussionTopicsGetASingleTopic(progress *task.Progress, includ
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10873,7 +11121,7 @@ func (c *Canvas) DiscussionTopicsGetASingleTopic(progress *task.Progress, includ
 // or updated entries won't yet be reflected in the view. If the application wants to also get a flat list of all
 // entries not yet reflected in the view, pass include_new_entries=1 to the request and this array of entries will be
 // returned. These entries are returned in a flat array, in ascending created_at order.
-func (c *Canvas) DiscussionTopicsGetTheFullTopic(progress *task.Progress, courseID string, topicID string) (*DiscussionTopicFullView, error) {
+func (c *Canvas) DiscussionTopicsGetTheFullTopic(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*DiscussionTopicFullView, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/view", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10884,7 +11132,7 @@ func (c *Canvas) DiscussionTopicsGetTheFullTopic(progress *task.Progress, course
 		res = obj.(*DiscussionTopicFullView)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10892,7 +11140,7 @@ func (c *Canvas) DiscussionTopicsGetTheFullTopic(progress *task.Progress, course
 
 // DiscussionTopicsPostAnEntry API call: Create a new entry in a discussion topic. Returns a json representation of the
 // created entry (see documentation for 'entries' method) on success.
-func (c *Canvas) DiscussionTopicsPostAnEntry(progress *task.Progress, message *interface{}, attachment *interface{}, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsPostAnEntry(ctx context.Context, progress *task.Progress, message *interface{}, attachment *interface{}, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries.json", courseID, topicID)
 	params := map[string]interface{}{}
 	if message != nil {
@@ -10909,7 +11157,7 @@ func (c *Canvas) DiscussionTopicsPostAnEntry(progress *task.Progress, message *i
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10921,7 +11169,7 @@ func (c *Canvas) DiscussionTopicsPostAnEntry(progress *task.Progress, message *i
 // replies, if any, for each entry returned. If the topic is a root topic with children corresponding to groups of a
 // group assignment, entries from those subtopics for which the user belongs to the corresponding group will be
 // returned. Ordering of returned entries is newest-first by posting timestamp (reply activity is ignored).
-func (c *Canvas) DiscussionTopicsListTopicEntries(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsListTopicEntries(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10932,7 +11180,7 @@ func (c *Canvas) DiscussionTopicsListTopicEntries(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10942,7 +11190,7 @@ func (c *Canvas) DiscussionTopicsListTopicEntries(progress *task.Progress) (*map
 // the created reply (see documentation for 'replies' method) on success. May require (depending on the topic) that the
 // user has posted in the topic. If it is required, and the user has not posted, will respond with a 403 Forbidden
 // status and the body 'require_initial_post'.
-func (c *Canvas) DiscussionTopicsPostAReply(progress *task.Progress, message *interface{}, attachment *interface{}, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsPostAReply(ctx context.Context, progress *task.Progress, message *interface{}, attachment *interface{}, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s/replies.json", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	if message != nil {
@@ -10959,7 +11207,7 @@ func (c *Canvas) DiscussionTopicsPostAReply(progress *task.Progress, message *in
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10969,7 +11217,7 @@ func (c *Canvas) DiscussionTopicsPostAReply(progress *task.Progress, message *in
 // topic. May require (depending on the topic) that the user has posted in the topic. If it is required, and the user
 // has not posted, will respond with a 403 Forbidden status and the body 'require_initial_post'. Ordering of returned
 // entries is newest-first by creation timestamp.
-func (c *Canvas) DiscussionTopicsListEntryReplies(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsListEntryReplies(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -10980,7 +11228,7 @@ func (c *Canvas) DiscussionTopicsListEntryReplies(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -10989,7 +11237,7 @@ func (c *Canvas) DiscussionTopicsListEntryReplies(progress *task.Progress) (*map
 // DiscussionTopicsListEntries API call: Retrieve a paginated list of discussion entries, given a list of ids. May
 // require (depending on the topic) that the user has posted in the topic. If it is required, and the user has not
 // posted, will respond with a 403 Forbidden status and the body 'require_initial_post'.
-func (c *Canvas) DiscussionTopicsListEntries(progress *task.Progress, ids *string, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsListEntries(ctx context.Context, progress *task.Progress, ids *string, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entry_list", courseID, topicID)
 	params := map[string]interface{}{}
 	if ids != nil {
@@ -11003,7 +11251,7 @@ func (c *Canvas) DiscussionTopicsListEntries(progress *task.Progress, ids *strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11011,7 +11259,7 @@ func (c *Canvas) DiscussionTopicsListEntries(progress *task.Progress, ids *strin
 
 // DiscussionTopicsMarkTopicAsRead API call: Mark the initial text of the discussion topic as read. No request fields
 // are necessary. On success, the response will be 204 No Content with an empty body.
-func (c *Canvas) DiscussionTopicsMarkTopicAsRead(progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkTopicAsRead(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/read.json", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11022,7 +11270,7 @@ func (c *Canvas) DiscussionTopicsMarkTopicAsRead(progress *task.Progress, course
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11030,7 +11278,7 @@ func (c *Canvas) DiscussionTopicsMarkTopicAsRead(progress *task.Progress, course
 
 // DiscussionTopicsMarkTopicAsUnread API call: Mark the initial text of the discussion topic as unread. No request
 // fields are necessary. On success, the response will be 204 No Content with an empty body.
-func (c *Canvas) DiscussionTopicsMarkTopicAsUnread(progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkTopicAsUnread(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/read.json", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11041,7 +11289,7 @@ func (c *Canvas) DiscussionTopicsMarkTopicAsUnread(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11049,7 +11297,7 @@ func (c *Canvas) DiscussionTopicsMarkTopicAsUnread(progress *task.Progress, cour
 
 // DiscussionTopicsMarkAllEntriesAsRead API call: Mark the discussion topic and all its entries as read. No request
 // fields are necessary.
-func (c *Canvas) DiscussionTopicsMarkAllEntriesAsRead(progress *task.Progress, forcedReadState *bool, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkAllEntriesAsRead(ctx context.Context, progress *task.Progress, forcedReadState *bool, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/read_all.json", courseID, topicID)
 	params := map[string]interface{}{}
 	if forcedReadState != nil {
@@ -11063,7 +11311,7 @@ func (c *Canvas) DiscussionTopicsMarkAllEntriesAsRead(progress *task.Progress, f
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11071,7 +11319,7 @@ func (c *Canvas) DiscussionTopicsMarkAllEntriesAsRead(progress *task.Progress, f
 
 // DiscussionTopicsMarkAllEntriesAsUnread API call: Mark the discussion topic and all its entries as unread. No request
 // fields are necessary.
-func (c *Canvas) DiscussionTopicsMarkAllEntriesAsUnread(progress *task.Progress, forcedReadState *bool, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkAllEntriesAsUnread(ctx context.Context, progress *task.Progress, forcedReadState *bool, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/read_all.json", courseID, topicID)
 	params := map[string]interface{}{}
 	if forcedReadState != nil {
@@ -11085,14 +11333,14 @@ func (c *Canvas) DiscussionTopicsMarkAllEntriesAsUnread(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // DiscussionTopicsMarkEntryAsRead API call: Mark a discussion entry as read. No request fields are necessary.
-func (c *Canvas) DiscussionTopicsMarkEntryAsRead(progress *task.Progress, forcedReadState *bool, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkEntryAsRead(ctx context.Context, progress *task.Progress, forcedReadState *bool, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s/read.json", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	if forcedReadState != nil {
@@ -11106,14 +11354,14 @@ func (c *Canvas) DiscussionTopicsMarkEntryAsRead(progress *task.Progress, forced
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // DiscussionTopicsMarkEntryAsUnread API call: Mark a discussion entry as unread. No request fields are necessary.
-func (c *Canvas) DiscussionTopicsMarkEntryAsUnread(progress *task.Progress, forcedReadState *bool, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsMarkEntryAsUnread(ctx context.Context, progress *task.Progress, forcedReadState *bool, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s/read.json", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	if forcedReadState != nil {
@@ -11127,14 +11375,14 @@ func (c *Canvas) DiscussionTopicsMarkEntryAsUnread(progress *task.Progress, forc
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // DiscussionTopicsRateEntry API call: Rate a discussion entry.
-func (c *Canvas) DiscussionTopicsRateEntry(progress *task.Progress, rating *int, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsRateEntry(ctx context.Context, progress *task.Progress, rating *int, courseID string, topicID string, entryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/entries/%s/rating.json", courseID, topicID, entryID)
 	params := map[string]interface{}{}
 	if rating != nil {
@@ -11148,7 +11396,7 @@ func (c *Canvas) DiscussionTopicsRateEntry(progress *task.Progress, rating *int,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11156,7 +11404,7 @@ func (c *Canvas) DiscussionTopicsRateEntry(progress *task.Progress, rating *int,
 
 // DiscussionTopicsSubscribeToATopic API call: Subscribe to a topic to receive notifications about new entries On
 // success, the response will be 204 No Content with an empty body
-func (c *Canvas) DiscussionTopicsSubscribeToATopic(progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsSubscribeToATopic(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/subscribed.json", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11167,7 +11415,7 @@ func (c *Canvas) DiscussionTopicsSubscribeToATopic(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11175,7 +11423,7 @@ func (c *Canvas) DiscussionTopicsSubscribeToATopic(progress *task.Progress, cour
 
 // DiscussionTopicsUnsubscribeFromATopic API call: Unsubscribe from a topic to stop receiving notifications about new
 // entries On success, the response will be 204 No Content with an empty body
-func (c *Canvas) DiscussionTopicsUnsubscribeFromATopic(progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsUnsubscribeFromATopic(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s/subscribed.json", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11186,7 +11434,7 @@ func (c *Canvas) DiscussionTopicsUnsubscribeFromATopic(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11194,7 +11442,7 @@ func (c *Canvas) DiscussionTopicsUnsubscribeFromATopic(progress *task.Progress,
 
 // DiscussionTopicsListDiscussionTopics API call: Returns the paginated list of discussion topics for this course or
 // group.
-func (c *Canvas) DiscussionTopicsListDiscussionTopics(progress *task.Progress, include *DiscussionTopicsListDiscussionTopicsInclude, orderBy *DiscussionTopicsListDiscussionTopicsOrderBy, scope *DiscussionTopicsListDiscussionTopicsScope, onlyAnnouncements *bool, filterBy *DiscussionTopicsListDiscussionTopicsFilterBy, searchTerm *string, excludeContextModuleLockedTopics *bool, courseID string) ([]DiscussionTopic, error) {
+func (c *Canvas) DiscussionTopicsListDiscussionTopics(ctx context.Context, progress *task.Progress, include *DiscussionTopicsListDiscussionTopicsInclude, orderBy *DiscussionTopicsListDiscussionTopicsOrderBy, scope *DiscussionTopicsListDiscussionTopicsScope, onlyAnnouncements *bool, filterBy *DiscussionTopicsListDiscussionTopicsFilterBy, searchTerm *string, excludeContextModuleLockedTopics *bool, courseID string) ([]DiscussionTopic, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics", courseID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -11227,14 +11475,14 @@ func (c *Canvas) DiscussionTopicsListDiscussionTopics(progress *task.Progress, i
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // DiscussionTopicsCreateANewDiscussionTopic API call: Create an new discussion topic for the course or group.
-func (c *Canvas) DiscussionTopicsCreateANewDiscussionTopic(progress *task.Progress, title *string, message *string, discussionType *DiscussionTopicsCreateANewDiscussionTopicDiscussionType, published *bool, delayedPostAt *time.Time, allowRating *bool, lockAt *time.Time, podcastEnabled *bool, podcastHasStudentPosts *bool, requireInitialPost *bool, assignment *Assignment, isAnnouncement *bool, pinned *bool, positionAfter *string, groupCategoryID *int, onlyGradersCanRate *bool, sortByRating *bool, attachment *File, specificSections *string, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsCreateANewDiscussionTopic(ctx context.Context, progress *task.Progress, title *string, message *string, discussionType *DiscussionTopicsCreateANewDiscussionTopicDiscussionType, published *bool, delayedPostAt *time.Time, allowRating *bool, lockAt *time.Time, podcastEnabled *bool, podcastHasStudentPosts *bool, requireInitialPost *bool, assignment *Assignment, isAnnouncement *bool, pinned *bool, positionAfter *string, groupCategoryID *int, onlyGradersCanRate *bool, sortByRating *bool, attachment *File, specificSections *string, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics", courseID)
 	params := map[string]interface{}{}
 	if title != nil {
@@ -11302,14 +11550,14 @@ func (c *Canvas) DiscussionTopicsCreateANewDiscussionTopic(progress *task.Progre
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // DiscussionTopicsUpdateATopic API call: Update an existing discussion topic for the course or group.
-func (c *Canvas) DiscussionTopicsUpdateATopic(progress *task.Progress, title *string, message *string, discussionType *DiscussionTopicsUpdateATopicDiscussionType, published *bool, delayedPostAt *time.Time, lockAt *time.Time, podcastEnabled *bool, podcastHasStudentPosts *bool, requireInitialPost *bool, assignment *Assignment, isAnnouncement *bool, pinned *bool, positionAfter *string, groupCategoryID *int, allowRating *bool, onlyGradersCanRate *bool, sortByRating *bool, specificSections *string, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsUpdateATopic(ctx context.Context, progress *task.Progress, title *string, message *string, discussionType *DiscussionTopicsUpdateATopicDiscussionType, published *bool, delayedPostAt *time.Time, lockAt *time.Time, podcastEnabled *bool, podcastHasStudentPosts *bool, requireInitialPost *bool, assignment *Assignment, isAnnouncement *bool, pinned *bool, positionAfter *string, groupCategoryID *int, allowRating *bool, onlyGradersCanRate *bool, sortByRating *bool, specificSections *string, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s", courseID, topicID)
 	params := map[string]interface{}{}
 	if title != nil {
@@ -11374,7 +11622,7 @@ func (c *Canvas) DiscussionTopicsUpdateATopic(progress *task.Progress, title *st
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11382,7 +11630,7 @@ func (c *Canvas) DiscussionTopicsUpdateATopic(progress *task.Progress, title *st
 
 // DiscussionTopicsDeleteATopic API call: Deletes the discussion topic. This will also delete the assignment, if it's an
 // assignment discussion.
-func (c *Canvas) DiscussionTopicsDeleteATopic(progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
+func (c *Canvas) DiscussionTopicsDeleteATopic(ctx context.Context, progress *task.Progress, courseID string, topicID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/discussion_topics/%s", courseID, topicID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11393,7 +11641,7 @@ func (c *Canvas) DiscussionTopicsDeleteATopic(progress *task.Progress, courseID
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11401,8 +11649,8 @@ func (c *Canvas) DiscussionTopicsDeleteATopic(progress *task.Progress, courseID
 
 // DiscussionTopicsReorderPinnedTopics API call: Puts the pinned discussion topics in the specified order. All pinned
 // topics should be included.
-func (c *Canvas) DiscussionTopicsReorderPinnedTopics(progress *task.Progress, order *int) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) DiscussionTopicsReorderPinnedTopics(ctx context.Context, progress *task.Progress, order *int, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/discussion_topics/reorder", courseID)
 	params := map[string]interface{}{}
 	if order != nil {
 		params["order"] = *order
@@ -11415,7 +11663,7 @@ func (c *Canvas) DiscussionTopicsReorderPinnedTopics(progress *task.Progress, or
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11426,7 +11674,7 @@ func (c *Canvas) DiscussionTopicsReorderPinnedTopics(progress *task.Progress, or
 // student, teacher, TA, and observer enrollments. If a user has multiple enrollments in a context (e.g. as a teacher
 // and a student or in multiple course sections), each enrollment will be listed separately. note: Currently, only a
 // root level admin user can return other users' enrollments. A user can, however, return his/her own enrollments.
-func (c *Canvas) EnrollmentsListEnrollments(progress *task.Progress, typeName *string, role *string, state *EnrollmentsListEnrollmentsState, include *EnrollmentsListEnrollmentsInclude, userID *string, gradingPeriodID *int, enrollmentTermID *int, sisAccountID *string, sisCourseID *string, sisSectionID *string, sisUserID *string, createdForSisID *bool) ([]Enrollment, error) {
+func (c *Canvas) EnrollmentsListEnrollments(ctx context.Context, progress *task.Progress, typeName *string, role *string, state *EnrollmentsListEnrollmentsState, include *EnrollmentsListEnrollmentsInclude, userID *string, gradingPeriodID *int, enrollmentTermID *int, sisAccountID *string, sisCourseID *string, sisSectionID *string, sisUserID *string, createdForSisID *bool) ([]Enrollment, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if typeName != nil {
@@ -11474,14 +11722,14 @@ func (c *Canvas) EnrollmentsListEnrollments(progress *task.Progress, typeName *s
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsEnrollmentByID API call: Get an Enrollment object by Enrollment ID
-func (c *Canvas) EnrollmentsEnrollmentByID(progress *task.Progress, id *int) (*Enrollment, error) {
+func (c *Canvas) EnrollmentsEnrollmentByID(ctx context.Context, progress *task.Progress, id *int) (*Enrollment, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if id != nil {
@@ -11495,14 +11743,14 @@ func (c *Canvas) EnrollmentsEnrollmentByID(progress *task.Progress, id *int) (*E
 		res = obj.(*Enrollment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsEnrollAUser API call: Create a new user enrollment for a course or section.
-func (c *Canvas) EnrollmentsEnrollAUser(progress *task.Progress, enrollment *string) (*Enrollment, error) {
+func (c *Canvas) EnrollmentsEnrollAUser(ctx context.Context, progress *task.Progress, enrollment *string) (*Enrollment, error) {
 	endpoint := fmt.Sprintf("courses/:course_id/enrollments")
 	params := map[string]interface{}{}
 	if enrollment != nil {
@@ -11516,7 +11764,7 @@ func (c *Canvas) EnrollmentsEnrollAUser(progress *task.Progress, enrollment *str
 		res = obj.(*Enrollment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11524,7 +11772,7 @@ func (c *Canvas) EnrollmentsEnrollAUser(progress *task.Progress, enrollment *str
 
 // EnrollmentsConcludeDeactivateOrDeleteAnEnrollment API call: Conclude, deactivate, or delete an enrollment. If the
 // +task+ argument isn't given, the enrollment will be concluded.
-func (c *Canvas) EnrollmentsConcludeDeactivateOrDeleteAnEnrollment(progress *task.Progress, task *EnrollmentsConcludeDeactivateOrDeleteAnEnrollmentTask) (*Enrollment, error) {
+func (c *Canvas) EnrollmentsConcludeDeactivateOrDeleteAnEnrollment(ctx context.Context, progress *task.Progress, task *EnrollmentsConcludeDeactivateOrDeleteAnEnrollmentTask) (*Enrollment, error) {
 	endpoint := fmt.Sprintf("courses/:course_id/enrollments/:enrollment_id")
 	params := map[string]interface{}{}
 	if task != nil {
@@ -11538,14 +11786,14 @@ func (c *Canvas) EnrollmentsConcludeDeactivateOrDeleteAnEnrollment(progress *tas
 		res = obj.(*Enrollment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsAcceptCourseInvitation API call: accepts a pending course invitation for the current user
-func (c *Canvas) EnrollmentsAcceptCourseInvitation(progress *task.Progress, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) EnrollmentsAcceptCourseInvitation(ctx context.Context, progress *task.Progress, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/enrollments/:id/accept", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11556,14 +11804,14 @@ func (c *Canvas) EnrollmentsAcceptCourseInvitation(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsRejectCourseInvitation API call: rejects a pending course invitation for the current user
-func (c *Canvas) EnrollmentsRejectCourseInvitation(progress *task.Progress, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) EnrollmentsRejectCourseInvitation(ctx context.Context, progress *task.Progress, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/enrollments/:id/reject", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11574,14 +11822,14 @@ func (c *Canvas) EnrollmentsRejectCourseInvitation(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsReActivateAnEnrollment API call: Activates an inactive enrollment
-func (c *Canvas) EnrollmentsReActivateAnEnrollment(progress *task.Progress) (*Enrollment, error) {
+func (c *Canvas) EnrollmentsReActivateAnEnrollment(ctx context.Context, progress *task.Progress) (*Enrollment, error) {
 	endpoint := fmt.Sprintf("courses/:course_id/enrollments/:enrollment_id/reactivate")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11592,14 +11840,14 @@ func (c *Canvas) EnrollmentsReActivateAnEnrollment(progress *task.Progress) (*En
 		res = obj.(*Enrollment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentsAddsLastAttendedDateToStudentEnrollmentInCourse API call
-func (c *Canvas) EnrollmentsAddsLastAttendedDateToStudentEnrollmentInCourse(progress *task.Progress) (*Enrollment, error) {
+func (c *Canvas) EnrollmentsAddsLastAttendedDateToStudentEnrollmentInCourse(ctx context.Context, progress *task.Progress) (*Enrollment, error) {
 	endpoint := fmt.Sprintf("courses/:course_id/user/:user_id/last_attended")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11610,7 +11858,7 @@ func (c *Canvas) EnrollmentsAddsLastAttendedDateToStudentEnrollmentInCourse(prog
 		res = obj.(*Enrollment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11618,7 +11866,7 @@ func (c *Canvas) EnrollmentsAddsLastAttendedDateToStudentEnrollmentInCourse(prog
 
 // EPubExportsListCoursesWithTheirLatestEPubExport API call: A paginated list of all courses a user is actively
 // participating in, and the latest ePub export associated with the user & course.
-func (c *Canvas) EPubExportsListCoursesWithTheirLatestEPubExport(progress *task.Progress) ([]CourseEpubExport, error) {
+func (c *Canvas) EPubExportsListCoursesWithTheirLatestEPubExport(ctx context.Context, progress *task.Progress) ([]CourseEpubExport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11630,7 +11878,7 @@ func (c *Canvas) EPubExportsListCoursesWithTheirLatestEPubExport(progress *task.
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11640,8 +11888,8 @@ func (c *Canvas) EPubExportsListCoursesWithTheirLatestEPubExport(progress *task.
 // Progress API} to track the progress of the export. The export's progress is linked to with the _progress_url_ value.
 // When the export completes, use the {api:EpubExportsController#show Show content export} endpoint to retrieve a
 // download URL for the exported content.
-func (c *Canvas) EPubExportsCreateEPubExport(progress *task.Progress) (*EpubExport, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) EPubExportsCreateEPubExport(ctx context.Context, progress *task.Progress, courseID string) (*EpubExport, error) {
+	endpoint := fmt.Sprintf("courses/%s/epub_exports", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &EpubExport{}
@@ -11651,14 +11899,14 @@ func (c *Canvas) EPubExportsCreateEPubExport(progress *task.Progress) (*EpubExpo
 		res = obj.(*EpubExport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EPubExportsShowEPubExport API call: Get information about a single ePub export.
-func (c *Canvas) EPubExportsShowEPubExport(progress *task.Progress) (*EpubExport, error) {
+func (c *Canvas) EPubExportsShowEPubExport(ctx context.Context, progress *task.Progress) (*EpubExport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11669,7 +11917,7 @@ func (c *Canvas) EPubExportsShowEPubExport(progress *task.Progress) (*EpubExport
 		res = obj.(*EpubExport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11677,7 +11925,7 @@ func (c *Canvas) EPubExportsShowEPubExport(progress *task.Progress) (*EpubExport
 
 // ErrorReportsCreateErrorReport API call: Create a new error report documenting an experienced problem Performs the
 // same action as when a user uses the "help -> report a problem" dialog.
-func (c *Canvas) ErrorReportsCreateErrorReport(progress *task.Progress, err *string) (*map[string]interface{}, error) {
+func (c *Canvas) ErrorReportsCreateErrorReport(ctx context.Context, progress *task.Progress, err *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("error_reports")
 	params := map[string]interface{}{}
 	if err != nil {
@@ -11691,7 +11939,7 @@ func (c *Canvas) ErrorReportsCreateErrorReport(progress *task.Progress, err *str
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11699,7 +11947,7 @@ func (c *Canvas) ErrorReportsCreateErrorReport(progress *task.Progress, err *str
 
 // AnnouncementExternalFeedsListExternalFeeds API call: Returns the paginated list of External Feeds this course or
 // group.
-func (c *Canvas) AnnouncementExternalFeedsListExternalFeeds(progress *task.Progress, courseID string) ([]ExternalFeed, error) {
+func (c *Canvas) AnnouncementExternalFeedsListExternalFeeds(ctx context.Context, progress *task.Progress, courseID string) ([]ExternalFeed, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_feeds", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11711,14 +11959,14 @@ func (c *Canvas) AnnouncementExternalFeedsListExternalFeeds(progress *task.Progr
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AnnouncementExternalFeedsCreateAnExternalFeed API call: Create a new external feed for the course or group.
-func (c *Canvas) AnnouncementExternalFeedsCreateAnExternalFeed(progress *task.Progress, url *string, headerMatch *bool, verbosity *AnnouncementExternalFeedsCreateAnExternalFeedVerbosity, courseID string) (*ExternalFeed, error) {
+func (c *Canvas) AnnouncementExternalFeedsCreateAnExternalFeed(ctx context.Context, progress *task.Progress, url *string, headerMatch *bool, verbosity *AnnouncementExternalFeedsCreateAnExternalFeedVerbosity, courseID string) (*ExternalFeed, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_feeds", courseID)
 	params := map[string]interface{}{}
 	if url != nil {
@@ -11738,14 +11986,14 @@ func (c *Canvas) AnnouncementExternalFeedsCreateAnExternalFeed(progress *task.Pr
 		res = obj.(*ExternalFeed)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AnnouncementExternalFeedsDeleteAnExternalFeed API call: Deletes the external feed.
-func (c *Canvas) AnnouncementExternalFeedsDeleteAnExternalFeed(progress *task.Progress, courseID string, feedID string) (*ExternalFeed, error) {
+func (c *Canvas) AnnouncementExternalFeedsDeleteAnExternalFeed(ctx context.Context, progress *task.Progress, courseID string, feedID string) (*ExternalFeed, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_feeds/%s", courseID, feedID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11756,7 +12004,7 @@ func (c *Canvas) AnnouncementExternalFeedsDeleteAnExternalFeed(progress *task.Pr
 		res = obj.(*ExternalFeed)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11764,7 +12012,7 @@ func (c *Canvas) AnnouncementExternalFeedsDeleteAnExternalFeed(progress *task.Pr
 
 // ExternalToolsListExternalTools API call: Returns the paginated list of external tools for the current context. See
 // the get request docs for a single tool for a list of properties on an external tool.
-func (c *Canvas) ExternalToolsListExternalTools(progress *task.Progress, searchTerm *string, selectable *bool, includeParents *bool) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsListExternalTools(ctx context.Context, progress *task.Progress, searchTerm *string, selectable *bool, includeParents *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -11784,7 +12032,7 @@ func (c *Canvas) ExternalToolsListExternalTools(progress *task.Progress, searchT
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11792,7 +12040,7 @@ func (c *Canvas) ExternalToolsListExternalTools(progress *task.Progress, searchT
 
 // ExternalToolsGetASessionlessLaunchURLForAnExternalTool API call: Returns a sessionless launch url for an external
 // tool. NOTE: Either the id or url must be provided unless launch_type is assessment or module_item.
-func (c *Canvas) ExternalToolsGetASessionlessLaunchURLForAnExternalTool(progress *task.Progress, id *string, url *string, assignmentID *string, moduleItemID *string, launchType *ExternalToolsGetASessionlessLaunchURLForAnExternalToolLaunchType, courseID string) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsGetASessionlessLaunchURLForAnExternalTool(ctx context.Context, progress *task.Progress, id *string, url *string, assignmentID *string, moduleItemID *string, launchType *ExternalToolsGetASessionlessLaunchURLForAnExternalToolLaunchType, courseID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_tools/sessionless_launch", courseID)
 	params := map[string]interface{}{}
 	if id != nil {
@@ -11818,14 +12066,14 @@ func (c *Canvas) ExternalToolsGetASessionlessLaunchURLForAnExternalTool(progress
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ExternalToolsGetASingleExternalTool API call: Returns the specified external tool.
-func (c *Canvas) ExternalToolsGetASingleExternalTool(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsGetASingleExternalTool(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11836,7 +12084,7 @@ func (c *Canvas) ExternalToolsGetASingleExternalTool(progress *task.Progress) (*
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11845,7 +12093,7 @@ func (c *Canvas) ExternalToolsGetASingleExternalTool(progress *task.Progress) (*
 // ExternalToolsCreateAnExternalTool API call: Create an external tool in the specified course/account. The created tool
 // will be returned, see the "show" endpoint for an example. If a client ID is supplied canvas will attempt to create a
 // context external tool using the LTI 1.3 standard.
-func (c *Canvas) ExternalToolsCreateAnExternalTool(progress *task.Progress, clientID *string, name *string, privacyLevel *ExternalToolsCreateAnExternalToolPrivacyLevel, consumerKey *string, sharedSecret *string, description *string, url *string, domain *string, iconURL *string, text *string, customFields *string, accountNavigation *string, userNavigation *string, courseHomeSubNavigation *string, courseNavigation *bool, editorButton *string, homeworkSubmission *string, linkSelection *string, migrationSelection *string, toolConfiguration *string, resourceSelection *string, configType *string, configXML *string, configURL *string, notSelectable *bool, oauthCompliant *bool, accountID string) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsCreateAnExternalTool(ctx context.Context, progress *task.Progress, clientID *string, name *string, privacyLevel *ExternalToolsCreateAnExternalToolPrivacyLevel, consumerKey *string, sharedSecret *string, description *string, url *string, domain *string, iconURL *string, text *string, customFields *string, accountNavigation *string, userNavigation *string, courseHomeSubNavigation *string, courseNavigation *bool, editorButton *string, homeworkSubmission *string, linkSelection *string, migrationSelection *string, toolConfiguration *string, resourceSelection *string, configType *string, configXML *string, configURL *string, notSelectable *bool, oauthCompliant *bool, accountID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/%s/external_tools", accountID)
 	params := map[string]interface{}{}
 	if clientID != nil {
@@ -11934,14 +12182,14 @@ func (c *Canvas) ExternalToolsCreateAnExternalTool(progress *task.Progress, clie
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ExternalToolsEditAnExternalTool API call: Update the specified external tool. Uses same parameters as create
-func (c *Canvas) ExternalToolsEditAnExternalTool(progress *task.Progress, courseID string, externalToolID string) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsEditAnExternalTool(ctx context.Context, progress *task.Progress, courseID string, externalToolID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_tools/%s", courseID, externalToolID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11952,14 +12200,14 @@ func (c *Canvas) ExternalToolsEditAnExternalTool(progress *task.Progress, course
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ExternalToolsDeleteAnExternalTool API call: Remove the specified external tool
-func (c *Canvas) ExternalToolsDeleteAnExternalTool(progress *task.Progress, courseID string, externalToolID string) (*map[string]interface{}, error) {
+func (c *Canvas) ExternalToolsDeleteAnExternalTool(ctx context.Context, progress *task.Progress, courseID string, externalToolID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/external_tools/%s", courseID, externalToolID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -11970,7 +12218,7 @@ func (c *Canvas) ExternalToolsDeleteAnExternalTool(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -11978,7 +12226,7 @@ func (c *Canvas) ExternalToolsDeleteAnExternalTool(progress *task.Progress, cour
 
 // FavoritesListFavoriteCourses API call: Retrieve the paginated list of favorite courses for the current user. If the
 // user has not chosen any favorites, then a selection of currently enrolled courses will be returned.
-func (c *Canvas) FavoritesListFavoriteCourses(progress *task.Progress, excludeBlueprintCourses *bool) ([]Course, error) {
+func (c *Canvas) FavoritesListFavoriteCourses(ctx context.Context, progress *task.Progress, excludeBlueprintCourses *bool) ([]Course, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/courses")
 	params := map[string]interface{}{}
 	if excludeBlueprintCourses != nil {
@@ -11993,7 +12241,7 @@ func (c *Canvas) FavoritesListFavoriteCourses(progress *task.Progress, excludeBl
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12001,7 +12249,7 @@ func (c *Canvas) FavoritesListFavoriteCourses(progress *task.Progress, excludeBl
 
 // FavoritesListFavoriteGroups API call: Retrieve the paginated list of favorite groups for the current user. If the
 // user has not chosen any favorites, then a selection of groups that the user is a member of will be returned.
-func (c *Canvas) FavoritesListFavoriteGroups(progress *task.Progress) ([]Group, error) {
+func (c *Canvas) FavoritesListFavoriteGroups(ctx context.Context, progress *task.Progress) ([]Group, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/groups")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12013,7 +12261,7 @@ func (c *Canvas) FavoritesListFavoriteGroups(progress *task.Progress) ([]Group,
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12021,7 +12269,7 @@ func (c *Canvas) FavoritesListFavoriteGroups(progress *task.Progress) ([]Group,
 
 // FavoritesAddCourseToFavorites API call: Add a course to the current user's favorites.  If the course is already in
 // the user's favorites, nothing happens.
-func (c *Canvas) FavoritesAddCourseToFavorites(progress *task.Progress, id *string) (*Favorite, error) {
+func (c *Canvas) FavoritesAddCourseToFavorites(ctx context.Context, progress *task.Progress, id *string) (*Favorite, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/courses/1170")
 	params := map[string]interface{}{}
 	if id != nil {
@@ -12035,7 +12283,7 @@ func (c *Canvas) FavoritesAddCourseToFavorites(progress *task.Progress, id *stri
 		res = obj.(*Favorite)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12043,7 +12291,7 @@ func (c *Canvas) FavoritesAddCourseToFavorites(progress *task.Progress, id *stri
 
 // FavoritesAddGroupToFavorites API call: Add a group to the current user's favorites.  If the group is already in the
 // user's favorites, nothing happens.
-func (c *Canvas) FavoritesAddGroupToFavorites(progress *task.Progress, id *string) (*Favorite, error) {
+func (c *Canvas) FavoritesAddGroupToFavorites(ctx context.Context, progress *task.Progress, id *string) (*Favorite, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/group/1170")
 	params := map[string]interface{}{}
 	if id != nil {
@@ -12057,14 +12305,14 @@ func (c *Canvas) FavoritesAddGroupToFavorites(progress *task.Progress, id *strin
 		res = obj.(*Favorite)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FavoritesRemoveCourseFromFavorites API call: Remove a course from the current user's favorites.
-func (c *Canvas) FavoritesRemoveCourseFromFavorites(progress *task.Progress, id *string) (*Favorite, error) {
+func (c *Canvas) FavoritesRemoveCourseFromFavorites(ctx context.Context, progress *task.Progress, id *string) (*Favorite, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/courses/1170")
 	params := map[string]interface{}{}
 	if id != nil {
@@ -12078,14 +12326,14 @@ func (c *Canvas) FavoritesRemoveCourseFromFavorites(progress *task.Progress, id
 		res = obj.(*Favorite)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FavoritesRemoveGroupFromFavorites API call: Remove a group from the current user's favorites.
-func (c *Canvas) FavoritesRemoveGroupFromFavorites(progress *task.Progress, id *string) (*Favorite, error) {
+func (c *Canvas) FavoritesRemoveGroupFromFavorites(ctx context.Context, progress *task.Progress, id *string) (*Favorite, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/groups/1170")
 	params := map[string]interface{}{}
 	if id != nil {
@@ -12099,7 +12347,7 @@ func (c *Canvas) FavoritesRemoveGroupFromFavorites(progress *task.Progress, id *
 		res = obj.(*Favorite)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12107,7 +12355,7 @@ func (c *Canvas) FavoritesRemoveGroupFromFavorites(progress *task.Progress, id *
 
 // FavoritesResetCourseFavorites API call: Reset the current user's course favorites to the default automatically
 // generated list of enrolled courses
-func (c *Canvas) FavoritesResetCourseFavorites(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) FavoritesResetCourseFavorites(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/courses")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12118,7 +12366,7 @@ func (c *Canvas) FavoritesResetCourseFavorites(progress *task.Progress) (*map[st
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12126,7 +12374,7 @@ func (c *Canvas) FavoritesResetCourseFavorites(progress *task.Progress) (*map[st
 
 // FavoritesResetGroupFavorites API call: Reset the current user's group favorites to the default automatically
 // generated list of enrolled group
-func (c *Canvas) FavoritesResetGroupFavorites(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) FavoritesResetGroupFavorites(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/favorites/group")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12137,14 +12385,14 @@ func (c *Canvas) FavoritesResetGroupFavorites(progress *task.Progress) (*map[str
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FeatureFlagsListFeatures API call: A paginated list of all features that apply to a given Account, Course, or User.
-func (c *Canvas) FeatureFlagsListFeatures(progress *task.Progress) ([]Feature, error) {
+func (c *Canvas) FeatureFlagsListFeatures(ctx context.Context, progress *task.Progress) ([]Feature, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12156,7 +12404,7 @@ func (c *Canvas) FeatureFlagsListFeatures(progress *task.Progress) ([]Feature, e
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12164,7 +12412,7 @@ func (c *Canvas) FeatureFlagsListFeatures(progress *task.Progress) ([]Feature, e
 
 // FeatureFlagsListEnabledFeatures API call: A paginated list of all features that are enabled on a given Account,
 // Course, or User. Only the feature names are returned.
-func (c *Canvas) FeatureFlagsListEnabledFeatures(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) FeatureFlagsListEnabledFeatures(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12175,7 +12423,7 @@ func (c *Canvas) FeatureFlagsListEnabledFeatures(progress *task.Progress) (*map[
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12185,7 +12433,7 @@ func (c *Canvas) FeatureFlagsListEnabledFeatures(progress *task.Progress) (*map[
 // may be defined on the object, or it may be inherited from a parent account. You can look at the context_id and
 // context_type of the returned object to determine which is the case. If these fields are missing, then the object is
 // the global Canvas default.
-func (c *Canvas) FeatureFlagsGetFeatureFlag(progress *task.Progress) (*FeatureFlag, error) {
+func (c *Canvas) FeatureFlagsGetFeatureFlag(ctx context.Context, progress *task.Progress) (*FeatureFlag, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12196,7 +12444,7 @@ func (c *Canvas) FeatureFlagsGetFeatureFlag(progress *task.Progress) (*FeatureFl
 		res = obj.(*FeatureFlag)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12204,8 +12452,8 @@ func (c *Canvas) FeatureFlagsGetFeatureFlag(progress *task.Progress) (*FeatureFl
 
 // FeatureFlagsSetFeatureFlag API call: Set a feature flag for a given Account, Course, or User. This call will fail if
 // a parent account sets a feature flag for the same feature in any state other than "allowed".
-func (c *Canvas) FeatureFlagsSetFeatureFlag(progress *task.Progress, state *FeatureFlagsSetFeatureFlagState) (*FeatureFlag, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) FeatureFlagsSetFeatureFlag(ctx context.Context, progress *task.Progress, state *FeatureFlagsSetFeatureFlagState, courseID string, feature string) (*FeatureFlag, error) {
+	endpoint := fmt.Sprintf("courses/%s/features/flags/%s", courseID, feature)
 	params := map[string]interface{}{}
 	if state != nil {
 		params["state"] = *state
@@ -12218,7 +12466,7 @@ func (c *Canvas) FeatureFlagsSetFeatureFlag(progress *task.Progress, state *Feat
 		res = obj.(*FeatureFlag)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12228,8 +12476,8 @@ func (c *Canvas) FeatureFlagsSetFeatureFlag(progress *task.Progress, state *Feat
 // flag must be defined on the Account, Course, or User directly.)  The object will then inherit the feature flags from
 // a higher account, if any exist.  If this flag was 'on' or 'off', then lower-level account flags that were masked by
 // this one will apply again.
-func (c *Canvas) FeatureFlagsRemoveFeatureFlag(progress *task.Progress) (*FeatureFlag, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) FeatureFlagsRemoveFeatureFlag(ctx context.Context, progress *task.Progress, courseID string, feature string) (*FeatureFlag, error) {
+	endpoint := fmt.Sprintf("courses/%s/features/flags/%s", courseID, feature)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &FeatureFlag{}
@@ -12239,14 +12487,14 @@ func (c *Canvas) FeatureFlagsRemoveFeatureFlag(progress *task.Progress) (*Featur
 		res = obj.(*FeatureFlag)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesGetQuotaInformation API call: Returns the total and used storage quota for the course, group, or user.
-func (c *Canvas) FilesGetQuotaInformation(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) FilesGetQuotaInformation(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/1/files/quota")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12257,14 +12505,14 @@ func (c *Canvas) FilesGetQuotaInformation(progress *task.Progress) (*map[string]
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesListFiles API call: Returns the paginated list of files for the folder or course.
-func (c *Canvas) FilesListFiles(progress *task.Progress, contentTypes *string, excludeContentTypes *string, searchTerm *string, include *FilesListFilesInclude, only []interface{}, sort *FilesListFilesSort, order *FilesListFilesOrder, courseID string) ([]File, error) {
+func (c *Canvas) FilesListFiles(ctx context.Context, progress *task.Progress, contentTypes *string, excludeContentTypes *string, searchTerm *string, include *FilesListFilesInclude, only []interface{}, sort *FilesListFilesSort, order *FilesListFilesOrder, courseID string) ([]File, error) {
 	endpoint := fmt.Sprintf("courses/%s/files", courseID)
 	params := map[string]interface{}{}
 	if contentTypes != nil {
@@ -12297,14 +12545,14 @@ func (c *Canvas) FilesListFiles(progress *task.Progress, contentTypes *string, e
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesGetPublicInlinePreviewURL API call: Determine the URL that should be used for inline preview of the file.
-func (c *Canvas) FilesGetPublicInlinePreviewURL(progress *task.Progress, submissionID *int) (*map[string]interface{}, error) {
+func (c *Canvas) FilesGetPublicInlinePreviewURL(ctx context.Context, progress *task.Progress, submissionID *int) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("files/1/public_url")
 	params := map[string]interface{}{}
 	if submissionID != nil {
@@ -12318,14 +12566,14 @@ func (c *Canvas) FilesGetPublicInlinePreviewURL(progress *task.Progress, submiss
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesGetFile API call: Returns the standard attachment json object
-func (c *Canvas) FilesGetFile(progress *task.Progress, include *FilesGetFileInclude, fileID string) (*File, error) {
+func (c *Canvas) FilesGetFile(ctx context.Context, progress *task.Progress, include *FilesGetFileInclude, fileID string) (*File, error) {
 	endpoint := fmt.Sprintf("files/%s", fileID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -12339,14 +12587,14 @@ func (c *Canvas) FilesGetFile(progress *task.Progress, include *FilesGetFileIncl
 		res = obj.(*File)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesUpdateFile API call: Update some settings on the specified file
-func (c *Canvas) FilesUpdateFile(progress *task.Progress, name *string, parentFolderID *string, onDuplicate *FilesUpdateFileOnDuplicate, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, fileID string) (*File, error) {
+func (c *Canvas) FilesUpdateFile(ctx context.Context, progress *task.Progress, name *string, parentFolderID *string, onDuplicate *FilesUpdateFileOnDuplicate, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, fileID string) (*File, error) {
 	endpoint := fmt.Sprintf("files/%s", fileID)
 	params := map[string]interface{}{}
 	if name != nil {
@@ -12378,7 +12626,7 @@ func (c *Canvas) FilesUpdateFile(progress *task.Progress, name *string, parentFo
 		res = obj.(*File)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12387,7 +12635,7 @@ func (c *Canvas) FilesUpdateFile(progress *task.Progress, name *string, parentFo
 // FilesDeleteFile API call: Remove the specified file. Unlike most other DELETE endpoints, using this endpoint will
 // result in comprehensive, irretrievable destruction of the file. It should be used with the `replace` parameter set to
 // true in cases where the file preview also needs to be destroyed (such as to remove files that violate privacy laws).
-func (c *Canvas) FilesDeleteFile(progress *task.Progress, replace *bool, fileID string) (*File, error) {
+func (c *Canvas) FilesDeleteFile(ctx context.Context, progress *task.Progress, replace *bool, fileID string) (*File, error) {
 	endpoint := fmt.Sprintf("files/%s", fileID)
 	params := map[string]interface{}{}
 	if replace != nil {
@@ -12401,7 +12649,7 @@ func (c *Canvas) FilesDeleteFile(progress *task.Progress, replace *bool, fileID
 		res = obj.(*File)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12410,7 +12658,7 @@ func (c *Canvas) FilesDeleteFile(progress *task.Progress, replace *bool, fileID
 // FilesResetLinkVerifier API call: Resets the link verifier. Any existing links to the file using the previous
 // hard-coded "verifier" parameter will no longer automatically grant access. Must have manage files and become other
 // users permissions
-func (c *Canvas) FilesResetLinkVerifier(progress *task.Progress, fileID string) (*File, error) {
+func (c *Canvas) FilesResetLinkVerifier(ctx context.Context, progress *task.Progress, fileID string) (*File, error) {
 	endpoint := fmt.Sprintf("files/%s/reset_verifier", fileID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12421,14 +12669,14 @@ func (c *Canvas) FilesResetLinkVerifier(progress *task.Progress, fileID string)
 		res = obj.(*File)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesListFolders API call
-func (c *Canvas) FilesListFolders(progress *task.Progress, folderID string) ([]Folder, error) {
+func (c *Canvas) FilesListFolders(ctx context.Context, progress *task.Progress, folderID string) ([]Folder, error) {
 	endpoint := fmt.Sprintf("folders/%s/folders", folderID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12440,14 +12688,14 @@ func (c *Canvas) FilesListFolders(progress *task.Progress, folderID string) ([]F
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesListAllFolders API call
-func (c *Canvas) FilesListAllFolders(progress *task.Progress, courseID string) ([]Folder, error) {
+func (c *Canvas) FilesListAllFolders(ctx context.Context, progress *task.Progress, courseID string) ([]Folder, error) {
 	endpoint := fmt.Sprintf("courses/%s/folders", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12459,14 +12707,14 @@ func (c *Canvas) FilesListAllFolders(progress *task.Progress, courseID string) (
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesResolvePath API call
-func (c *Canvas) FilesResolvePath(progress *task.Progress, courseID string) ([]Folder, error) {
+func (c *Canvas) FilesResolvePath(ctx context.Context, progress *task.Progress, courseID string) ([]Folder, error) {
 	endpoint := fmt.Sprintf("courses/%s/folders/by_path/foo/bar/baz", courseID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12478,14 +12726,14 @@ func (c *Canvas) FilesResolvePath(progress *task.Progress, courseID string) ([]F
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesGetFolder API call
-func (c *Canvas) FilesGetFolder(progress *task.Progress, folderID string) (*Folder, error) {
+func (c *Canvas) FilesGetFolder(ctx context.Context, progress *task.Progress, folderID string) (*Folder, error) {
 	endpoint := fmt.Sprintf("folders/%s", folderID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12496,14 +12744,14 @@ func (c *Canvas) FilesGetFolder(progress *task.Progress, folderID string) (*Fold
 		res = obj.(*Folder)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesUpdateFolder API call
-func (c *Canvas) FilesUpdateFolder(progress *task.Progress, name *string, parentFolderID *string, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, position *int, folderID string) (*Folder, error) {
+func (c *Canvas) FilesUpdateFolder(ctx context.Context, progress *task.Progress, name *string, parentFolderID *string, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, position *int, folderID string) (*Folder, error) {
 	endpoint := fmt.Sprintf("folders/%s", folderID)
 	params := map[string]interface{}{}
 	if name != nil {
@@ -12535,14 +12783,14 @@ func (c *Canvas) FilesUpdateFolder(progress *task.Progress, name *string, parent
 		res = obj.(*Folder)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesCreateFolder API call
-func (c *Canvas) FilesCreateFolder(progress *task.Progress, name *string, parentFolderID *string, parentFolderPath *string, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, position *int, courseID string) (*Folder, error) {
+func (c *Canvas) FilesCreateFolder(ctx context.Context, progress *task.Progress, name *string, parentFolderID *string, parentFolderPath *string, lockAt *time.Time, unlockAt *time.Time, locked *bool, hidden *bool, position *int, courseID string) (*Folder, error) {
 	endpoint := fmt.Sprintf("courses/%s/folders", courseID)
 	params := map[string]interface{}{}
 	if name != nil {
@@ -12577,14 +12825,14 @@ func (c *Canvas) FilesCreateFolder(progress *task.Progress, name *string, parent
 		res = obj.(*Folder)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesDeleteFolder API call
-func (c *Canvas) FilesDeleteFolder(progress *task.Progress, force *bool, folderID string) (*map[string]interface{}, error) {
+func (c *Canvas) FilesDeleteFolder(ctx context.Context, progress *task.Progress, force *bool, folderID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("folders/%s", folderID)
 	params := map[string]interface{}{}
 	if force != nil {
@@ -12598,7 +12846,7 @@ func (c *Canvas) FilesDeleteFolder(progress *task.Progress, force *bool, folderI
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12607,8 +12855,8 @@ func (c *Canvas) FilesDeleteFolder(progress *task.Progress, force *bool, folderI
 // FilesUploadAFile API call: Upload a file to a folder. This API endpoint is the first step in uploading a file. See
 // the {file:file_uploads.html File Upload Documentation} for details on the file upload workflow. Only those with the
 // "Manage Files" permission on a course or group can upload files to a folder in that course or group.
-func (c *Canvas) FilesUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) FilesUploadAFile(ctx context.Context, progress *task.Progress, folderID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("folders/%s/files", folderID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -12618,7 +12866,7 @@ func (c *Canvas) FilesUploadAFile(progress *task.Progress) (*map[string]interfac
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12626,7 +12874,7 @@ func (c *Canvas) FilesUploadAFile(progress *task.Progress) (*map[string]interfac
 
 // FilesCopyAFile API call: Copy a file from elsewhere in Canvas into a folder. Copying a file across contexts (between
 // courses and users) is permitted, but the source and destination must belong to the same institution.
-func (c *Canvas) FilesCopyAFile(progress *task.Progress, sourceFileID *string, onDuplicate *FilesCopyAFileOnDuplicate) (*File, error) {
+func (c *Canvas) FilesCopyAFile(ctx context.Context, progress *task.Progress, sourceFileID *string, onDuplicate *FilesCopyAFileOnDuplicate) (*File, error) {
 	endpoint := fmt.Sprintf("folders/123/copy_file")
 	params := map[string]interface{}{}
 	if sourceFileID != nil {
@@ -12643,7 +12891,7 @@ func (c *Canvas) FilesCopyAFile(progress *task.Progress, sourceFileID *string, o
 		res = obj.(*File)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12653,7 +12901,7 @@ func (c *Canvas) FilesCopyAFile(progress *task.Progress, sourceFileID *string, o
 // across contexts (between courses and users) is permitted, but the source and destination must belong to the same
 // institution. If the source and destination folders are in the same context, the source folder may not contain the
 // destination folder. A folder will be renamed at its destination if another folder with the same name already exists.
-func (c *Canvas) FilesCopyAFolder(progress *task.Progress, sourceFolderID *string) (*Folder, error) {
+func (c *Canvas) FilesCopyAFolder(ctx context.Context, progress *task.Progress, sourceFolderID *string) (*Folder, error) {
 	endpoint := fmt.Sprintf("folders/123/copy_folder")
 	params := map[string]interface{}{}
 	if sourceFolderID != nil {
@@ -12667,14 +12915,14 @@ func (c *Canvas) FilesCopyAFolder(progress *task.Progress, sourceFolderID *strin
 		res = obj.(*Folder)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesGetUploadedMediaFolderForUser API call
-func (c *Canvas) FilesGetUploadedMediaFolderForUser(progress *task.Progress) (*Folder, error) {
+func (c *Canvas) FilesGetUploadedMediaFolderForUser(ctx context.Context, progress *task.Progress) (*Folder, error) {
 	endpoint := fmt.Sprintf("courses/1337/folders/media")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12685,14 +12933,14 @@ func (c *Canvas) FilesGetUploadedMediaFolderForUser(progress *task.Progress) (*F
 		res = obj.(*Folder)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradeChangeLogQueryByAssignment API call: List grade change events for a given assignment.
-func (c *Canvas) GradeChangeLogQueryByAssignment(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
+func (c *Canvas) GradeChangeLogQueryByAssignment(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -12710,14 +12958,14 @@ func (c *Canvas) GradeChangeLogQueryByAssignment(progress *task.Progress, startT
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradeChangeLogQueryByCourse API call: List grade change events for a given course.
-func (c *Canvas) GradeChangeLogQueryByCourse(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
+func (c *Canvas) GradeChangeLogQueryByCourse(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -12735,14 +12983,14 @@ func (c *Canvas) GradeChangeLogQueryByCourse(progress *task.Progress, startTime
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradeChangeLogQueryByStudent API call: List grade change events for a given student.
-func (c *Canvas) GradeChangeLogQueryByStudent(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
+func (c *Canvas) GradeChangeLogQueryByStudent(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -12760,14 +13008,14 @@ func (c *Canvas) GradeChangeLogQueryByStudent(progress *task.Progress, startTime
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradeChangeLogQueryByGrader API call: List grade change events for a given grader.
-func (c *Canvas) GradeChangeLogQueryByGrader(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
+func (c *Canvas) GradeChangeLogQueryByGrader(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]GradeChangeEvent, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -12785,14 +13033,14 @@ func (c *Canvas) GradeChangeLogQueryByGrader(progress *task.Progress, startTime
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradebookHistoryDaysInGradebookHistoryForThisCourse API call: Returns a map of dates to grader/assignment groups
-func (c *Canvas) GradebookHistoryDaysInGradebookHistoryForThisCourse(progress *task.Progress, courseID *int) ([]Day, error) {
+func (c *Canvas) GradebookHistoryDaysInGradebookHistoryForThisCourse(ctx context.Context, progress *task.Progress, courseID *int) ([]Day, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if courseID != nil {
@@ -12807,7 +13055,7 @@ func (c *Canvas) GradebookHistoryDaysInGradebookHistoryForThisCourse(progress *t
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12816,7 +13064,7 @@ func (c *Canvas) GradebookHistoryDaysInGradebookHistoryForThisCourse(progress *t
 // GradebookHistoryDetailsForAGivenDateInGradebookHistoryForThisCourse API call: Returns the graders who worked on this
 // day, along with the assignments they worked on. More details can be obtained by selecting a grader and assignment and
 // calling the 'submissions' api endpoint for a given date.
-func (c *Canvas) GradebookHistoryDetailsForAGivenDateInGradebookHistoryForThisCourse(progress *task.Progress, courseID *int, date *string) ([]Grader, error) {
+func (c *Canvas) GradebookHistoryDetailsForAGivenDateInGradebookHistoryForThisCourse(ctx context.Context, progress *task.Progress, courseID *int, date *string) ([]Grader, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if courseID != nil {
@@ -12834,14 +13082,14 @@ func (c *Canvas) GradebookHistoryDetailsForAGivenDateInGradebookHistoryForThisCo
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradebookHistoryListsSubmissions API call: Gives a nested list of submission versions
-func (c *Canvas) GradebookHistoryListsSubmissions(progress *task.Progress, courseID *int, date *string, graderID *int, assignmentID *int) ([]SubmissionHistory, error) {
+func (c *Canvas) GradebookHistoryListsSubmissions(ctx context.Context, progress *task.Progress, courseID *int, date *string, graderID *int, assignmentID *int) ([]SubmissionHistory, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if courseID != nil {
@@ -12865,7 +13113,7 @@ func (c *Canvas) GradebookHistoryListsSubmissions(progress *task.Progress, cours
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12874,7 +13122,7 @@ func (c *Canvas) GradebookHistoryListsSubmissions(progress *task.Progress, cours
 // GradebookHistoryListUncollatedSubmissionVersions API call: Gives a paginated, uncollated list of submission versions
 // for all matching submissions in the context. This SubmissionVersion objects will not include the +new_grade+ or
 // +previous_grade+ keys, only the +grade+; same for +graded_at+ and +grader+.
-func (c *Canvas) GradebookHistoryListUncollatedSubmissionVersions(progress *task.Progress, courseID *int, assignmentID *int, userID *int, ascending *bool) ([]SubmissionVersion, error) {
+func (c *Canvas) GradebookHistoryListUncollatedSubmissionVersions(ctx context.Context, progress *task.Progress, courseID *int, assignmentID *int, userID *int, ascending *bool) ([]SubmissionVersion, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if courseID != nil {
@@ -12898,14 +13146,14 @@ func (c *Canvas) GradebookHistoryListUncollatedSubmissionVersions(progress *task
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradingPeriodsListGradingPeriods API call: Returns the paginated list of grading periods for the current course.
-func (c *Canvas) GradingPeriodsListGradingPeriods(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) GradingPeriodsListGradingPeriods(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12916,14 +13164,14 @@ func (c *Canvas) GradingPeriodsListGradingPeriods(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradingPeriodsGetASingleGradingPeriod API call: Returns the grading period with the given id
-func (c *Canvas) GradingPeriodsGetASingleGradingPeriod(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) GradingPeriodsGetASingleGradingPeriod(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -12934,15 +13182,15 @@ func (c *Canvas) GradingPeriodsGetASingleGradingPeriod(progress *task.Progress)
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GradingPeriodsUpdateASingleGradingPeriod API call: Update an existing grading period.
-func (c *Canvas) GradingPeriodsUpdateASingleGradingPeriod(progress *task.Progress, gradingPeriods *time.Time) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) GradingPeriodsUpdateASingleGradingPeriod(ctx context.Context, progress *task.Progress, gradingPeriods *time.Time, courseID string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/grading_periods/%s", courseID, id)
 	params := map[string]interface{}{}
 	if gradingPeriods != nil {
 		params["grading_periods"] = *gradingPeriods
@@ -12955,7 +13203,7 @@ func (c *Canvas) GradingPeriodsUpdateASingleGradingPeriod(progress *task.Progres
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12963,8 +13211,8 @@ func (c *Canvas) GradingPeriodsUpdateASingleGradingPeriod(progress *task.Progres
 
 // GradingPeriodsDeleteAGradingPeriod API call: <b>204 No Content</b> response code is returned if the deletion was
 // successful.
-func (c *Canvas) GradingPeriodsDeleteAGradingPeriod(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) GradingPeriodsDeleteAGradingPeriod(ctx context.Context, progress *task.Progress, courseID string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/grading_periods/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -12974,7 +13222,7 @@ func (c *Canvas) GradingPeriodsDeleteAGradingPeriod(progress *task.Progress) (*m
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -12983,7 +13231,7 @@ func (c *Canvas) GradingPeriodsDeleteAGradingPeriod(progress *task.Progress) (*m
 // GradingStandardsCreateANewGradingStandard API call: Create a new grading standard If grading_scheme_entry arguments
 // are omitted, then a default grading scheme will be set. The default scheme is as follows: "A" : 94, "A-" : 90, "B+" :
 // 87, "B" : 84, "B-" : 80, "C+" : 77, "C" : 74, "C-" : 70, "D+" : 67, "D" : 64, "D-" : 61, "F" : 0,
-func (c *Canvas) GradingStandardsCreateANewGradingStandard(progress *task.Progress, title *string, gradingSchemeEntry *string, courseID string) (*GradingStandard, error) {
+func (c *Canvas) GradingStandardsCreateANewGradingStandard(ctx context.Context, progress *task.Progress, title *string, gradingSchemeEntry *string, courseID string) (*GradingStandard, error) {
 	endpoint := fmt.Sprintf("courses/%s/grading_standards", courseID)
 	params := map[string]interface{}{}
 	if title != nil {
@@ -13000,7 +13248,7 @@ func (c *Canvas) GradingStandardsCreateANewGradingStandard(progress *task.Progre
 		res = obj.(*GradingStandard)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13008,7 +13256,7 @@ func (c *Canvas) GradingStandardsCreateANewGradingStandard(progress *task.Progre
 
 // GradingStandardsListTheGradingStandardsAvailableInAContext API call: Returns the paginated list of grading standards
 // for the given context that are visible to the user.
-func (c *Canvas) GradingStandardsListTheGradingStandardsAvailableInAContext(progress *task.Progress) ([]GradingStandard, error) {
+func (c *Canvas) GradingStandardsListTheGradingStandardsAvailableInAContext(ctx context.Context, progress *task.Progress) ([]GradingStandard, error) {
 	endpoint := fmt.Sprintf("courses/1/grading_standards")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13020,7 +13268,7 @@ func (c *Canvas) GradingStandardsListTheGradingStandardsAvailableInAContext(prog
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13028,7 +13276,7 @@ func (c *Canvas) GradingStandardsListTheGradingStandardsAvailableInAContext(prog
 
 // GradingStandardsGetASingleGradingStandardInAContext API call: Returns a grading standard for the given context that
 // is visible to the user.
-func (c *Canvas) GradingStandardsGetASingleGradingStandardInAContext(progress *task.Progress) (*GradingStandard, error) {
+func (c *Canvas) GradingStandardsGetASingleGradingStandardInAContext(ctx context.Context, progress *task.Progress) (*GradingStandard, error) {
 	endpoint := fmt.Sprintf("courses/1/grading_standards/5")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13039,14 +13287,14 @@ func (c *Canvas) GradingStandardsGetASingleGradingStandardInAContext(progress *t
 		res = obj.(*GradingStandard)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupCategoriesListGroupCategoriesForAContext API call: Returns a paginated list of group categories in a context
-func (c *Canvas) GroupCategoriesListGroupCategoriesForAContext(progress *task.Progress, accountID string) ([]GroupCategory, error) {
+func (c *Canvas) GroupCategoriesListGroupCategoriesForAContext(ctx context.Context, progress *task.Progress, accountID string) ([]GroupCategory, error) {
 	endpoint := fmt.Sprintf("accounts/%s/group_categories", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13058,7 +13306,7 @@ func (c *Canvas) GroupCategoriesListGroupCategoriesForAContext(progress *task.Pr
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13066,7 +13314,7 @@ func (c *Canvas) GroupCategoriesListGroupCategoriesForAContext(progress *task.Pr
 
 // GroupCategoriesGetASingleGroupCategory API call: Returns the data for a single group category, or a 401 if the caller
 // doesn't have the rights to see it.
-func (c *Canvas) GroupCategoriesGetASingleGroupCategory(progress *task.Progress, groupCategoryID string) (*GroupCategory, error) {
+func (c *Canvas) GroupCategoriesGetASingleGroupCategory(ctx context.Context, progress *task.Progress, groupCategoryID string) (*GroupCategory, error) {
 	endpoint := fmt.Sprintf("group_categories/%s", groupCategoryID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13077,15 +13325,15 @@ func (c *Canvas) GroupCategoriesGetASingleGroupCategory(progress *task.Progress,
 		res = obj.(*GroupCategory)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupCategoriesCreateAGroupCategory API call: Create a new group category
-func (c *Canvas) GroupCategoriesCreateAGroupCategory(progress *task.Progress, name *string, selfSignup *GroupCategoriesCreateAGroupCategorySelfSignup, autoLeader *GroupCategoriesCreateAGroupCategoryAutoLeader, groupLimit *int, sisGroupCategoryID *string, createGroupCount *int, splitGroupCount *interface{}) (*GroupCategory, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) GroupCategoriesCreateAGroupCategory(ctx context.Context, progress *task.Progress, name *string, selfSignup *GroupCategoriesCreateAGroupCategorySelfSignup, autoLeader *GroupCategoriesCreateAGroupCategoryAutoLeader, groupLimit *int, sisGroupCategoryID *string, createGroupCount *int, splitGroupCount *interface{}, accountID string) (*GroupCategory, error) {
+	endpoint := fmt.Sprintf("accounts/%s/group_categories", accountID)
 	params := map[string]interface{}{}
 	if name != nil {
 		params["name"] = *name
@@ -13116,14 +13364,14 @@ func (c *Canvas) GroupCategoriesCreateAGroupCategory(progress *task.Progress, na
 		res = obj.(*GroupCategory)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupCategoriesUpdateAGroupCategory API call: Modifies an existing group category.
-func (c *Canvas) GroupCategoriesUpdateAGroupCategory(progress *task.Progress, name *string, selfSignup *GroupCategoriesUpdateAGroupCategorySelfSignup, autoLeader *GroupCategoriesUpdateAGroupCategoryAutoLeader, groupLimit *int, sisGroupCategoryID *string, createGroupCount *int, splitGroupCount *interface{}, groupCategoryID string) (*GroupCategory, error) {
+func (c *Canvas) GroupCategoriesUpdateAGroupCategory(ctx context.Context, progress *task.Progress, name *string, selfSignup *GroupCategoriesUpdateAGroupCategorySelfSignup, autoLeader *GroupCategoriesUpdateAGroupCategoryAutoLeader, groupLimit *int, sisGroupCategoryID *string, createGroupCount *int, splitGroupCount *interface{}, groupCategoryID string) (*GroupCategory, error) {
 	endpoint := fmt.Sprintf("group_categories/%s", groupCategoryID)
 	params := map[string]interface{}{}
 	if name != nil {
@@ -13155,7 +13403,7 @@ func (c *Canvas) GroupCategoriesUpdateAGroupCategory(progress *task.Progress, na
 		res = obj.(*GroupCategory)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13163,7 +13411,7 @@ func (c *Canvas) GroupCategoriesUpdateAGroupCategory(progress *task.Progress, na
 
 // GroupCategoriesDeleteAGroupCategory API call: Deletes a group category and all groups under it. Protected group
 // categories can not be deleted, i.e. "communities" and "student_organized".
-func (c *Canvas) GroupCategoriesDeleteAGroupCategory(progress *task.Progress, groupCategoryID string) (*map[string]interface{}, error) {
+func (c *Canvas) GroupCategoriesDeleteAGroupCategory(ctx context.Context, progress *task.Progress, groupCategoryID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("group_categories/%s", groupCategoryID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13174,14 +13422,14 @@ func (c *Canvas) GroupCategoriesDeleteAGroupCategory(progress *task.Progress, gr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupCategoriesListGroupsInGroupCategory API call: Returns a paginated list of groups in a group category
-func (c *Canvas) GroupCategoriesListGroupsInGroupCategory(progress *task.Progress, groupCateogryID string) ([]Group, error) {
+func (c *Canvas) GroupCategoriesListGroupsInGroupCategory(ctx context.Context, progress *task.Progress, groupCateogryID string) ([]Group, error) {
 	endpoint := fmt.Sprintf("group_categories/%s/groups", groupCateogryID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13193,14 +13441,14 @@ func (c *Canvas) GroupCategoriesListGroupsInGroupCategory(progress *task.Progres
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupCategoriesListUsersInGroupCategory API call: Returns a paginated list of users in the group category.
-func (c *Canvas) GroupCategoriesListUsersInGroupCategory(progress *task.Progress, searchTerm *string, unassigned *bool) ([]User, error) {
+func (c *Canvas) GroupCategoriesListUsersInGroupCategory(ctx context.Context, progress *task.Progress, searchTerm *string, unassigned *bool) ([]User, error) {
 	endpoint := fmt.Sprintf("group_categories/1/users")
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -13218,7 +13466,7 @@ func (c *Canvas) GroupCategoriesListUsersInGroupCategory(progress *task.Progress
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13226,7 +13474,7 @@ func (c *Canvas) GroupCategoriesListUsersInGroupCategory(progress *task.Progress
 
 // GroupCategoriesAssignUnassignedMembers API call: Assign all unassigned members as evenly as possible among the
 // existing student groups.
-func (c *Canvas) GroupCategoriesAssignUnassignedMembers(progress *task.Progress, sync *bool) (*map[string]interface{}, error) {
+func (c *Canvas) GroupCategoriesAssignUnassignedMembers(ctx context.Context, progress *task.Progress, sync *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("group_categories/1/assign_unassigned_members")
 	params := map[string]interface{}{}
 	if sync != nil {
@@ -13240,14 +13488,14 @@ func (c *Canvas) GroupCategoriesAssignUnassignedMembers(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsListGroupMemberships API call
-func (c *Canvas) GroupsListGroupMemberships(progress *task.Progress, filterStates *GroupsListGroupMembershipsFilterStates, groupID string) ([]GroupMembership, error) {
+func (c *Canvas) GroupsListGroupMemberships(ctx context.Context, progress *task.Progress, filterStates *GroupsListGroupMembershipsFilterStates, groupID string) ([]GroupMembership, error) {
 	endpoint := fmt.Sprintf("groups/%s/memberships", groupID)
 	params := map[string]interface{}{}
 	if filterStates != nil {
@@ -13262,14 +13510,14 @@ func (c *Canvas) GroupsListGroupMemberships(progress *task.Progress, filterState
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsGetASingleGroupMembership API call
-func (c *Canvas) GroupsGetASingleGroupMembership(progress *task.Progress, groupID string, userID string) (*GroupMembership, error) {
+func (c *Canvas) GroupsGetASingleGroupMembership(ctx context.Context, progress *task.Progress, groupID string, userID string) (*GroupMembership, error) {
 	endpoint := fmt.Sprintf("groups/%s/users/%s", groupID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13280,14 +13528,14 @@ func (c *Canvas) GroupsGetASingleGroupMembership(progress *task.Progress, groupI
 		res = obj.(*GroupMembership)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsCreateAMembership API call
-func (c *Canvas) GroupsCreateAMembership(progress *task.Progress, userID *string, groupID string) (*GroupMembership, error) {
+func (c *Canvas) GroupsCreateAMembership(ctx context.Context, progress *task.Progress, userID *string, groupID string) (*GroupMembership, error) {
 	endpoint := fmt.Sprintf("groups/%s/memberships", groupID)
 	params := map[string]interface{}{}
 	if userID != nil {
@@ -13301,14 +13549,14 @@ func (c *Canvas) GroupsCreateAMembership(progress *task.Progress, userID *string
 		res = obj.(*GroupMembership)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsUpdateAMembership API call
-func (c *Canvas) GroupsUpdateAMembership(progress *task.Progress, workflowState *GroupsUpdateAMembershipWorkflowState, moderator *interface{}, groupID string, userID string) (*GroupMembership, error) {
+func (c *Canvas) GroupsUpdateAMembership(ctx context.Context, progress *task.Progress, workflowState *GroupsUpdateAMembershipWorkflowState, moderator *interface{}, groupID string, userID string) (*GroupMembership, error) {
 	endpoint := fmt.Sprintf("groups/%s/users/%s", groupID, userID)
 	params := map[string]interface{}{}
 	if workflowState != nil {
@@ -13325,14 +13573,14 @@ func (c *Canvas) GroupsUpdateAMembership(progress *task.Progress, workflowState
 		res = obj.(*GroupMembership)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsLeaveAGroup API call
-func (c *Canvas) GroupsLeaveAGroup(progress *task.Progress, groupID string, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsLeaveAGroup(ctx context.Context, progress *task.Progress, groupID string, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("groups/%s/users/%s", groupID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13343,14 +13591,14 @@ func (c *Canvas) GroupsLeaveAGroup(progress *task.Progress, groupID string, user
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsListYourGroups API call: Returns a paginated list of active groups for the current user.
-func (c *Canvas) GroupsListYourGroups(progress *task.Progress, contextType *GroupsListYourGroupsContextType, include *GroupsListYourGroupsInclude) ([]Group, error) {
+func (c *Canvas) GroupsListYourGroups(ctx context.Context, progress *task.Progress, contextType *GroupsListYourGroupsContextType, include *GroupsListYourGroupsInclude) ([]Group, error) {
 	endpoint := fmt.Sprintf("users/self/groups")
 	params := map[string]interface{}{}
 	if contextType != nil {
@@ -13368,7 +13616,7 @@ func (c *Canvas) GroupsListYourGroups(progress *task.Progress, contextType *Grou
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13376,7 +13624,7 @@ func (c *Canvas) GroupsListYourGroups(progress *task.Progress, contextType *Grou
 
 // GroupsListTheGroupsAvailableInAContext API call: Returns the paginated list of active groups in the given context
 // that are visible to user.
-func (c *Canvas) GroupsListTheGroupsAvailableInAContext(progress *task.Progress, onlyOwnGroups *bool, include *GroupsListTheGroupsAvailableInAContextInclude) ([]Group, error) {
+func (c *Canvas) GroupsListTheGroupsAvailableInAContext(ctx context.Context, progress *task.Progress, onlyOwnGroups *bool, include *GroupsListTheGroupsAvailableInAContextInclude) ([]Group, error) {
 	endpoint := fmt.Sprintf("courses/1/groups")
 	params := map[string]interface{}{}
 	if onlyOwnGroups != nil {
@@ -13394,7 +13642,7 @@ func (c *Canvas) GroupsListTheGroupsAvailableInAContext(progress *task.Progress,
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13402,7 +13650,7 @@ func (c *Canvas) GroupsListTheGroupsAvailableInAContext(progress *task.Progress,
 
 // GroupsGetASingleGroup API call: Returns the data for a single group, or a 401 if the caller doesn't have the rights
 // to see it.
-func (c *Canvas) GroupsGetASingleGroup(progress *task.Progress, include *GroupsGetASingleGroupInclude, groupID string) (*Group, error) {
+func (c *Canvas) GroupsGetASingleGroup(ctx context.Context, progress *task.Progress, include *GroupsGetASingleGroupInclude, groupID string) (*Group, error) {
 	endpoint := fmt.Sprintf("groups/%s", groupID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -13416,7 +13664,7 @@ func (c *Canvas) GroupsGetASingleGroup(progress *task.Progress, include *GroupsG
 		res = obj.(*Group)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13424,7 +13672,7 @@ func (c *Canvas) GroupsGetASingleGroup(progress *task.Progress, include *GroupsG
 
 // GroupsCreateAGroup API call: Creates a new group. Groups created using the "/api/v1/groups/" endpoint will be
 // community groups.
-func (c *Canvas) GroupsCreateAGroup(progress *task.Progress, name *string, description *string, isPublic *bool, joinLevel *GroupsCreateAGroupJoinLevel, storageQuotaMb *int, sisGroupID *string) (*Group, error) {
+func (c *Canvas) GroupsCreateAGroup(ctx context.Context, progress *task.Progress, name *string, description *string, isPublic *bool, joinLevel *GroupsCreateAGroupJoinLevel, storageQuotaMb *int, sisGroupID *string) (*Group, error) {
 	endpoint := fmt.Sprintf("groups")
 	params := map[string]interface{}{}
 	if name != nil {
@@ -13453,7 +13701,7 @@ func (c *Canvas) GroupsCreateAGroup(progress *task.Progress, name *string, descr
 		res = obj.(*Group)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13462,7 +13710,7 @@ func (c *Canvas) GroupsCreateAGroup(progress *task.Progress, name *string, descr
 // GroupsEditAGroup API call: Modifies an existing group.  Note that to set an avatar image for the group, you must
 // first upload the image file to the group, and the use the id in the response as the argument to this function.  See
 // the {file:file_uploads.html File Upload Documentation} for details on the file upload workflow.
-func (c *Canvas) GroupsEditAGroup(progress *task.Progress, name *string, description *string, isPublic *bool, joinLevel *GroupsEditAGroupJoinLevel, avatarID *int, storageQuotaMb *int, members *string, sisGroupID *string, groupID string) (*Group, error) {
+func (c *Canvas) GroupsEditAGroup(ctx context.Context, progress *task.Progress, name *string, description *string, isPublic *bool, joinLevel *GroupsEditAGroupJoinLevel, avatarID *int, storageQuotaMb *int, members *string, sisGroupID *string, groupID string) (*Group, error) {
 	endpoint := fmt.Sprintf("groups/%s", groupID)
 	params := map[string]interface{}{}
 	if name != nil {
@@ -13497,14 +13745,14 @@ func (c *Canvas) GroupsEditAGroup(progress *task.Progress, name *string, descrip
 		res = obj.(*Group)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsDeleteAGroup API call: Deletes a group and removes all members.
-func (c *Canvas) GroupsDeleteAGroup(progress *task.Progress, groupID string) (*Group, error) {
+func (c *Canvas) GroupsDeleteAGroup(ctx context.Context, progress *task.Progress, groupID string) (*Group, error) {
 	endpoint := fmt.Sprintf("groups/%s", groupID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13515,14 +13763,14 @@ func (c *Canvas) GroupsDeleteAGroup(progress *task.Progress, groupID string) (*G
 		res = obj.(*Group)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsInviteOthersToAGroup API call
-func (c *Canvas) GroupsInviteOthersToAGroup(progress *task.Progress, invitees *string, groupID string) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsInviteOthersToAGroup(ctx context.Context, progress *task.Progress, invitees *string, groupID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("groups/%s/invite", groupID)
 	params := map[string]interface{}{}
 	if invitees != nil {
@@ -13536,14 +13784,14 @@ func (c *Canvas) GroupsInviteOthersToAGroup(progress *task.Progress, invitees *s
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsListGroupSUsers API call: Returns a paginated list of users in the group.
-func (c *Canvas) GroupsListGroupSUsers(progress *task.Progress, searchTerm *string, include *GroupsListGroupSUsersInclude, excludeInactive *bool) ([]User, error) {
+func (c *Canvas) GroupsListGroupSUsers(ctx context.Context, progress *task.Progress, searchTerm *string, include *GroupsListGroupSUsersInclude, excludeInactive *bool) ([]User, error) {
 	endpoint := fmt.Sprintf("groups/1/users")
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -13564,7 +13812,7 @@ func (c *Canvas) GroupsListGroupSUsers(progress *task.Progress, searchTerm *stri
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13574,8 +13822,8 @@ func (c *Canvas) GroupsListGroupSUsers(progress *task.Progress, searchTerm *stri
 // group. See the {file:file_uploads.html File Upload Documentation} for details on the file upload workflow. Only those
 // with the "Manage Files" permission on a group can upload files to the group. By default, this is anybody
 // participating in the group, or any admin over the group.
-func (c *Canvas) GroupsUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) GroupsUploadAFile(ctx context.Context, progress *task.Progress, groupID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("groups/%s/files", groupID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -13585,14 +13833,14 @@ func (c *Canvas) GroupsUploadAFile(progress *task.Progress) (*map[string]interfa
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // GroupsPreviewProcessedHTML API call: Preview html content processed for this group
-func (c *Canvas) GroupsPreviewProcessedHTML(progress *task.Progress, html *string, groupID string) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsPreviewProcessedHTML(ctx context.Context, progress *task.Progress, html *string, groupID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("groups/%s/preview_html", groupID)
 	params := map[string]interface{}{}
 	if html != nil {
@@ -13606,7 +13854,7 @@ func (c *Canvas) GroupsPreviewProcessedHTML(progress *task.Progress, html *strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13614,7 +13862,7 @@ func (c *Canvas) GroupsPreviewProcessedHTML(progress *task.Progress, html *strin
 
 // GroupsGroupActivityStream API call: Returns the current user's group-specific activity stream, paginated. For full
 // documentation, see the API documentation for the user activity stream, in the user api.
-func (c *Canvas) GroupsGroupActivityStream(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsGroupActivityStream(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13625,7 +13873,7 @@ func (c *Canvas) GroupsGroupActivityStream(progress *task.Progress) (*map[string
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13633,7 +13881,7 @@ func (c *Canvas) GroupsGroupActivityStream(progress *task.Progress) (*map[string
 
 // GroupsGroupActivityStreamSummary API call: Returns a summary of the current user's group-specific activity stream.
 // For full documentation, see the API documentation for the user activity stream summary, in the user api.
-func (c *Canvas) GroupsGroupActivityStreamSummary(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsGroupActivityStreamSummary(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13644,7 +13892,7 @@ func (c *Canvas) GroupsGroupActivityStreamSummary(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13652,7 +13900,7 @@ func (c *Canvas) GroupsGroupActivityStreamSummary(progress *task.Progress) (*map
 
 // GroupsPermissions API call: Returns permission information for the calling user in the given group. See also the
 // {api:AccountsController#permissions Account} and {api:CoursesController#permissions Course} counterparts.
-func (c *Canvas) GroupsPermissions(progress *task.Progress, permissions *string, groupID string) (*map[string]interface{}, error) {
+func (c *Canvas) GroupsPermissions(ctx context.Context, progress *task.Progress, permissions *string, groupID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("groups/%s/permissions", groupID)
 	params := map[string]interface{}{}
 	if permissions != nil {
@@ -13666,7 +13914,7 @@ func (c *Canvas) GroupsPermissions(progress *task.Progress, permissions *string,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13675,7 +13923,7 @@ func (c *Canvas) GroupsPermissions(progress *task.Progress, permissions *string,
 // ImageSearchFindImages API call: Find public domain images for use in courses and user content.  If you select an
 // image using this API, please use the {api:InternetImageController#image_selection Confirm image selection API} to
 // indicate photo usage to the server.
-func (c *Canvas) ImageSearchFindImages(progress *task.Progress, query *string) (*map[string]interface{}, error) {
+func (c *Canvas) ImageSearchFindImages(ctx context.Context, progress *task.Progress, query *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if query != nil {
@@ -13689,7 +13937,7 @@ func (c *Canvas) ImageSearchFindImages(progress *task.Progress, query *string) (
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13697,12 +13945,9 @@ func (c *Canvas) ImageSearchFindImages(progress *task.Progress, query *string) (
 
 // ImageSearchConfirmImageSelection API call: After you have used the search API, you should hit this API to indicate
 // photo usage to the server.
-func (c *Canvas) ImageSearchConfirmImageSelection(progress *task.Progress, id *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ImageSearchConfirmImageSelection(ctx context.Context, progress *task.Progress, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("image_selection/%s", id)
 	params := map[string]interface{}{}
-	if id != nil {
-		params["id"] = *id
-	}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
 	}
@@ -13711,7 +13956,7 @@ func (c *Canvas) ImageSearchConfirmImageSelection(progress *task.Progress, id *s
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13719,7 +13964,7 @@ func (c *Canvas) ImageSearchConfirmImageSelection(progress *task.Progress, id *s
 
 // JWTsCreateJWT API call: Create a unique jwt for using with other canvas services Generates a different JWT each time
 // it's called, each one expires after a short window (1 hour)
-func (c *Canvas) JWTsCreateJWT(progress *task.Progress) (*JWT, error) {
+func (c *Canvas) JWTsCreateJWT(ctx context.Context, progress *task.Progress) (*JWT, error) {
 	endpoint := fmt.Sprintf("jwts")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13730,7 +13975,7 @@ func (c *Canvas) JWTsCreateJWT(progress *task.Progress) (*JWT, error) {
 		res = obj.(*JWT)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13738,7 +13983,7 @@ func (c *Canvas) JWTsCreateJWT(progress *task.Progress) (*JWT, error) {
 
 // JWTsRefreshJWT API call: Refresh a JWT for use with other canvas services Generates a different JWT each time it's
 // called, each one expires after a short window (1 hour).
-func (c *Canvas) JWTsRefreshJWT(progress *task.Progress, jwt *string) (*JWT, error) {
+func (c *Canvas) JWTsRefreshJWT(ctx context.Context, progress *task.Progress, jwt *string) (*JWT, error) {
 	endpoint := fmt.Sprintf("jwts/refresh")
 	params := map[string]interface{}{}
 	if jwt != nil {
@@ -13752,14 +13997,14 @@ func (c *Canvas) JWTsRefreshJWT(progress *task.Progress, jwt *string) (*JWT, err
 		res = obj.(*JWT)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LatePolicyGetALatePolicy API call: Returns the late policy for a course.
-func (c *Canvas) LatePolicyGetALatePolicy(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) LatePolicyGetALatePolicy(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13770,7 +14015,7 @@ func (c *Canvas) LatePolicyGetALatePolicy(progress *task.Progress) (*map[string]
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13778,8 +14023,8 @@ func (c *Canvas) LatePolicyGetALatePolicy(progress *task.Progress) (*map[string]
 
 // LatePolicyCreateALatePolicy API call: Create a late policy. If the course already has a late policy, a bad_request is
 // returned since there can only be one late policy per course.
-func (c *Canvas) LatePolicyCreateALatePolicy(progress *task.Progress, latePolicy *bool) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) LatePolicyCreateALatePolicy(ctx context.Context, progress *task.Progress, latePolicy *bool, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/late_policy", id)
 	params := map[string]interface{}{}
 	if latePolicy != nil {
 		params["late_policy"] = *latePolicy
@@ -13792,15 +14037,15 @@ func (c *Canvas) LatePolicyCreateALatePolicy(progress *task.Progress, latePolicy
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LatePolicyPatchALatePolicy API call: Patch a late policy. No body is returned upon success.
-func (c *Canvas) LatePolicyPatchALatePolicy(progress *task.Progress, latePolicy *bool) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) LatePolicyPatchALatePolicy(ctx context.Context, progress *task.Progress, latePolicy *bool, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/late_policy", id)
 	params := map[string]interface{}{}
 	if latePolicy != nil {
 		params["late_policy"] = *latePolicy
@@ -13813,7 +14058,7 @@ func (c *Canvas) LatePolicyPatchALatePolicy(progress *task.Progress, latePolicy
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PATCH", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13821,7 +14066,7 @@ func (c *Canvas) LatePolicyPatchALatePolicy(progress *task.Progress, latePolicy
 
 // MediaObjectsListMediaObjects API call: Returns Media Objects created by the user making the request. When using the
 // second version, returns only those Media Objects associated with the given course.
-func (c *Canvas) MediaObjectsListMediaObjects(progress *task.Progress, sort *MediaObjectsListMediaObjectsSort, order *MediaObjectsListMediaObjectsOrder, exclude *MediaObjectsListMediaObjectsExclude) ([]map[string]interface{}, error) {
+func (c *Canvas) MediaObjectsListMediaObjects(ctx context.Context, progress *task.Progress, sort *MediaObjectsListMediaObjectsSort, order *MediaObjectsListMediaObjectsOrder, exclude *MediaObjectsListMediaObjectsExclude) ([]map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("media_objects")
 	params := map[string]interface{}{}
 	if sort != nil {
@@ -13842,15 +14087,15 @@ func (c *Canvas) MediaObjectsListMediaObjects(progress *task.Progress, sort *Med
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // MediaObjectsUpdateMediaObject API call
-func (c *Canvas) MediaObjectsUpdateMediaObject(progress *task.Progress, userEnteredTitle *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) MediaObjectsUpdateMediaObject(ctx context.Context, progress *task.Progress, userEnteredTitle *interface{}, mediaObjectID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("media_objects/%s", mediaObjectID)
 	params := map[string]interface{}{}
 	if userEnteredTitle != nil {
 		params["user_entered_title"] = *userEnteredTitle
@@ -13863,14 +14108,14 @@ func (c *Canvas) MediaObjectsUpdateMediaObject(progress *task.Progress, userEnte
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // MediaObjectsListMediaTracksForAMediaObject API call: List the media tracks associated with a media object
-func (c *Canvas) MediaObjectsListMediaTracksForAMediaObject(progress *task.Progress, include *MediaObjectsListMediaTracksForAMediaObjectInclude, mediaObjectID string) ([]map[string]interface{}, error) {
+func (c *Canvas) MediaObjectsListMediaTracksForAMediaObject(ctx context.Context, progress *task.Progress, include *MediaObjectsListMediaTracksForAMediaObjectInclude, mediaObjectID string) ([]map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("media_objects/%s/media_tracks", mediaObjectID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -13885,7 +14130,7 @@ func (c *Canvas) MediaObjectsListMediaTracksForAMediaObject(progress *task.Progr
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13894,7 +14139,7 @@ func (c *Canvas) MediaObjectsListMediaTracksForAMediaObject(progress *task.Progr
 // MediaObjectsUpdateMediaTracks API call: Replace the media tracks associated with a media object with the array of
 // tracks provided in the body. Update will delete any existing tracks not listed, leave untouched any tracks with no
 // content field, and update or create tracks with a content field.
-func (c *Canvas) MediaObjectsUpdateMediaTracks(progress *task.Progress, include *string, mediaObjectID string) ([]map[string]interface{}, error) {
+func (c *Canvas) MediaObjectsUpdateMediaTracks(ctx context.Context, progress *task.Progress, include *string, mediaObjectID string) ([]map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("media_objects/%s/mediatracksinclude[]=content", mediaObjectID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -13909,14 +14154,14 @@ func (c *Canvas) MediaObjectsUpdateMediaTracks(progress *task.Progress, include
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentMigrationsListMigrationIssues API call: Returns paginated migration issues
-func (c *Canvas) ContentMigrationsListMigrationIssues(progress *task.Progress, courseID string, contentMigrationID string) ([]MigrationIssue, error) {
+func (c *Canvas) ContentMigrationsListMigrationIssues(ctx context.Context, progress *task.Progress, courseID string, contentMigrationID string) ([]MigrationIssue, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations/%s/migration_issues", courseID, contentMigrationID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13928,14 +14173,14 @@ func (c *Canvas) ContentMigrationsListMigrationIssues(progress *task.Progress, c
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentMigrationsGetAMigrationIssue API call: Returns data on an individual migration issue
-func (c *Canvas) ContentMigrationsGetAMigrationIssue(progress *task.Progress, courseID string, contentMigrationID string, id string) (*MigrationIssue, error) {
+func (c *Canvas) ContentMigrationsGetAMigrationIssue(ctx context.Context, progress *task.Progress, courseID string, contentMigrationID string, id string) (*MigrationIssue, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations/%s/migration_issues/%s", courseID, contentMigrationID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13946,14 +14191,14 @@ func (c *Canvas) ContentMigrationsGetAMigrationIssue(progress *task.Progress, co
 		res = obj.(*MigrationIssue)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ContentMigrationsUpdateAMigrationIssue API call: Update the workflow_state of a migration issue
-func (c *Canvas) ContentMigrationsUpdateAMigrationIssue(progress *task.Progress, workflowState *ContentMigrationsUpdateAMigrationIssueWorkflowState, courseID string, contentMigrationID string, id string) (*MigrationIssue, error) {
+func (c *Canvas) ContentMigrationsUpdateAMigrationIssue(ctx context.Context, progress *task.Progress, workflowState *ContentMigrationsUpdateAMigrationIssueWorkflowState, courseID string, contentMigrationID string, id string) (*MigrationIssue, error) {
 	endpoint := fmt.Sprintf("courses/%s/content_migrations/%s/migration_issues/%s", courseID, contentMigrationID, id)
 	params := map[string]interface{}{}
 	if workflowState != nil {
@@ -13967,7 +14212,7 @@ func (c *Canvas) ContentMigrationsUpdateAMigrationIssue(progress *task.Progress,
 		res = obj.(*MigrationIssue)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -13975,7 +14220,7 @@ func (c *Canvas) ContentMigrationsUpdateAMigrationIssue(progress *task.Progress,
 
 // ModeratedGradingListStudentsSelectedForModeration API call: Returns a paginated list of students selected for
 // moderation
-func (c *Canvas) ModeratedGradingListStudentsSelectedForModeration(progress *task.Progress) ([]User, error) {
+func (c *Canvas) ModeratedGradingListStudentsSelectedForModeration(ctx context.Context, progress *task.Progress) ([]User, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -13987,15 +14232,15 @@ func (c *Canvas) ModeratedGradingListStudentsSelectedForModeration(progress *tas
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ModeratedGradingSelectStudentsForModeration API call: Returns an array of users that were selected for moderation
-func (c *Canvas) ModeratedGradingSelectStudentsForModeration(progress *task.Progress, studentIds *float64) ([]User, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ModeratedGradingSelectStudentsForModeration(ctx context.Context, progress *task.Progress, studentIds *float64, courseID string, assignmentID string) ([]User, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/moderated_students", courseID, assignmentID)
 	params := map[string]interface{}{}
 	if studentIds != nil {
 		params["student_ids"] = *studentIds
@@ -14009,14 +14254,14 @@ func (c *Canvas) ModeratedGradingSelectStudentsForModeration(progress *task.Prog
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // NotificationPreferencesListPreferences API call: Fetch all preferences for the given communication channel
-func (c *Canvas) NotificationPreferencesListPreferences(progress *task.Progress) ([]NotificationPreference, error) {
+func (c *Canvas) NotificationPreferencesListPreferences(ctx context.Context, progress *task.Progress) ([]NotificationPreference, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14028,7 +14273,7 @@ func (c *Canvas) NotificationPreferencesListPreferences(progress *task.Progress)
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14036,7 +14281,7 @@ func (c *Canvas) NotificationPreferencesListPreferences(progress *task.Progress)
 
 // NotificationPreferencesListOfPreferenceCategories API call: Fetch all notification preference categories for the
 // given communication channel
-func (c *Canvas) NotificationPreferencesListOfPreferenceCategories(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) NotificationPreferencesListOfPreferenceCategories(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14047,7 +14292,7 @@ func (c *Canvas) NotificationPreferencesListOfPreferenceCategories(progress *tas
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14055,7 +14300,7 @@ func (c *Canvas) NotificationPreferencesListOfPreferenceCategories(progress *tas
 
 // NotificationPreferencesGetAPreference API call: Fetch the preference for the given notification for the given
 // communication channel
-func (c *Canvas) NotificationPreferencesGetAPreference(progress *task.Progress) (*NotificationPreference, error) {
+func (c *Canvas) NotificationPreferencesGetAPreference(ctx context.Context, progress *task.Progress) (*NotificationPreference, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14066,7 +14311,7 @@ func (c *Canvas) NotificationPreferencesGetAPreference(progress *task.Progress)
 		res = obj.(*NotificationPreference)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14074,8 +14319,8 @@ func (c *Canvas) NotificationPreferencesGetAPreference(progress *task.Progress)
 
 // NotificationPreferencesUpdateAPreference API call: Change the preference for a single notification for a single
 // communication channel
-func (c *Canvas) NotificationPreferencesUpdateAPreference(progress *task.Progress, notificationPreferences *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) NotificationPreferencesUpdateAPreference(ctx context.Context, progress *task.Progress, notificationPreferences *interface{}, communicationChannelID string, notification string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("users/self/communication_channels/%s/notification_preferences/%s", communicationChannelID, notification)
 	params := map[string]interface{}{}
 	if notificationPreferences != nil {
 		params["notification_preferences"] = *notificationPreferences
@@ -14088,7 +14333,7 @@ func (c *Canvas) NotificationPreferencesUpdateAPreference(progress *task.Progres
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14096,12 +14341,9 @@ func (c *Canvas) NotificationPreferencesUpdateAPreference(progress *task.Progres
 
 // NotificationPreferencesUpdatePreferencesByCategory API call: Change the preferences for multiple notifications based
 // on the category for a single communication channel
-func (c *Canvas) NotificationPreferencesUpdatePreferencesByCategory(progress *task.Progress, category *interface{}, notificationPreferences *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) NotificationPreferencesUpdatePreferencesByCategory(ctx context.Context, progress *task.Progress, notificationPreferences *interface{}, communicationChannelID string, category string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("users/self/communication_channels/%s/notification_preference_categories/%s", communicationChannelID, category)
 	params := map[string]interface{}{}
-	if category != nil {
-		params["category"] = *category
-	}
 	if notificationPreferences != nil {
 		params["notification_preferences"] = *notificationPreferences
 	}
@@ -14113,7 +14355,7 @@ func (c *Canvas) NotificationPreferencesUpdatePreferencesByCategory(progress *ta
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14121,8 +14363,8 @@ func (c *Canvas) NotificationPreferencesUpdatePreferencesByCategory(progress *ta
 
 // NotificationPreferencesUpdateMultiplePreferences API call: Change the preferences for multiple notifications for a
 // single communication channel at once
-func (c *Canvas) NotificationPreferencesUpdateMultiplePreferences(progress *task.Progress, notificationPreferences *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) NotificationPreferencesUpdateMultiplePreferences(ctx context.Context, progress *task.Progress, notificationPreferences *interface{}, communicationChannelID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("users/self/communication_channels/%s/notification_preferences", communicationChannelID)
 	params := map[string]interface{}{}
 	if notificationPreferences != nil {
 		params["notification_preferences"] = *notificationPreferences
@@ -14135,7 +14377,7 @@ func (c *Canvas) NotificationPreferencesUpdateMultiplePreferences(progress *task
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14143,7 +14385,7 @@ func (c *Canvas) NotificationPreferencesUpdateMultiplePreferences(progress *task
 
 // OutcomeGroupsRedirectToRootOutcomeGroupForContext API call: Convenience redirect to find the root outcome group for a
 // particular context. Will redirect to the appropriate outcome group's URL.
-func (c *Canvas) OutcomeGroupsRedirectToRootOutcomeGroupForContext(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) OutcomeGroupsRedirectToRootOutcomeGroupForContext(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14154,14 +14396,14 @@ func (c *Canvas) OutcomeGroupsRedirectToRootOutcomeGroupForContext(progress *tas
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomeGroupsGetAllOutcomeGroupsForContext API call
-func (c *Canvas) OutcomeGroupsGetAllOutcomeGroupsForContext(progress *task.Progress) ([]OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsGetAllOutcomeGroupsForContext(ctx context.Context, progress *task.Progress) ([]OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14173,14 +14415,14 @@ func (c *Canvas) OutcomeGroupsGetAllOutcomeGroupsForContext(progress *task.Progr
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomeGroupsGetAllOutcomeLinksForContext API call
-func (c *Canvas) OutcomeGroupsGetAllOutcomeLinksForContext(progress *task.Progress, outcomeStyle *string, outcomeGroupStyle *string) ([]OutcomeLink, error) {
+func (c *Canvas) OutcomeGroupsGetAllOutcomeLinksForContext(ctx context.Context, progress *task.Progress, outcomeStyle *string, outcomeGroupStyle *string) ([]OutcomeLink, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if outcomeStyle != nil {
@@ -14198,14 +14440,14 @@ func (c *Canvas) OutcomeGroupsGetAllOutcomeLinksForContext(progress *task.Progre
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomeGroupsShowAnOutcomeGroup API call
-func (c *Canvas) OutcomeGroupsShowAnOutcomeGroup(progress *task.Progress) (*OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsShowAnOutcomeGroup(ctx context.Context, progress *task.Progress) (*OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14216,7 +14458,7 @@ func (c *Canvas) OutcomeGroupsShowAnOutcomeGroup(progress *task.Progress) (*Outc
 		res = obj.(*OutcomeGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14225,7 +14467,7 @@ func (c *Canvas) OutcomeGroupsShowAnOutcomeGroup(progress *task.Progress) (*Outc
 // OutcomeGroupsUpdateAnOutcomeGroup API call: Modify an existing outcome group. Fields not provided are left as is;
 // unrecognized fields are ignored. When changing the parent outcome group, the new parent group must belong to the same
 // context as this outcome group, and must not be a descendant of this outcome group (i.e. no cycles allowed).
-func (c *Canvas) OutcomeGroupsUpdateAnOutcomeGroup(progress *task.Progress, title *string, description *string, vendorGUID *string, parentOutcomeGroupID *int) (*OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsUpdateAnOutcomeGroup(ctx context.Context, progress *task.Progress, title *string, description *string, vendorGUID *string, parentOutcomeGroupID *int) (*OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("accounts/1/outcome_groups/2.json")
 	params := map[string]interface{}{}
 	if title != nil {
@@ -14248,7 +14490,7 @@ func (c *Canvas) OutcomeGroupsUpdateAnOutcomeGroup(progress *task.Progress, titl
 		res = obj.(*OutcomeGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14258,7 +14500,7 @@ func (c *Canvas) OutcomeGroupsUpdateAnOutcomeGroup(progress *task.Progress, titl
 // links. The linked outcomes themselves are only deleted if all links to the outcome were deleted. Aligned outcomes
 // cannot be deleted; as such, if all remaining links to an aligned outcome are included in this group's descendants,
 // the group deletion will fail.
-func (c *Canvas) OutcomeGroupsDeleteAnOutcomeGroup(progress *task.Progress) (*OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsDeleteAnOutcomeGroup(ctx context.Context, progress *task.Progress) (*OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("accounts/1/outcome_groups/2.json")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14269,7 +14511,7 @@ func (c *Canvas) OutcomeGroupsDeleteAnOutcomeGroup(progress *task.Progress) (*Ou
 		res = obj.(*OutcomeGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14277,7 +14519,7 @@ func (c *Canvas) OutcomeGroupsDeleteAnOutcomeGroup(progress *task.Progress) (*Ou
 
 // OutcomeGroupsListLinkedOutcomes API call: A paginated list of the immediate OutcomeLink children of the outcome
 // group.
-func (c *Canvas) OutcomeGroupsListLinkedOutcomes(progress *task.Progress, outcomeStyle *string) ([]OutcomeLink, error) {
+func (c *Canvas) OutcomeGroupsListLinkedOutcomes(ctx context.Context, progress *task.Progress, outcomeStyle *string) ([]OutcomeLink, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if outcomeStyle != nil {
@@ -14292,7 +14534,7 @@ func (c *Canvas) OutcomeGroupsListLinkedOutcomes(progress *task.Progress, outcom
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14310,7 +14552,7 @@ func (c *Canvas) OutcomeGroupsListLinkedOutcomes(progress *task.Progress, outcom
 // the maximum points in the highest rating if not specified in the mastery_points parameter. Any ratings lacking a
 // description are given a default of "No description". Any ratings lacking a point value are given a default of 0. If
 // no ratings are provided, the mastery_points parameter is ignored.
-func (c *Canvas) OutcomeGroupsCreateLinkAnOutcome(progress *task.Progress, outcomeID *int, moveFrom *int, title *string, displayName *string, description *string, vendorGUID *string, masteryPoints *int, ratings *string, calculationMethod *OutcomeGroupsCreateLinkAnOutcomeCalculationMethod, calculationInt *int) (*OutcomeLink, error) {
+func (c *Canvas) OutcomeGroupsCreateLinkAnOutcome(ctx context.Context, progress *task.Progress, outcomeID *int, moveFrom *int, title *string, displayName *string, description *string, vendorGUID *string, masteryPoints *int, ratings *string, calculationMethod *OutcomeGroupsCreateLinkAnOutcomeCalculationMethod, calculationInt *int) (*OutcomeLink, error) {
 	endpoint := fmt.Sprintf("accounts/1/outcome_groups/1/outcomes.json")
 	params := map[string]interface{}{}
 	if outcomeID != nil {
@@ -14351,7 +14593,7 @@ func (c *Canvas) OutcomeGroupsCreateLinkAnOutcome(progress *task.Progress, outco
 		res = obj.(*OutcomeLink)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14360,7 +14602,7 @@ func (c *Canvas) OutcomeGroupsCreateLinkAnOutcome(progress *task.Progress, outco
 // OutcomeGroupsUnlinkAnOutcome API call: Unlinking an outcome only deletes the outcome itself if this was the last link
 // to the outcome in any group in any context. Aligned outcomes cannot be deleted; as such, if this is the last link to
 // an aligned outcome, the unlinking will fail.
-func (c *Canvas) OutcomeGroupsUnlinkAnOutcome(progress *task.Progress) (*OutcomeLink, error) {
+func (c *Canvas) OutcomeGroupsUnlinkAnOutcome(ctx context.Context, progress *task.Progress) (*OutcomeLink, error) {
 	endpoint := fmt.Sprintf("accounts/1/outcome_groups/1/outcomes/1.json")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14371,14 +14613,14 @@ func (c *Canvas) OutcomeGroupsUnlinkAnOutcome(progress *task.Progress) (*Outcome
 		res = obj.(*OutcomeLink)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomeGroupsListSubgroups API call: A paginated list of the immediate OutcomeGroup children of the outcome group.
-func (c *Canvas) OutcomeGroupsListSubgroups(progress *task.Progress) ([]OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsListSubgroups(ctx context.Context, progress *task.Progress) ([]OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14390,7 +14632,7 @@ func (c *Canvas) OutcomeGroupsListSubgroups(progress *task.Progress) ([]OutcomeG
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14398,7 +14640,7 @@ func (c *Canvas) OutcomeGroupsListSubgroups(progress *task.Progress) ([]OutcomeG
 
 // OutcomeGroupsCreateASubgroup API call: Creates a new empty subgroup under the outcome group with the given title and
 // description.
-func (c *Canvas) OutcomeGroupsCreateASubgroup(progress *task.Progress, title *string, description *string, vendorGUID *string) (*OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsCreateASubgroup(ctx context.Context, progress *task.Progress, title *string, description *string, vendorGUID *string) (*OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("accounts/1/outcome_groups/1/subgroups.json")
 	params := map[string]interface{}{}
 	if title != nil {
@@ -14418,7 +14660,7 @@ func (c *Canvas) OutcomeGroupsCreateASubgroup(progress *task.Progress, title *st
 		res = obj.(*OutcomeGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14430,7 +14672,7 @@ func (c *Canvas) OutcomeGroupsCreateASubgroup(progress *task.Progress, title *st
 // Allows you to copy organizational structure, but does not create copies of the outcomes themselves, only new links.
 // The source group must be either global, from the same context as this outcome group, or from an associated account.
 // The source group cannot be the root outcome group of its context.
-func (c *Canvas) OutcomeGroupsImportAnOutcomeGroup(progress *task.Progress, sourceOutcomeGroupID *int, async *bool) (*OutcomeGroup, error) {
+func (c *Canvas) OutcomeGroupsImportAnOutcomeGroup(ctx context.Context, progress *task.Progress, sourceOutcomeGroupID *int, async *bool) (*OutcomeGroup, error) {
 	endpoint := fmt.Sprintf("accounts/2/outcome_groups/3/import.json")
 	params := map[string]interface{}{}
 	if sourceOutcomeGroupID != nil {
@@ -14447,7 +14689,7 @@ func (c *Canvas) OutcomeGroupsImportAnOutcomeGroup(progress *task.Progress, sour
 		res = obj.(*OutcomeGroup)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14455,8 +14697,8 @@ func (c *Canvas) OutcomeGroupsImportAnOutcomeGroup(progress *task.Progress, sour
 
 // OutcomeImportsImportOutcomes API call: Import outcomes into Canvas. For more information on the format that's
 // expected here, please see the "Outcomes CSV" section in the API docs.
-func (c *Canvas) OutcomeImportsImportOutcomes(progress *task.Progress, importType *string, attachment *interface{}, extension *string) (*OutcomeImport, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) OutcomeImportsImportOutcomes(ctx context.Context, progress *task.Progress, importType *string, attachment *interface{}, extension *string, accountID string) (*OutcomeImport, error) {
+	endpoint := fmt.Sprintf("accounts/%s/outcome_imports", accountID)
 	params := map[string]interface{}{}
 	if importType != nil {
 		params["import_type"] = *importType
@@ -14475,7 +14717,7 @@ func (c *Canvas) OutcomeImportsImportOutcomes(progress *task.Progress, importTyp
 		res = obj.(*OutcomeImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14486,7 +14728,7 @@ func (c *Canvas) OutcomeImportsImportOutcomes(progress *task.Progress, importTyp
 // 'https://<canvas>/api/v1/accounts/<account_id>/outcome_imports/<outcome_import_id>' \ -H "Authorization: Bearer
 // <token>" curl 'https://<canvas>/api/v1/courses/<course_id>/outcome_imports/<outcome_import_id>' \ -H "Authorization:
 // Bearer <token>"
-func (c *Canvas) OutcomeImportsGetOutcomeImportStatus(progress *task.Progress) (*OutcomeImport, error) {
+func (c *Canvas) OutcomeImportsGetOutcomeImportStatus(ctx context.Context, progress *task.Progress) (*OutcomeImport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14497,7 +14739,7 @@ func (c *Canvas) OutcomeImportsGetOutcomeImportStatus(progress *task.Progress) (
 		res = obj.(*OutcomeImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14505,7 +14747,7 @@ func (c *Canvas) OutcomeImportsGetOutcomeImportStatus(progress *task.Progress) (
 
 // ProficiencyRatingsCreateUpdateProficiencyRatings API call: Create or update account-level proficiency ratings. These
 // ratings will apply to all sub-accounts, unless they have their own account-level proficiency ratings defined.
-func (c *Canvas) ProficiencyRatingsCreateUpdateProficiencyRatings(progress *task.Progress, ratings *string, accountID string) (*Proficiency, error) {
+func (c *Canvas) ProficiencyRatingsCreateUpdateProficiencyRatings(ctx context.Context, progress *task.Progress, ratings *string, accountID string) (*Proficiency, error) {
 	endpoint := fmt.Sprintf("accounts/%s/outcome_proficiency", accountID)
 	params := map[string]interface{}{}
 	if ratings != nil {
@@ -14519,7 +14761,7 @@ func (c *Canvas) ProficiencyRatingsCreateUpdateProficiencyRatings(progress *task
 		res = obj.(*Proficiency)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14529,7 +14771,7 @@ func (c *Canvas) ProficiencyRatingsCreateUpdateProficiencyRatings(progress *task
 // account, it will return proficiency ratings for the nearest super-account with ratings defined. Will return 404 if
 // none found. Examples: curl https://<canvas>/api/v1/accounts/<account_id>/outcome_proficiency \ -H 'Authorization:
 // Bearer <token>'
-func (c *Canvas) ProficiencyRatingsGetProficiencyRatings(progress *task.Progress) (*Proficiency, error) {
+func (c *Canvas) ProficiencyRatingsGetProficiencyRatings(ctx context.Context, progress *task.Progress) (*Proficiency, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14540,14 +14782,14 @@ func (c *Canvas) ProficiencyRatingsGetProficiencyRatings(progress *task.Progress
 		res = obj.(*Proficiency)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomeResultsGetOutcomeResults API call: Gets the outcome results for users and outcomes in the specified context.
-func (c *Canvas) OutcomeResultsGetOutcomeResults(progress *task.Progress, userIds *int, outcomeIds *int, include *OutcomeResultsGetOutcomeResultsInclude, includeHidden *bool) (*map[string]interface{}, error) {
+func (c *Canvas) OutcomeResultsGetOutcomeResults(ctx context.Context, progress *task.Progress, userIds *int, outcomeIds *int, include *OutcomeResultsGetOutcomeResultsInclude, includeHidden *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if userIds != nil {
@@ -14570,7 +14812,7 @@ func (c *Canvas) OutcomeResultsGetOutcomeResults(progress *task.Progress, userId
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14578,7 +14820,7 @@ func (c *Canvas) OutcomeResultsGetOutcomeResults(progress *task.Progress, userId
 
 // OutcomeResultsGetOutcomeResultRollups API call: Gets the outcome rollups for the users and outcomes in the specified
 // context.
-func (c *Canvas) OutcomeResultsGetOutcomeResultRollups(progress *task.Progress, aggregate *OutcomeResultsGetOutcomeResultRollupsAggregate, aggregateStat *OutcomeResultsGetOutcomeResultRollupsAggregateStat, userIds *int, outcomeIds *int, include *OutcomeResultsGetOutcomeResultRollupsInclude, exclude *OutcomeResultsGetOutcomeResultRollupsExclude, sortBy *OutcomeResultsGetOutcomeResultRollupsSortBy, sortOutcomeID *int, sortOrder *OutcomeResultsGetOutcomeResultRollupsSortOrder) (*map[string]interface{}, error) {
+func (c *Canvas) OutcomeResultsGetOutcomeResultRollups(ctx context.Context, progress *task.Progress, aggregate *OutcomeResultsGetOutcomeResultRollupsAggregate, aggregateStat *OutcomeResultsGetOutcomeResultRollupsAggregateStat, userIds *int, outcomeIds *int, include *OutcomeResultsGetOutcomeResultRollupsInclude, exclude *OutcomeResultsGetOutcomeResultRollupsExclude, sortBy *OutcomeResultsGetOutcomeResultRollupsSortBy, sortOutcomeID *int, sortOrder *OutcomeResultsGetOutcomeResultRollupsSortOrder) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if aggregate != nil {
@@ -14616,14 +14858,14 @@ func (c *Canvas) OutcomeResultsGetOutcomeResultRollups(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomesShowAnOutcome API call: Returns the details of the outcome with the given id.
-func (c *Canvas) OutcomesShowAnOutcome(progress *task.Progress) (*Outcome, error) {
+func (c *Canvas) OutcomesShowAnOutcome(ctx context.Context, progress *task.Progress) (*Outcome, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14634,7 +14876,7 @@ func (c *Canvas) OutcomesShowAnOutcome(progress *task.Progress) (*Outcome, error
 		res = obj.(*Outcome)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14646,7 +14888,7 @@ func (c *Canvas) OutcomesShowAnOutcome(progress *task.Progress) (*Outcome, error
 // embedded rubric criterion's mastery_points default to the maximum points in the highest rating if not specified in
 // the mastery_points parameter. Any new ratings lacking a description are given a default of "No description". Any new
 // ratings lacking a point value are given a default of 0.
-func (c *Canvas) OutcomesUpdateAnOutcome(progress *task.Progress, title *string, displayName *string, description *string, vendorGUID *string, masteryPoints *int, ratings *string, calculationMethod *OutcomesUpdateAnOutcomeCalculationMethod, calculationInt *int) (*Outcome, error) {
+func (c *Canvas) OutcomesUpdateAnOutcome(ctx context.Context, progress *task.Progress, title *string, displayName *string, description *string, vendorGUID *string, masteryPoints *int, ratings *string, calculationMethod *OutcomesUpdateAnOutcomeCalculationMethod, calculationInt *int) (*Outcome, error) {
 	endpoint := fmt.Sprintf("outcomes/1.json")
 	params := map[string]interface{}{}
 	if title != nil {
@@ -14681,14 +14923,14 @@ func (c *Canvas) OutcomesUpdateAnOutcome(progress *task.Progress, title *string,
 		res = obj.(*Outcome)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // OutcomesGetAlignedAssignmentsForAnOutcomeInACourseForAParticularStudent API call
-func (c *Canvas) OutcomesGetAlignedAssignmentsForAnOutcomeInACourseForAParticularStudent(progress *task.Progress, courseID *int, studentID *int) ([]OutcomeAlignment, error) {
+func (c *Canvas) OutcomesGetAlignedAssignmentsForAnOutcomeInACourseForAParticularStudent(ctx context.Context, progress *task.Progress, courseID *int, studentID *int) ([]OutcomeAlignment, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if courseID != nil {
@@ -14706,7 +14948,7 @@ func (c *Canvas) OutcomesGetAlignedAssignmentsForAnOutcomeInACourseForAParticula
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14714,7 +14956,7 @@ func (c *Canvas) OutcomesGetAlignedAssignmentsForAnOutcomeInACourseForAParticula
 
 // UsersListUserPageViews API call: Return a paginated list of the user's page view history in json format, similar to
 // the available CSV download. Page views are returned in descending order, newest to oldest.
-func (c *Canvas) UsersListUserPageViews(progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]PageView, error) {
+func (c *Canvas) UsersListUserPageViews(ctx context.Context, progress *task.Progress, startTime *time.Time, endTime *time.Time) ([]PageView, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startTime != nil {
@@ -14732,14 +14974,14 @@ func (c *Canvas) UsersListUserPageViews(progress *task.Progress, startTime *time
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PeerReviewsGetAllPeerReviews API call: Get a list of all Peer Reviews for this assignment
-func (c *Canvas) PeerReviewsGetAllPeerReviews(progress *task.Progress, include *PeerReviewsGetAllPeerReviewsInclude) ([]PeerReview, error) {
+func (c *Canvas) PeerReviewsGetAllPeerReviews(ctx context.Context, progress *task.Progress, include *PeerReviewsGetAllPeerReviewsInclude) ([]PeerReview, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -14754,15 +14996,15 @@ func (c *Canvas) PeerReviewsGetAllPeerReviews(progress *task.Progress, include *
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PeerReviewsCreatePeerReview API call: Create a peer review for the assignment
-func (c *Canvas) PeerReviewsCreatePeerReview(progress *task.Progress, userID *int) (*PeerReview, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PeerReviewsCreatePeerReview(ctx context.Context, progress *task.Progress, userID *int, courseID string, assignmentID string, submissionID string) (*PeerReview, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/peer_reviews", courseID, assignmentID, submissionID)
 	params := map[string]interface{}{}
 	if userID != nil {
 		params["user_id"] = *userID
@@ -14775,15 +15017,15 @@ func (c *Canvas) PeerReviewsCreatePeerReview(progress *task.Progress, userID *in
 		res = obj.(*PeerReview)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PeerReviewsDeletePeerReview API call: Delete a peer review for the assignment
-func (c *Canvas) PeerReviewsDeletePeerReview(progress *task.Progress, userID *int) (*PeerReview, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PeerReviewsDeletePeerReview(ctx context.Context, progress *task.Progress, userID *int, courseID string, assignmentID string, submissionID string) (*PeerReview, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/peer_reviews", courseID, assignmentID, submissionID)
 	params := map[string]interface{}{}
 	if userID != nil {
 		params["user_id"] = *userID
@@ -14796,7 +15038,7 @@ func (c *Canvas) PeerReviewsDeletePeerReview(progress *task.Progress, userID *in
 		res = obj.(*PeerReview)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -14805,7 +15047,7 @@ func (c *Canvas) PeerReviewsDeletePeerReview(progress *task.Progress, userID *in
 // PlannerListPlannerItems API call: Retrieve the paginated list of objects to be shown on the planner for the current
 // user with the associated planner override to override an item's visibility if set. Planner items for a student may
 // also be retrieved by a linked observer. Use the path that accepts a user_id and supply the student's id.
-func (c *Canvas) PlannerListPlannerItems(progress *task.Progress, startDate *time.Time, endDate *time.Time, contextCodes *string, filter *PlannerListPlannerItemsFilter) (*map[string]interface{}, error) {
+func (c *Canvas) PlannerListPlannerItems(ctx context.Context, progress *task.Progress, startDate *time.Time, endDate *time.Time, contextCodes *string, filter *PlannerListPlannerItemsFilter) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if startDate != nil {
@@ -14828,15 +15070,15 @@ func (c *Canvas) PlannerListPlannerItems(progress *task.Progress, startDate *tim
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerListPlannerNotes API call: Retrieve planner note for a user
-func (c *Canvas) PlannerListPlannerNotes(progress *task.Progress, startDate *time.Time, endDate *time.Time, contextCodes *string) ([]PlannerNote, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerListPlannerNotes(ctx context.Context, progress *task.Progress, startDate *time.Time, endDate *time.Time, contextCodes []string) ([]PlannerNote, error) {
+	endpoint := fmt.Sprintf("planner_notes")
 	params := map[string]interface{}{}
 	if startDate != nil {
 		params["start_date"] = *startDate
@@ -14844,8 +15086,8 @@ func (c *Canvas) PlannerListPlannerNotes(progress *task.Progress, startDate *tim
 	if endDate != nil {
 		params["end_date"] = *endDate
 	}
-	if contextCodes != nil {
-		params["context_codes"] = *contextCodes
+	if contextCodes != nil && len(contextCodes) > 0 {
+		params["context_codes"] = contextCodes
 	}
 	responseCtor := func() interface{} {
 		return &[]PlannerNote{}
@@ -14856,14 +15098,14 @@ func (c *Canvas) PlannerListPlannerNotes(progress *task.Progress, startDate *tim
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerShowAPlannerNote API call: Retrieve a planner note for the current user
-func (c *Canvas) PlannerShowAPlannerNote(progress *task.Progress) (*PlannerNote, error) {
+func (c *Canvas) PlannerShowAPlannerNote(ctx context.Context, progress *task.Progress) (*PlannerNote, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14874,15 +15116,15 @@ func (c *Canvas) PlannerShowAPlannerNote(progress *task.Progress) (*PlannerNote,
 		res = obj.(*PlannerNote)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerUpdateAPlannerNote API call: Update a planner note for the current user
-func (c *Canvas) PlannerUpdateAPlannerNote(progress *task.Progress, title *string, details *string, todoDate *time.Time, courseID *int) (*PlannerNote, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerUpdateAPlannerNote(ctx context.Context, progress *task.Progress, title *string, details *string, todoDate *time.Time, courseID *int, id string) (*PlannerNote, error) {
+	endpoint := fmt.Sprintf("planner_notes/%s", id)
 	params := map[string]interface{}{}
 	if title != nil {
 		params["title"] = *title
@@ -14904,15 +15146,15 @@ func (c *Canvas) PlannerUpdateAPlannerNote(progress *task.Progress, title *strin
 		res = obj.(*PlannerNote)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerCreateAPlannerNote API call: Create a planner note for the current user
-func (c *Canvas) PlannerCreateAPlannerNote(progress *task.Progress, title *string, details *string, todoDate *time.Time, courseID *int, linkedObjectType *string, linkedObjectID *int) (*PlannerNote, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerCreateAPlannerNote(ctx context.Context, progress *task.Progress, title *string, details *string, todoDate *time.Time, courseID *int, linkedObjectType *string, linkedObjectID *int) (*PlannerNote, error) {
+	endpoint := fmt.Sprintf("planner_notes")
 	params := map[string]interface{}{}
 	if title != nil {
 		params["title"] = *title
@@ -14940,15 +15182,15 @@ func (c *Canvas) PlannerCreateAPlannerNote(progress *task.Progress, title *strin
 		res = obj.(*PlannerNote)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerDeleteAPlannerNote API call: Delete a planner note for the current user
-func (c *Canvas) PlannerDeleteAPlannerNote(progress *task.Progress) (*PlannerNote, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerDeleteAPlannerNote(ctx context.Context, progress *task.Progress, id string) (*PlannerNote, error) {
+	endpoint := fmt.Sprintf("planner_notes/%s", id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &PlannerNote{}
@@ -14958,14 +15200,14 @@ func (c *Canvas) PlannerDeleteAPlannerNote(progress *task.Progress) (*PlannerNot
 		res = obj.(*PlannerNote)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerListPlannerOverrides API call: Retrieve a planner override for the current user
-func (c *Canvas) PlannerListPlannerOverrides(progress *task.Progress) ([]PlannerOverride, error) {
+func (c *Canvas) PlannerListPlannerOverrides(ctx context.Context, progress *task.Progress) ([]PlannerOverride, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14977,14 +15219,14 @@ func (c *Canvas) PlannerListPlannerOverrides(progress *task.Progress) ([]Planner
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerShowAPlannerOverride API call: Retrieve a planner override for the current user
-func (c *Canvas) PlannerShowAPlannerOverride(progress *task.Progress) (*PlannerOverride, error) {
+func (c *Canvas) PlannerShowAPlannerOverride(ctx context.Context, progress *task.Progress) (*PlannerOverride, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -14995,15 +15237,15 @@ func (c *Canvas) PlannerShowAPlannerOverride(progress *task.Progress) (*PlannerO
 		res = obj.(*PlannerOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerUpdateAPlannerOverride API call: Update a planner override's visibilty for the current user
-func (c *Canvas) PlannerUpdateAPlannerOverride(progress *task.Progress, markedComplete *interface{}, dismissed *interface{}) (*PlannerOverride, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerUpdateAPlannerOverride(ctx context.Context, progress *task.Progress, markedComplete *interface{}, dismissed *interface{}, id string) (*PlannerOverride, error) {
+	endpoint := fmt.Sprintf("planner/overrides/%s", id)
 	params := map[string]interface{}{}
 	if markedComplete != nil {
 		params["marked_complete"] = *markedComplete
@@ -15019,15 +15261,15 @@ func (c *Canvas) PlannerUpdateAPlannerOverride(progress *task.Progress, markedCo
 		res = obj.(*PlannerOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerCreateAPlannerOverride API call: Create a planner override for the current user
-func (c *Canvas) PlannerCreateAPlannerOverride(progress *task.Progress, plannableType *PlannerCreateAPlannerOverridePlannableType, plannableID *int, markedComplete *bool, dismissed *bool) (*PlannerOverride, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerCreateAPlannerOverride(ctx context.Context, progress *task.Progress, plannableType *PlannerCreateAPlannerOverridePlannableType, plannableID *int, markedComplete *bool, dismissed *bool) (*PlannerOverride, error) {
+	endpoint := fmt.Sprintf("planner/overrides")
 	params := map[string]interface{}{}
 	if plannableType != nil {
 		params["plannable_type"] = *plannableType
@@ -15049,15 +15291,15 @@ func (c *Canvas) PlannerCreateAPlannerOverride(progress *task.Progress, plannabl
 		res = obj.(*PlannerOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PlannerDeleteAPlannerOverride API call: Delete a planner override for the current user
-func (c *Canvas) PlannerDeleteAPlannerOverride(progress *task.Progress) (*PlannerOverride, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) PlannerDeleteAPlannerOverride(ctx context.Context, progress *task.Progress, id string) (*PlannerOverride, error) {
+	endpoint := fmt.Sprintf("planner/overrides/%s", id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &PlannerOverride{}
@@ -15067,7 +15309,7 @@ func (c *Canvas) PlannerDeleteAPlannerOverride(progress *task.Progress) (*Planne
 		res = obj.(*PlannerOverride)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15075,7 +15317,7 @@ func (c *Canvas) PlannerDeleteAPlannerOverride(progress *task.Progress) (*Planne
 
 // UsersGetUserProfile API call: Returns user profile data, including user id, name, and profile pic. When requesting
 // the profile for the user accessing the API, the user's calendar feed URL and LTI user id will be returned as well.
-func (c *Canvas) UsersGetUserProfile(progress *task.Progress) (*Profile, error) {
+func (c *Canvas) UsersGetUserProfile(ctx context.Context, progress *task.Progress) (*Profile, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15086,7 +15328,7 @@ func (c *Canvas) UsersGetUserProfile(progress *task.Progress) (*Profile, error)
 		res = obj.(*Profile)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15103,7 +15345,7 @@ func (c *Canvas) UsersGetUserProfile(progress *task.Progress) (*Profile, error)
 // client. display_name:: A textual description of the avatar record id:: ['attachment' type only] the internal id of
 // the attachment content-type:: ['attachment' type only] the content-type of the attachment filename:: ['attachment'
 // type only] the filename of the attachment size:: ['attachment' type only] the size of the attachment
-func (c *Canvas) UsersListAvatarOptions(progress *task.Progress) ([]Avatar, error) {
+func (c *Canvas) UsersListAvatarOptions(ctx context.Context, progress *task.Progress) ([]Avatar, error) {
 	endpoint := fmt.Sprintf("users/1/avatars.json")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15115,14 +15357,14 @@ func (c *Canvas) UsersListAvatarOptions(progress *task.Progress) ([]Avatar, erro
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ProgressQueryProgress API call: Return completion and status information about an asynchronous job
-func (c *Canvas) ProgressQueryProgress(progress *task.Progress) (*Progress, error) {
+func (c *Canvas) ProgressQueryProgress(ctx context.Context, progress *task.Progress) (*Progress, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15133,7 +15375,7 @@ func (c *Canvas) ProgressQueryProgress(progress *task.Progress) (*Progress, erro
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15142,8 +15384,8 @@ func (c *Canvas) ProgressQueryProgress(progress *task.Progress) (*Progress, erro
 // ModeratedGradingBulkSelectProvisionalGrades API call: Choose which provisional grades will be received by associated
 // students for an assignment. The caller must be the final grader for the assignment or an admin with
 // :select_final_grade rights.
-func (c *Canvas) ModeratedGradingBulkSelectProvisionalGrades(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ModeratedGradingBulkSelectProvisionalGrades(ctx context.Context, progress *task.Progress, courseID string, assignmentID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/provisional_grades/bulk_select", courseID, assignmentID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -15153,7 +15395,7 @@ func (c *Canvas) ModeratedGradingBulkSelectProvisionalGrades(progress *task.Prog
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15161,7 +15403,7 @@ func (c *Canvas) ModeratedGradingBulkSelectProvisionalGrades(progress *task.Prog
 
 // ModeratedGradingShowProvisionalGradeStatusForAStudent2 API call: Tell whether the student's submission needs one or
 // more provisional grades.
-func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent2(progress *task.Progress, studentID *int) (*map[string]interface{}, error) {
+func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent2(ctx context.Context, progress *task.Progress, studentID *int) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/provisional_grades/status")
 	params := map[string]interface{}{}
 	if studentID != nil {
@@ -15175,7 +15417,7 @@ func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent2(progress
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15183,8 +15425,8 @@ func (c *Canvas) ModeratedGradingShowProvisionalGradeStatusForAStudent2(progress
 
 // ModeratedGradingSelectProvisionalGrade API call: Choose which provisional grade the student should receive for a
 // submission. The caller must be the final grader for the assignment or an admin with :select_final_grade rights.
-func (c *Canvas) ModeratedGradingSelectProvisionalGrade(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ModeratedGradingSelectProvisionalGrade(ctx context.Context, progress *task.Progress, courseID string, assignmentID string, provisionalGradeID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/provisional_grades/%s/select", courseID, assignmentID, provisionalGradeID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -15194,7 +15436,7 @@ func (c *Canvas) ModeratedGradingSelectProvisionalGrade(progress *task.Progress)
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15204,7 +15446,7 @@ func (c *Canvas) ModeratedGradingSelectProvisionalGrade(progress *task.Progress)
 // submissions to an assignment. Use the "Select provisional grade" endpoint to choose which provisional grade to
 // publish for a particular submission. Students not in the moderation set will have their one and only provisional
 // grade published. WARNING: This is irreversible. This will overwrite existing grades in the gradebook.
-func (c *Canvas) ModeratedGradingPublishProvisionalGradesForAnAssignment(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ModeratedGradingPublishProvisionalGradesForAnAssignment(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/provisional_grades/publish")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15215,14 +15457,14 @@ func (c *Canvas) ModeratedGradingPublishProvisionalGradesForAnAssignment(progres
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LoginsListUserLogins API call: Given a user ID, return a paginated list of that user's logins for the given account.
-func (c *Canvas) LoginsListUserLogins(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) LoginsListUserLogins(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15233,14 +15475,14 @@ func (c *Canvas) LoginsListUserLogins(progress *task.Progress) (*map[string]inte
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LoginsCreateAUserLogin API call: Create a new login for an existing user in the given account.
-func (c *Canvas) LoginsCreateAUserLogin(progress *task.Progress, user *string, login *string, accountID string) (*map[string]interface{}, error) {
+func (c *Canvas) LoginsCreateAUserLogin(ctx context.Context, progress *task.Progress, user *string, login *string, accountID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("accounts/%s/logins", accountID)
 	params := map[string]interface{}{}
 	if user != nil {
@@ -15257,15 +15499,15 @@ func (c *Canvas) LoginsCreateAUserLogin(progress *task.Progress, user *string, l
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LoginsEditAUserLogin API call: Update an existing login for a user in the given account.
-func (c *Canvas) LoginsEditAUserLogin(progress *task.Progress, login *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) LoginsEditAUserLogin(ctx context.Context, progress *task.Progress, login *string, accountID string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("accounts/%s/logins/%s", accountID, id)
 	params := map[string]interface{}{}
 	if login != nil {
 		params["login"] = *login
@@ -15278,14 +15520,14 @@ func (c *Canvas) LoginsEditAUserLogin(progress *task.Progress, login *string) (*
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // LoginsDeleteAUserLogin API call: Delete an existing login.
-func (c *Canvas) LoginsDeleteAUserLogin(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) LoginsDeleteAUserLogin(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/:user_id/logins/:login_id")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15296,14 +15538,236 @@ func (c *Canvas) LoginsDeleteAUserLogin(progress *task.Progress) (*map[string]in
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizSubmissionsGetAllQuizSubmissions API call: Get a list of all submissions for this quiz. Users who can view or
+// manage grades for a course will have submissions from multiple users returned. A user who can only submit will have
+// only their own submissions returned. When a user has an in-progress submission, only that submission is returned.
+// When there isn't an in-progress quiz_submission, all completed submissions, including previous attempts, are
+// returned. <b>200 OK</b> response code is returned if the request was successful.
+func (c *Canvas) QuizSubmissionsGetAllQuizSubmissions(ctx context.Context, progress *task.Progress, include []QuizSubmissionsGetAllQuizSubmissionsInclude, courseID string, quizID string) (*QuizSubmissionsResponse, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s/submissions", courseID, quizID)
+	params := map[string]interface{}{}
+	if include != nil && len(include) > 0 {
+		params["include"] = include
+	}
+	responseCtor := func() interface{} {
+		return &QuizSubmissionsResponse{}
+	}
+	var res *QuizSubmissionsResponse
+	callback := func(obj interface{}) error {
+		res = obj.(*QuizSubmissionsResponse)
+		return nil
+	}
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizSubmissionsGetTheQuizSubmission API call: Get the submission for this quiz for the current user. <b>200 OK</b>
+// response code is returned if the request was successful.
+func (c *Canvas) QuizSubmissionsGetTheQuizSubmission(ctx context.Context, progress *task.Progress, include []QuizSubmissionsGetTheQuizSubmissionInclude, courseID string, quizID string) (*QuizSubmissionsResponse, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s/submission", courseID, quizID)
+	params := map[string]interface{}{}
+	if include != nil && len(include) > 0 {
+		params["include"] = include
+	}
+	responseCtor := func() interface{} {
+		return &QuizSubmissionsResponse{}
+	}
+	var res *QuizSubmissionsResponse
+	callback := func(obj interface{}) error {
+		res = obj.(*QuizSubmissionsResponse)
+		return nil
+	}
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizSubmissionsGetASingleQuizSubmission API call: Get a single quiz submission. <b>200 OK</b> response code is
+// returned if the request was successful.
+func (c *Canvas) QuizSubmissionsGetASingleQuizSubmission(ctx context.Context, progress *task.Progress, include []QuizSubmissionsGetASingleQuizSubmissionInclude, courseID string, quizID string, id string) (*QuizSubmissionsResponse, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s/submissions/%s", courseID, quizID, id)
+	params := map[string]interface{}{}
+	if include != nil && len(include) > 0 {
+		params["include"] = include
+	}
+	responseCtor := func() interface{} {
+		return &QuizSubmissionsResponse{}
+	}
+	var res *QuizSubmissionsResponse
+	callback := func(obj interface{}) error {
+		res = obj.(*QuizSubmissionsResponse)
+		return nil
+	}
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizSubmissionsCreateTheQuizSubmissionStartAQuizTakingSession API call: Start taking a Quiz by creating a
+// QuizSubmission which you can use to answer questions and submit your answers.
+func (c *Canvas) QuizSubmissionsCreateTheQuizSubmissionStartAQuizTakingSession(ctx context.Context, progress *task.Progress, accessCode *string, preview *bool, courseID string, quizID string) (*QuizSubmissionsResponse, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s/submissions", courseID, quizID)
+	params := map[string]interface{}{}
+	if accessCode != nil {
+		params["access_code"] = *accessCode
+	}
+	if preview != nil {
+		params["preview"] = *preview
+	}
+	responseCtor := func() interface{} {
+		return &QuizSubmissionsResponse{}
+	}
+	var res *QuizSubmissionsResponse
+	callback := func(obj interface{}) error {
+		res = obj.(*QuizSubmissionsResponse)
+		return nil
+	}
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizSubmissionsCompleteTheQuizSubmissionTurnItIn API call: Complete the quiz submission by marking it as complete and
+// grading it. When the quiz submission has been marked as complete, no further modifications will be allowed.
+func (c *Canvas) QuizSubmissionsCompleteTheQuizSubmissionTurnItIn(ctx context.Context, progress *task.Progress, attempt *int, validationToken *string, accessCode *string, courseID string, quizID string, id string) (*QuizSubmissionsResponse, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s/submissions/%s/complete", courseID, quizID, id)
+	params := map[string]interface{}{}
+	if attempt != nil {
+		params["attempt"] = *attempt
+	}
+	if validationToken != nil {
+		params["validation_token"] = *validationToken
+	}
+	if accessCode != nil {
+		params["access_code"] = *accessCode
+	}
+	responseCtor := func() interface{} {
+		return &QuizSubmissionsResponse{}
+	}
+	var res *QuizSubmissionsResponse
+	callback := func(obj interface{}) error {
+		res = obj.(*QuizSubmissionsResponse)
+		return nil
+	}
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizzesListQuizzesInACourse API call: Returns the paginated list of Quizzes in this course.
+func (c *Canvas) QuizzesListQuizzesInACourse(ctx context.Context, progress *task.Progress, searchTerm *string, courseID string) ([]Quiz, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes", courseID)
+	params := map[string]interface{}{}
+	if searchTerm != nil {
+		params["search_term"] = *searchTerm
+	}
+	responseCtor := func() interface{} {
+		return &[]Quiz{}
+	}
+	var res []Quiz
+	callback := func(obj interface{}) error {
+		arr := *obj.(*[]Quiz)
+		res = append(res, arr...)
+		return nil
+	}
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizzesGetASingleQuiz API call: Returns the quiz with the given id.
+func (c *Canvas) QuizzesGetASingleQuiz(ctx context.Context, progress *task.Progress, courseID string, id string) (*Quiz, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s", courseID, id)
+	params := map[string]interface{}{}
+	responseCtor := func() interface{} {
+		return &Quiz{}
+	}
+	var res *Quiz
+	callback := func(obj interface{}) error {
+		res = obj.(*Quiz)
+		return nil
+	}
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizzesCreateAQuiz API call: Create a new quiz for this course.
+func (c *Canvas) QuizzesCreateAQuiz(ctx context.Context, progress *task.Progress, quiz *string, courseID string) (*Quiz, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes", courseID)
+	params := map[string]interface{}{}
+	if quiz != nil {
+		params["quiz"] = *quiz
+	}
+	responseCtor := func() interface{} {
+		return &Quiz{}
+	}
+	var res *Quiz
+	callback := func(obj interface{}) error {
+		res = obj.(*Quiz)
+		return nil
+	}
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizzesEditAQuiz API call: Modify an existing quiz. See the documentation for quiz creation. Additional arguments:
+func (c *Canvas) QuizzesEditAQuiz(ctx context.Context, progress *task.Progress, quiz *bool, courseID string, id string) (*Quiz, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s", courseID, id)
+	params := map[string]interface{}{}
+	if quiz != nil {
+		params["quiz"] = *quiz
+	}
+	responseCtor := func() interface{} {
+		return &Quiz{}
+	}
+	var res *Quiz
+	callback := func(obj interface{}) error {
+		res = obj.(*Quiz)
+		return nil
+	}
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuizzesDeleteAQuiz API call
+func (c *Canvas) QuizzesDeleteAQuiz(ctx context.Context, progress *task.Progress, courseID string, id string) (*Quiz, error) {
+	endpoint := fmt.Sprintf("courses/%s/quizzes/%s", courseID, id)
+	params := map[string]interface{}{}
+	responseCtor := func() interface{} {
+		return &Quiz{}
+	}
+	var res *Quiz
+	callback := func(obj interface{}) error {
+		res = obj.(*Quiz)
+		return nil
+	}
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RolesListRoles API call: A paginated list of the roles available to an account.
-func (c *Canvas) RolesListRoles(progress *task.Progress, accountID *string, state *RolesListRolesState, showInherited *bool) ([]Role, error) {
+func (c *Canvas) RolesListRoles(ctx context.Context, progress *task.Progress, accountID *string, state *RolesListRolesState, showInherited *bool) ([]Role, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if accountID != nil {
@@ -15324,14 +15788,14 @@ func (c *Canvas) RolesListRoles(progress *task.Progress, accountID *string, stat
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RolesGetASingleRole API call: Retrieve information about a single role
-func (c *Canvas) RolesGetASingleRole(progress *task.Progress, accountID *string, roleID *int, role *string) (*Role, error) {
+func (c *Canvas) RolesGetASingleRole(ctx context.Context, progress *task.Progress, accountID *string, roleID *int, role *string) (*Role, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if accountID != nil {
@@ -15351,14 +15815,14 @@ func (c *Canvas) RolesGetASingleRole(progress *task.Progress, accountID *string,
 		res = obj.(*Role)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RolesCreateANewRole API call: Create a new course-level or account-level role.
-func (c *Canvas) RolesCreateANewRole(progress *task.Progress, label *string, role *string, baseRoleType *RolesCreateANewRoleBaseRoleType, permissions *bool, accountID string) (*Role, error) {
+func (c *Canvas) RolesCreateANewRole(ctx context.Context, progress *task.Progress, label *string, role *string, baseRoleType *RolesCreateANewRoleBaseRoleType, permissions *bool, accountID string) (*Role, error) {
 	endpoint := fmt.Sprintf("accounts/%s/roles.json", accountID)
 	params := map[string]interface{}{}
 	if label != nil {
@@ -15381,7 +15845,7 @@ func (c *Canvas) RolesCreateANewRole(progress *task.Progress, label *string, rol
 		res = obj.(*Role)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15390,8 +15854,8 @@ func (c *Canvas) RolesCreateANewRole(progress *task.Progress, label *string, rol
 // RolesDeactivateARole API call: Deactivates a custom role.  This hides it in the user interface and prevents it from
 // being assigned to new users.  Existing users assigned to the role will continue to function with the same permissions
 // they had previously. Built-in roles cannot be deactivated.
-func (c *Canvas) RolesDeactivateARole(progress *task.Progress, roleID *int, role *string) (*Role, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RolesDeactivateARole(ctx context.Context, progress *task.Progress, roleID *int, role *string, accountID string, id string) (*Role, error) {
+	endpoint := fmt.Sprintf("accounts/%s/roles/%s", accountID, id)
 	params := map[string]interface{}{}
 	if roleID != nil {
 		params["role_id"] = *roleID
@@ -15407,15 +15871,15 @@ func (c *Canvas) RolesDeactivateARole(progress *task.Progress, roleID *int, role
 		res = obj.(*Role)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RolesActivateARole API call: Re-activates an inactive role (allowing it to be assigned to new users)
-func (c *Canvas) RolesActivateARole(progress *task.Progress, roleID *int, role *string) (*Role, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RolesActivateARole(ctx context.Context, progress *task.Progress, roleID *int, role *string, accountID string, id string) (*Role, error) {
+	endpoint := fmt.Sprintf("accounts/%s/roles/%s/activate", accountID, id)
 	params := map[string]interface{}{}
 	if roleID != nil {
 		params["role_id"] = *roleID
@@ -15431,7 +15895,7 @@ func (c *Canvas) RolesActivateARole(progress *task.Progress, roleID *int, role *
 		res = obj.(*Role)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15440,7 +15904,7 @@ func (c *Canvas) RolesActivateARole(progress *task.Progress, roleID *int, role *
 // RolesUpdateARole API call: Update permissions for an existing role. Recognized roles are: * TeacherEnrollment *
 // StudentEnrollment * TaEnrollment * ObserverEnrollment * DesignerEnrollment * AccountAdmin * Any previously created
 // custom role
-func (c *Canvas) RolesUpdateARole(progress *task.Progress, label *string, permissions *bool) (*Role, error) {
+func (c *Canvas) RolesUpdateARole(ctx context.Context, progress *task.Progress, label *string, permissions *bool) (*Role, error) {
 	endpoint := fmt.Sprintf("accounts/:account_id/roles/2")
 	params := map[string]interface{}{}
 	if label != nil {
@@ -15457,7 +15921,7 @@ func (c *Canvas) RolesUpdateARole(progress *task.Progress, label *string, permis
 		res = obj.(*Role)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15465,15 +15929,9 @@ func (c *Canvas) RolesUpdateARole(progress *task.Progress, label *string, permis
 
 // RubricsCreateASingleRubricAssessment API call: Returns the rubric assessment with the given id. The returned object
 // also provides the information of :ratings, :assessor_name, :related_group_submissions_and_assessments, :artifact
-func (c *Canvas) RubricsCreateASingleRubricAssessment(progress *task.Progress, courseID *int, rubricAssociationID *int, provisional *string, final *string, gradedAnonymously *bool, rubricAssessment *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsCreateASingleRubricAssessment(ctx context.Context, progress *task.Progress, provisional *string, final *string, gradedAnonymously *bool, rubricAssessment *string, courseID string, rubricAssociationID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations/%s/rubric_assessments", courseID, rubricAssociationID)
 	params := map[string]interface{}{}
-	if courseID != nil {
-		params["course_id"] = *courseID
-	}
-	if rubricAssociationID != nil {
-		params["rubric_association_id"] = *rubricAssociationID
-	}
 	if provisional != nil {
 		params["provisional"] = *provisional
 	}
@@ -15494,7 +15952,7 @@ func (c *Canvas) RubricsCreateASingleRubricAssessment(progress *task.Progress, c
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15502,18 +15960,9 @@ func (c *Canvas) RubricsCreateASingleRubricAssessment(progress *task.Progress, c
 
 // RubricsUpdateASingleRubricAssessment API call: Returns the rubric assessment with the given id. The returned object
 // also provides the information of :ratings, :assessor_name, :related_group_submissions_and_assessments, :artifact
-func (c *Canvas) RubricsUpdateASingleRubricAssessment(progress *task.Progress, id *int, courseID *int, rubricAssociationID *int, provisional *string, final *string, gradedAnonymously *bool, rubricAssessment *string) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsUpdateASingleRubricAssessment(ctx context.Context, progress *task.Progress, provisional *string, final *string, gradedAnonymously *bool, rubricAssessment *string, courseID string, rubricAssociationID string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations/%s/rubric_assessments/%s", courseID, rubricAssociationID, id)
 	params := map[string]interface{}{}
-	if id != nil {
-		params["id"] = *id
-	}
-	if courseID != nil {
-		params["course_id"] = *courseID
-	}
-	if rubricAssociationID != nil {
-		params["rubric_association_id"] = *rubricAssociationID
-	}
 	if provisional != nil {
 		params["provisional"] = *provisional
 	}
@@ -15534,15 +15983,15 @@ func (c *Canvas) RubricsUpdateASingleRubricAssessment(progress *task.Progress, i
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsDeleteASingleRubricAssessment API call: Deletes a rubric assessment
-func (c *Canvas) RubricsDeleteASingleRubricAssessment(progress *task.Progress) (*RubricAssessment, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsDeleteASingleRubricAssessment(ctx context.Context, progress *task.Progress, courseID string, rubricAssociationID string, id string) (*RubricAssessment, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations/%s/rubric_assessments/%s", courseID, rubricAssociationID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &RubricAssessment{}
@@ -15552,15 +16001,15 @@ func (c *Canvas) RubricsDeleteASingleRubricAssessment(progress *task.Progress) (
 		res = obj.(*RubricAssessment)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsCreateARubricAssociation API call: Returns the rubric with the given id.
-func (c *Canvas) RubricsCreateARubricAssociation(progress *task.Progress, rubricAssociation *int) (*RubricAssociation, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsCreateARubricAssociation(ctx context.Context, progress *task.Progress, rubricAssociation *int, courseID string) (*RubricAssociation, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations", courseID)
 	params := map[string]interface{}{}
 	if rubricAssociation != nil {
 		params["rubric_association"] = *rubricAssociation
@@ -15573,19 +16022,16 @@ func (c *Canvas) RubricsCreateARubricAssociation(progress *task.Progress, rubric
 		res = obj.(*RubricAssociation)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsUpdateARubricAssociation API call: Returns the rubric with the given id.
-func (c *Canvas) RubricsUpdateARubricAssociation(progress *task.Progress, id *int, rubricAssociation *int) (*RubricAssociation, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsUpdateARubricAssociation(ctx context.Context, progress *task.Progress, rubricAssociation *int, courseID string, id string) (*RubricAssociation, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations/%s", courseID, id)
 	params := map[string]interface{}{}
-	if id != nil {
-		params["id"] = *id
-	}
 	if rubricAssociation != nil {
 		params["rubric_association"] = *rubricAssociation
 	}
@@ -15597,15 +16043,15 @@ func (c *Canvas) RubricsUpdateARubricAssociation(progress *task.Progress, id *in
 		res = obj.(*RubricAssociation)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsDeleteARubricAssociation API call: Delete the RubricAssociation with the given ID
-func (c *Canvas) RubricsDeleteARubricAssociation(progress *task.Progress) (*RubricAssociation, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsDeleteARubricAssociation(ctx context.Context, progress *task.Progress, courseID string, id string) (*RubricAssociation, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubric_associations/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &RubricAssociation{}
@@ -15615,14 +16061,14 @@ func (c *Canvas) RubricsDeleteARubricAssociation(progress *task.Progress) (*Rubr
 		res = obj.(*RubricAssociation)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsListRubrics API call: Returns the paginated list of active rubrics for the current context.
-func (c *Canvas) RubricsListRubrics(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) RubricsListRubrics(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15633,14 +16079,14 @@ func (c *Canvas) RubricsListRubrics(progress *task.Progress) (*map[string]interf
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsGetASingleRubric API call: Returns the rubric with the given id.
-func (c *Canvas) RubricsGetASingleRubric(progress *task.Progress, include *RubricsGetASingleRubricInclude, style *RubricsGetASingleRubricStyle) (*Rubric, error) {
+func (c *Canvas) RubricsGetASingleRubric(ctx context.Context, progress *task.Progress, include *RubricsGetASingleRubricInclude, style *RubricsGetASingleRubricStyle) (*Rubric, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -15657,7 +16103,7 @@ func (c *Canvas) RubricsGetASingleRubric(progress *task.Progress, include *Rubri
 		res = obj.(*Rubric)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15667,8 +16113,8 @@ func (c *Canvas) RubricsGetASingleRubric(progress *task.Progress, include *Rubri
 // return a standard Rubric object, instead it returns a hash that looks like { 'rubric': Rubric, 'rubric_association':
 // RubricAssociation } This may eventually be deprecated in favor of a more standardized return value, but that is not
 // currently planned.
-func (c *Canvas) RubricsCreateASingleRubric(progress *task.Progress, id *int, rubricAssociationID *int, rubric *string, rubricAssociation *int) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsCreateASingleRubric(ctx context.Context, progress *task.Progress, id *int, rubricAssociationID *int, rubric *string, rubricAssociation *int, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubrics", courseID)
 	params := map[string]interface{}{}
 	if id != nil {
 		params["id"] = *id
@@ -15690,7 +16136,7 @@ func (c *Canvas) RubricsCreateASingleRubric(progress *task.Progress, id *int, ru
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15700,12 +16146,9 @@ func (c *Canvas) RubricsCreateASingleRubric(progress *task.Progress, id *int, ru
 // return a standard Rubric object, instead it returns a hash that looks like { 'rubric': Rubric, 'rubric_association':
 // RubricAssociation } This may eventually be deprecated in favor of a more standardized return value, but that is not
 // currently planned.
-func (c *Canvas) RubricsUpdateASingleRubric(progress *task.Progress, id *int, rubricAssociationID *int, rubric *string, rubricAssociation *int) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsUpdateASingleRubric(ctx context.Context, progress *task.Progress, rubricAssociationID *int, rubric *string, rubricAssociation *int, courseID string, id string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubrics/%s", courseID, id)
 	params := map[string]interface{}{}
-	if id != nil {
-		params["id"] = *id
-	}
 	if rubricAssociationID != nil {
 		params["rubric_association_id"] = *rubricAssociationID
 	}
@@ -15723,15 +16166,15 @@ func (c *Canvas) RubricsUpdateASingleRubric(progress *task.Progress, id *int, ru
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // RubricsDeleteASingleRubric API call: Deletes a Rubric and removes all RubricAssociations.
-func (c *Canvas) RubricsDeleteASingleRubric(progress *task.Progress) (*Rubric, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) RubricsDeleteASingleRubric(ctx context.Context, progress *task.Progress, courseID string, id string) (*Rubric, error) {
+	endpoint := fmt.Sprintf("courses/%s/rubrics/%s", courseID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &Rubric{}
@@ -15741,14 +16184,14 @@ func (c *Canvas) RubricsDeleteASingleRubric(progress *task.Progress) (*Rubric, e
 		res = obj.(*Rubric)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // APITokenScopesListScopes API call: A list of scopes that can be applied to developer keys and access tokens.
-func (c *Canvas) APITokenScopesListScopes(progress *task.Progress, groupBy *APITokenScopesListScopesGroupBy) ([]Scope, error) {
+func (c *Canvas) APITokenScopesListScopes(ctx context.Context, progress *task.Progress, groupBy *APITokenScopesListScopesGroupBy) ([]Scope, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if groupBy != nil {
@@ -15763,7 +16206,7 @@ func (c *Canvas) APITokenScopesListScopes(progress *task.Progress, groupBy *APIT
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15772,7 +16215,7 @@ func (c *Canvas) APITokenScopesListScopes(progress *task.Progress, groupBy *APIT
 // SearchFindRecipients API call: Find valid recipients (users, courses and groups) that the current user can send
 // messages to. The /api/v1/search/recipients path is the preferred endpoint, /api/v1/conversations/find_recipients is
 // deprecated. Pagination is supported.
-func (c *Canvas) SearchFindRecipients(progress *task.Progress, search *string, context *string, exclude *string, typeName *SearchFindRecipientsType, userID *int, fromConversationID *int, permissions *string) (*map[string]interface{}, error) {
+func (c *Canvas) SearchFindRecipients(ctx context.Context, progress *task.Progress, search *string, context *string, exclude *string, typeName *SearchFindRecipientsType, userID *int, fromConversationID *int, permissions *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if search != nil {
@@ -15804,14 +16247,14 @@ func (c *Canvas) SearchFindRecipients(progress *task.Progress, search *string, c
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SearchListAllCourses API call: A paginated list of all courses visible in the public index
-func (c *Canvas) SearchListAllCourses(progress *task.Progress, search *string, publicOnly *bool, openEnrollmentOnly *bool) (*map[string]interface{}, error) {
+func (c *Canvas) SearchListAllCourses(ctx context.Context, progress *task.Progress, search *string, publicOnly *bool, openEnrollmentOnly *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if search != nil {
@@ -15831,14 +16274,14 @@ func (c *Canvas) SearchListAllCourses(progress *task.Progress, search *string, p
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsListCourseSections API call: A paginated list of the list of sections for this course.
-func (c *Canvas) SectionsListCourseSections(progress *task.Progress, include *SectionsListCourseSectionsInclude) ([]Section, error) {
+func (c *Canvas) SectionsListCourseSections(ctx context.Context, progress *task.Progress, include *SectionsListCourseSectionsInclude) ([]Section, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -15853,15 +16296,15 @@ func (c *Canvas) SectionsListCourseSections(progress *task.Progress, include *Se
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsCreateCourseSection API call: Creates a new section for this course.
-func (c *Canvas) SectionsCreateCourseSection(progress *task.Progress, courseSection *string, enableSisReactivation *bool) (*Section, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SectionsCreateCourseSection(ctx context.Context, progress *task.Progress, courseSection *string, enableSisReactivation *bool, courseID string) (*Section, error) {
+	endpoint := fmt.Sprintf("courses/%s/sections", courseID)
 	params := map[string]interface{}{}
 	if courseSection != nil {
 		params["course_section"] = *courseSection
@@ -15877,7 +16320,7 @@ func (c *Canvas) SectionsCreateCourseSection(progress *task.Progress, courseSect
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -15885,7 +16328,7 @@ func (c *Canvas) SectionsCreateCourseSection(progress *task.Progress, courseSect
 
 // SectionsCrossListASection API call: Move the Section to another course.  The new course may be in a different account
 // (department), but must belong to the same root account (institution).
-func (c *Canvas) SectionsCrossListASection(progress *task.Progress) (*Section, error) {
+func (c *Canvas) SectionsCrossListASection(ctx context.Context, progress *task.Progress) (*Section, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15896,14 +16339,14 @@ func (c *Canvas) SectionsCrossListASection(progress *task.Progress) (*Section, e
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsDeCrossListASection API call: Undo cross-listing of a Section, returning it to its original course.
-func (c *Canvas) SectionsDeCrossListASection(progress *task.Progress) (*Section, error) {
+func (c *Canvas) SectionsDeCrossListASection(ctx context.Context, progress *task.Progress) (*Section, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15914,15 +16357,15 @@ func (c *Canvas) SectionsDeCrossListASection(progress *task.Progress) (*Section,
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsEditASection API call: Modify an existing section.
-func (c *Canvas) SectionsEditASection(progress *task.Progress, courseSection *string) (*Section, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SectionsEditASection(ctx context.Context, progress *task.Progress, courseSection *string, id string) (*Section, error) {
+	endpoint := fmt.Sprintf("sections/%s", id)
 	params := map[string]interface{}{}
 	if courseSection != nil {
 		params["course_section"] = *courseSection
@@ -15935,14 +16378,14 @@ func (c *Canvas) SectionsEditASection(progress *task.Progress, courseSection *st
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsGetSectionInformation API call: Gets details about a specific section
-func (c *Canvas) SectionsGetSectionInformation(progress *task.Progress, include *SectionsGetSectionInformationInclude) (*Section, error) {
+func (c *Canvas) SectionsGetSectionInformation(ctx context.Context, progress *task.Progress, include *SectionsGetSectionInformationInclude) (*Section, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -15956,15 +16399,15 @@ func (c *Canvas) SectionsGetSectionInformation(progress *task.Progress, include
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SectionsDeleteASection API call: Delete an existing section.  Returns the former Section.
-func (c *Canvas) SectionsDeleteASection(progress *task.Progress) (*Section, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SectionsDeleteASection(ctx context.Context, progress *task.Progress, id string) (*Section, error) {
+	endpoint := fmt.Sprintf("sections/%s", id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &Section{}
@@ -15974,14 +16417,14 @@ func (c *Canvas) SectionsDeleteASection(progress *task.Progress) (*Section, erro
 		res = obj.(*Section)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // ServicesGetKalturaConfig API call: Return the config information for the Kaltura plugin in json format.
-func (c *Canvas) ServicesGetKalturaConfig(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) ServicesGetKalturaConfig(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -15992,7 +16435,7 @@ func (c *Canvas) ServicesGetKalturaConfig(progress *task.Progress) (*map[string]
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16000,8 +16443,8 @@ func (c *Canvas) ServicesGetKalturaConfig(progress *task.Progress) (*map[string]
 
 // ServicesStartKalturaSession API call: Start a new Kaltura session, so that new media can be recorded and uploaded to
 // this Canvas instance's Kaltura instance.
-func (c *Canvas) ServicesStartKalturaSession(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) ServicesStartKalturaSession(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("services/kaltura_session")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -16011,7 +16454,7 @@ func (c *Canvas) ServicesStartKalturaSession(progress *task.Progress) (*map[stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16019,7 +16462,7 @@ func (c *Canvas) ServicesStartKalturaSession(progress *task.Progress) (*map[stri
 
 // SharedBrandConfigsShareABrandConfigTheme API call: Create a SharedBrandConfig, which will give the given brand_config
 // a name and make it available to other users of this account.
-func (c *Canvas) SharedBrandConfigsShareABrandConfigTheme(progress *task.Progress, sharedBrandConfig *string, accountID string) (*SharedBrandConfig, error) {
+func (c *Canvas) SharedBrandConfigsShareABrandConfigTheme(ctx context.Context, progress *task.Progress, sharedBrandConfig *string, accountID string) (*SharedBrandConfig, error) {
 	endpoint := fmt.Sprintf("accounts/%s/shared_brand_configs", accountID)
 	params := map[string]interface{}{}
 	if sharedBrandConfig != nil {
@@ -16033,7 +16476,7 @@ func (c *Canvas) SharedBrandConfigsShareABrandConfigTheme(progress *task.Progres
 		res = obj.(*SharedBrandConfig)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16041,7 +16484,7 @@ func (c *Canvas) SharedBrandConfigsShareABrandConfigTheme(progress *task.Progres
 
 // SharedBrandConfigsUpdateASharedTheme API call: Update the specified shared_brand_config with a new name or to point
 // to a new brand_config. Uses same parameters as create.
-func (c *Canvas) SharedBrandConfigsUpdateASharedTheme(progress *task.Progress, accountID string, sharedBrandConfigID string) (*SharedBrandConfig, error) {
+func (c *Canvas) SharedBrandConfigsUpdateASharedTheme(ctx context.Context, progress *task.Progress, accountID string, sharedBrandConfigID string) (*SharedBrandConfig, error) {
 	endpoint := fmt.Sprintf("accounts/%s/shared_brand_configs/%s", accountID, sharedBrandConfigID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16052,7 +16495,7 @@ func (c *Canvas) SharedBrandConfigsUpdateASharedTheme(progress *task.Progress, a
 		res = obj.(*SharedBrandConfig)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16060,7 +16503,7 @@ func (c *Canvas) SharedBrandConfigsUpdateASharedTheme(progress *task.Progress, a
 
 // SharedBrandConfigsUnShareABrandConfigTheme API call: Delete a SharedBrandConfig, which will unshare it so you nor
 // anyone else in your account will see it as an option to pick from.
-func (c *Canvas) SharedBrandConfigsUnShareABrandConfigTheme(progress *task.Progress, id string) (*SharedBrandConfig, error) {
+func (c *Canvas) SharedBrandConfigsUnShareABrandConfigTheme(ctx context.Context, progress *task.Progress, id string) (*SharedBrandConfig, error) {
 	endpoint := fmt.Sprintf("shared_brand_configs/%s", id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16071,7 +16514,7 @@ func (c *Canvas) SharedBrandConfigsUnShareABrandConfigTheme(progress *task.Progr
 		res = obj.(*SharedBrandConfig)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16087,7 +16530,7 @@ func (c *Canvas) SharedBrandConfigsUnShareABrandConfigTheme(progress *task.Progr
 // feature is turned on and the assignment has an override for that section. When there is an override for the
 // assignment the override object's keys/values can be merged with the top level assignment object to create a view of
 // the assignment object specific to that section. See Assignments api for more information on assignment overrides.
-func (c *Canvas) SISIntegrationRetrieveAssignmentsEnabledForGradeExportToSIS(progress *task.Progress, accountID *interface{}, courseID *interface{}, startsBefore *interface{}, endsAfter *interface{}, include *interface{}) (*map[string]interface{}, error) {
+func (c *Canvas) SISIntegrationRetrieveAssignmentsEnabledForGradeExportToSIS(ctx context.Context, progress *task.Progress, accountID *interface{}, courseID *interface{}, startsBefore *interface{}, endsAfter *interface{}, include *interface{}) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if accountID != nil {
@@ -16113,7 +16556,7 @@ func (c *Canvas) SISIntegrationRetrieveAssignmentsEnabledForGradeExportToSIS(pro
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16121,7 +16564,7 @@ func (c *Canvas) SISIntegrationRetrieveAssignmentsEnabledForGradeExportToSIS(pro
 
 // SISImportErrorsGetSISImportErrorList API call: Returns the list of SIS import errors for an account or a SIS import.
 // Import errors are only stored for 30 days.
-func (c *Canvas) SISImportErrorsGetSISImportErrorList(progress *task.Progress, failure *bool) ([]SisImportError, error) {
+func (c *Canvas) SISImportErrorsGetSISImportErrorList(ctx context.Context, progress *task.Progress, failure *bool) ([]SisImportError, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if failure != nil {
@@ -16136,14 +16579,14 @@ func (c *Canvas) SISImportErrorsGetSISImportErrorList(progress *task.Progress, f
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SISImportsGetSISImportList API call: Returns the list of SIS imports for an account
-func (c *Canvas) SISImportsGetSISImportList(progress *task.Progress, createdSince *time.Time, workflowState *SISImportsGetSISImportListWorkflowState) ([]SisImport, error) {
+func (c *Canvas) SISImportsGetSISImportList(ctx context.Context, progress *task.Progress, createdSince *time.Time, workflowState *SISImportsGetSISImportListWorkflowState) ([]SisImport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if createdSince != nil {
@@ -16161,7 +16604,7 @@ func (c *Canvas) SISImportsGetSISImportList(progress *task.Progress, createdSinc
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16170,7 +16613,7 @@ func (c *Canvas) SISImportsGetSISImportList(progress *task.Progress, createdSinc
 // SISImportsGetTheCurrentImportingSISImport API call: Returns the SIS imports that are currently processing for an
 // account. If no imports are running, will return an empty array. Example: curl
 // https://<canvas>/api/v1/accounts/<account_id>/sis_imports/importing \ -H 'Authorization: Bearer <token>'
-func (c *Canvas) SISImportsGetTheCurrentImportingSISImport(progress *task.Progress) (*SisImport, error) {
+func (c *Canvas) SISImportsGetTheCurrentImportingSISImport(ctx context.Context, progress *task.Progress) (*SisImport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16181,7 +16624,7 @@ func (c *Canvas) SISImportsGetTheCurrentImportingSISImport(progress *task.Progre
 		res = obj.(*SisImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16189,8 +16632,8 @@ func (c *Canvas) SISImportsGetTheCurrentImportingSISImport(progress *task.Progre
 
 // SISImportsImportSISData API call: Import SIS data into Canvas. Must be on a root account with SIS imports enabled.
 // For more information on the format that's expected here, please see the "SIS CSV" section in the API docs.
-func (c *Canvas) SISImportsImportSISData(progress *task.Progress, importType *string, attachment *interface{}, extension *string, batchMode *bool, batchModeTermID *string, multiTermBatchMode *bool, skipDeletes *bool, overrideSisStickiness *bool, addSisStickiness *bool, clearSisStickiness *bool, diffingDataSetIdentifier *string, diffingRemasterDataSet *bool, diffingDropStatus *SISImportsImportSISDataDiffingDropStatus, changeThreshold *int, diffRowCountThreshold *int) (*SisImport, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SISImportsImportSISData(ctx context.Context, progress *task.Progress, importType *string, attachment *interface{}, extension *string, batchMode *bool, batchModeTermID *string, multiTermBatchMode *bool, skipDeletes *bool, overrideSisStickiness *bool, addSisStickiness *bool, clearSisStickiness *bool, diffingDataSetIdentifier *string, diffingRemasterDataSet *bool, diffingDropStatus *SISImportsImportSISDataDiffingDropStatus, changeThreshold *int, diffRowCountThreshold *int, accountID string) (*SisImport, error) {
+	endpoint := fmt.Sprintf("accounts/%s/sis_imports", accountID)
 	params := map[string]interface{}{}
 	if importType != nil {
 		params["import_type"] = *importType
@@ -16245,7 +16688,7 @@ func (c *Canvas) SISImportsImportSISData(progress *task.Progress, importType *st
 		res = obj.(*SisImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16253,7 +16696,7 @@ func (c *Canvas) SISImportsImportSISData(progress *task.Progress, importType *st
 
 // SISImportsGetSISImportStatus API call: Get the status of an already created SIS import. Examples: curl
 // https://<canvas>/api/v1/accounts/<account_id>/sis_imports/<sis_import_id> \ -H 'Authorization: Bearer <token>'
-func (c *Canvas) SISImportsGetSISImportStatus(progress *task.Progress) (*SisImport, error) {
+func (c *Canvas) SISImportsGetSISImportStatus(ctx context.Context, progress *task.Progress) (*SisImport, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16264,7 +16707,7 @@ func (c *Canvas) SISImportsGetSISImportStatus(progress *task.Progress) (*SisImpo
 		res = obj.(*SisImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16276,7 +16719,7 @@ func (c *Canvas) SISImportsGetSISImportStatus(progress *task.Progress) (*SisImpo
 // users.csv admins.csv This also restores states for other items that changed during the import. An example would be if
 // an enrollment was deleted from a sis import and the group_membership was also deleted as a result of the enrollment
 // deletion, both items would be restored when the sis batch is restored.
-func (c *Canvas) SISImportsRestoreWorkflowStatesOfSISImportedItems(progress *task.Progress, batchMode *bool, undeleteOnly *bool, unconcludeOnly *bool, accountID string, sisImportID string) (*Progress, error) {
+func (c *Canvas) SISImportsRestoreWorkflowStatesOfSISImportedItems(ctx context.Context, progress *task.Progress, batchMode *bool, undeleteOnly *bool, unconcludeOnly *bool, accountID string, sisImportID string) (*Progress, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sis_imports/%s/restore_states", accountID, sisImportID)
 	params := map[string]interface{}{}
 	if batchMode != nil {
@@ -16296,7 +16739,7 @@ func (c *Canvas) SISImportsRestoreWorkflowStatesOfSISImportedItems(progress *tas
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16305,7 +16748,7 @@ func (c *Canvas) SISImportsRestoreWorkflowStatesOfSISImportedItems(progress *tas
 // SISImportsAbortSISImport API call: Abort a SIS import that has not completed. Aborting a sis batch that is running
 // can take some time for every process to see the abort event. Subsequent sis batches begin to process 10 minutes after
 // the abort to allow each process to clean up properly.
-func (c *Canvas) SISImportsAbortSISImport(progress *task.Progress, accountID string, sisImportID string) (*SisImport, error) {
+func (c *Canvas) SISImportsAbortSISImport(ctx context.Context, progress *task.Progress, accountID string, sisImportID string) (*SisImport, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sis_imports/%s/abort", accountID, sisImportID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16316,14 +16759,14 @@ func (c *Canvas) SISImportsAbortSISImport(progress *task.Progress, accountID str
 		res = obj.(*SisImport)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SISImportsAbortAllPendingSISImports API call: Abort already created but not processed or processing SIS imports.
-func (c *Canvas) SISImportsAbortAllPendingSISImports(progress *task.Progress, accountID string) (*bool, error) {
+func (c *Canvas) SISImportsAbortAllPendingSISImports(ctx context.Context, progress *task.Progress, accountID string) (*bool, error) {
 	endpoint := fmt.Sprintf("accounts/%s/sis_imports/abort_all_pending", accountID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16335,15 +16778,15 @@ func (c *Canvas) SISImportsAbortAllPendingSISImports(progress *task.Progress, ac
 		res = obj.(*bool)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // AccountsCreateANewSubAccount API call: Add a new sub-account to a given account.
-func (c *Canvas) AccountsCreateANewSubAccount(progress *task.Progress, account *string) (*Account, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AccountsCreateANewSubAccount(ctx context.Context, progress *task.Progress, account *string, accountID string) (*Account, error) {
+	endpoint := fmt.Sprintf("accounts/%s/sub_accounts", accountID)
 	params := map[string]interface{}{}
 	if account != nil {
 		params["account"] = *account
@@ -16356,7 +16799,7 @@ func (c *Canvas) AccountsCreateANewSubAccount(progress *task.Progress, account *
 		res = obj.(*Account)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16364,8 +16807,8 @@ func (c *Canvas) AccountsCreateANewSubAccount(progress *task.Progress, account *
 
 // AccountsDeleteASubAccount API call: Cannot delete an account with active courses or active sub_accounts. Cannot
 // delete a root_account
-func (c *Canvas) AccountsDeleteASubAccount(progress *task.Progress) (*Account, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) AccountsDeleteASubAccount(ctx context.Context, progress *task.Progress, accountID string, id string) (*Account, error) {
+	endpoint := fmt.Sprintf("accounts/%s/sub_accounts/%s", accountID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &Account{}
@@ -16375,7 +16818,7 @@ func (c *Canvas) AccountsDeleteASubAccount(progress *task.Progress) (*Account, e
 		res = obj.(*Account)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16385,8 +16828,8 @@ func (c *Canvas) AccountsDeleteASubAccount(progress *task.Progress) (*Account, e
 // {file:file_uploads.html File Upload Documentation} for details on the file upload workflow. The final step of the
 // file upload workflow will return the attachment data, including the new file id. The caller can then PUT the file_id
 // to the submission API to attach it to a comment
-func (c *Canvas) SubmissionCommentsUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SubmissionCommentsUploadAFile(ctx context.Context, progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/comments/files", courseID, assignmentID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -16396,14 +16839,14 @@ func (c *Canvas) SubmissionCommentsUploadAFile(progress *task.Progress) (*map[st
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SubmissionsListAssignmentSubmissions API call: A paginated list of all existing submissions for an assignment.
-func (c *Canvas) SubmissionsListAssignmentSubmissions(progress *task.Progress, include *SubmissionsListAssignmentSubmissionsInclude, grouped *bool) ([]Submission, error) {
+func (c *Canvas) SubmissionsListAssignmentSubmissions(ctx context.Context, progress *task.Progress, include *SubmissionsListAssignmentSubmissionsInclude, grouped *bool) ([]Submission, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -16421,7 +16864,7 @@ func (c *Canvas) SubmissionsListAssignmentSubmissions(progress *task.Progress, i
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16429,7 +16872,7 @@ func (c *Canvas) SubmissionsListAssignmentSubmissions(progress *task.Progress, i
 
 // SubmissionsListSubmissionsForMultipleAssignments API call: A paginated list of all existing submissions for a given
 // set of students and assignments.
-func (c *Canvas) SubmissionsListSubmissionsForMultipleAssignments(progress *task.Progress, studentIds *string, assignmentIds *string, grouped *bool, postToSis *bool, submittedSince *time.Time, gradedSince *time.Time, gradingPeriodID *int, workflowState *SubmissionsListSubmissionsForMultipleAssignmentsWorkflowState, enrollmentState *SubmissionsListSubmissionsForMultipleAssignmentsEnrollmentState, stateBasedOnDate *bool, order *SubmissionsListSubmissionsForMultipleAssignmentsOrder, orderDirection *SubmissionsListSubmissionsForMultipleAssignmentsOrderDirection, include *SubmissionsListSubmissionsForMultipleAssignmentsInclude) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsListSubmissionsForMultipleAssignments(ctx context.Context, progress *task.Progress, studentIds *string, assignmentIds *string, grouped *bool, postToSis *bool, submittedSince *time.Time, gradedSince *time.Time, gradingPeriodID *int, workflowState *SubmissionsListSubmissionsForMultipleAssignmentsWorkflowState, enrollmentState *SubmissionsListSubmissionsForMultipleAssignmentsEnrollmentState, stateBasedOnDate *bool, order *SubmissionsListSubmissionsForMultipleAssignmentsOrder, orderDirection *SubmissionsListSubmissionsForMultipleAssignmentsOrderDirection, include *SubmissionsListSubmissionsForMultipleAssignmentsInclude) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if studentIds != nil {
@@ -16479,14 +16922,14 @@ func (c *Canvas) SubmissionsListSubmissionsForMultipleAssignments(progress *task
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SubmissionsGetASingleSubmission API call: Get a single submission, based on user id.
-func (c *Canvas) SubmissionsGetASingleSubmission(progress *task.Progress, include []SubmissionsGetASingleSubmissionInclude, courseID string, assignmentID string, userID string) (*Submission, error) {
+func (c *Canvas) SubmissionsGetASingleSubmission(ctx context.Context, progress *task.Progress, include []SubmissionsGetASingleSubmissionInclude, courseID string, assignmentID string, userID string) (*Submission, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s", courseID, assignmentID, userID)
 	params := map[string]interface{}{}
 	if include != nil && len(include) > 0 {
@@ -16500,7 +16943,7 @@ func (c *Canvas) SubmissionsGetASingleSubmission(progress *task.Progress, includ
 		res = obj.(*Submission)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16510,8 +16953,8 @@ func (c *Canvas) SubmissionsGetASingleSubmission(progress *task.Progress, includ
 // file to a submission as a student. See the {file:file_uploads.html File Upload Documentation} for details on the file
 // upload workflow. The final step of the file upload workflow will return the attachment data, including the new file
 // id. The caller can then POST to submit the +online_upload+ assignment with these file ids.
-func (c *Canvas) SubmissionsUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SubmissionsUploadAFile(ctx context.Context, progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/files", courseID, assignmentID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -16521,7 +16964,7 @@ func (c *Canvas) SubmissionsUploadAFile(progress *task.Progress) (*map[string]in
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16532,7 +16975,7 @@ func (c *Canvas) SubmissionsUploadAFile(progress *task.Progress) (*map[string]in
 // allow_new_anonymous_id parameter is passed, the returned data will not include any values identifying the student,
 // but will instead include an assignment-specific anonymous ID for each student. Section-limited instructors will only
 // see students in their own sections.
-func (c *Canvas) SubmissionsListGradeableStudents(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsListGradeableStudents(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16543,14 +16986,14 @@ func (c *Canvas) SubmissionsListGradeableStudents(progress *task.Progress) (*map
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // SubmissionsListMultipleAssignmentsGradeableStudents API call
-func (c *Canvas) SubmissionsListMultipleAssignmentsGradeableStudents(progress *task.Progress, assignmentIds *string) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsListMultipleAssignmentsGradeableStudents(ctx context.Context, progress *task.Progress, assignmentIds *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if assignmentIds != nil {
@@ -16564,7 +17007,7 @@ func (c *Canvas) SubmissionsListMultipleAssignmentsGradeableStudents(progress *t
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16573,7 +17016,7 @@ func (c *Canvas) SubmissionsListMultipleAssignmentsGradeableStudents(progress *t
 // SubmissionsGradeOrCommentOnMultipleSubmissions API call: Update the grading and comments on multiple student's
 // assignment submissions in an asynchronous job. The user must have permission to manage grades in the appropriate
 // context (course or section).
-func (c *Canvas) SubmissionsGradeOrCommentOnMultipleSubmissions(progress *task.Progress, gradeData *string) (*Progress, error) {
+func (c *Canvas) SubmissionsGradeOrCommentOnMultipleSubmissions(ctx context.Context, progress *task.Progress, gradeData *string) (*Progress, error) {
 	endpoint := fmt.Sprintf("courses/1/assignments/2/submissions/update_grades")
 	params := map[string]interface{}{}
 	if gradeData != nil {
@@ -16587,7 +17030,7 @@ func (c *Canvas) SubmissionsGradeOrCommentOnMultipleSubmissions(progress *task.P
 		res = obj.(*Progress)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16595,7 +17038,7 @@ func (c *Canvas) SubmissionsGradeOrCommentOnMultipleSubmissions(progress *task.P
 
 // SubmissionsMarkSubmissionAsRead API call: No request fields are necessary. On success, the response will be 204 No
 // Content with an empty body.
-func (c *Canvas) SubmissionsMarkSubmissionAsRead(progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsMarkSubmissionAsRead(ctx context.Context, progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/read.json", courseID, assignmentID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16606,7 +17049,7 @@ func (c *Canvas) SubmissionsMarkSubmissionAsRead(progress *task.Progress, course
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16614,7 +17057,7 @@ func (c *Canvas) SubmissionsMarkSubmissionAsRead(progress *task.Progress, course
 
 // SubmissionsMarkSubmissionAsUnread API call: No request fields are necessary. On success, the response will be 204 No
 // Content with an empty body.
-func (c *Canvas) SubmissionsMarkSubmissionAsUnread(progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsMarkSubmissionAsUnread(ctx context.Context, progress *task.Progress, courseID string, assignmentID string, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/read.json", courseID, assignmentID, userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16625,7 +17068,7 @@ func (c *Canvas) SubmissionsMarkSubmissionAsUnread(progress *task.Progress, cour
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16633,7 +17076,7 @@ func (c *Canvas) SubmissionsMarkSubmissionAsUnread(progress *task.Progress, cour
 
 // SubmissionsSubmissionSummary API call: Returns the number of submissions for the given assignment based on gradeable
 // students that fall into three categories: graded, ungraded, not submitted.
-func (c *Canvas) SubmissionsSubmissionSummary(progress *task.Progress, grouped *bool) (*map[string]interface{}, error) {
+func (c *Canvas) SubmissionsSubmissionSummary(ctx context.Context, progress *task.Progress, grouped *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if grouped != nil {
@@ -16647,7 +17090,7 @@ func (c *Canvas) SubmissionsSubmissionSummary(progress *task.Progress, grouped *
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16659,8 +17102,8 @@ func (c *Canvas) SubmissionsSubmissionSummary(progress *task.Progress, grouped *
 // {api:SubmissionsApiController#create_file file upload API}. However, there is no API yet for listing the user and
 // group files. * Media comments can be submitted, however, there is no API yet for creating a media comment to submit.
 // * Integration with Google Docs is not yet supported.
-func (c *Canvas) SubmissionsSubmitAnAssignment(progress *task.Progress, comment *string, submission *SubmissionsSubmitAnAssignmentSubmission) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) SubmissionsSubmitAnAssignment(ctx context.Context, progress *task.Progress, comment *string, submission *SubmissionsSubmitAnAssignmentSubmission, courseID string, assignmentID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/assignments/%s/submissions", courseID, assignmentID)
 	params := map[string]interface{}{}
 	if comment != nil {
 		params["comment"] = *comment
@@ -16676,7 +17119,7 @@ func (c *Canvas) SubmissionsSubmitAnAssignment(progress *task.Progress, comment
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16684,7 +17127,7 @@ func (c *Canvas) SubmissionsSubmitAnAssignment(progress *task.Progress, comment
 
 // TabsListAvailableTabsForACourseOrGroup API call: Returns a paginated list of navigation tabs available in the current
 // context.
-func (c *Canvas) TabsListAvailableTabsForACourseOrGroup(progress *task.Progress, groupID string) (*map[string]interface{}, error) {
+func (c *Canvas) TabsListAvailableTabsForACourseOrGroup(ctx context.Context, progress *task.Progress, groupID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("groups/%s/tabs", groupID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16695,7 +17138,7 @@ func (c *Canvas) TabsListAvailableTabsForACourseOrGroup(progress *task.Progress,
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16703,7 +17146,7 @@ func (c *Canvas) TabsListAvailableTabsForACourseOrGroup(progress *task.Progress,
 
 // TabsUpdateATabForACourse API call: Home and Settings tabs are not manageable, and can't be hidden or moved Returns a
 // tab object
-func (c *Canvas) TabsUpdateATabForACourse(progress *task.Progress, position *interface{}, hidden *bool, courseID string) (*Tab, error) {
+func (c *Canvas) TabsUpdateATabForACourse(ctx context.Context, progress *task.Progress, position *interface{}, hidden *bool, courseID string) (*Tab, error) {
 	endpoint := fmt.Sprintf("courses/%s/tabs/tab_id", courseID)
 	params := map[string]interface{}{}
 	if position != nil {
@@ -16720,14 +17163,14 @@ func (c *Canvas) TabsUpdateATabForACourse(progress *task.Progress, position *int
 		res = obj.(*Tab)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentTermsListEnrollmentTerms API call: An object with a paginated list of all of the terms in the account.
-func (c *Canvas) EnrollmentTermsListEnrollmentTerms(progress *task.Progress, workflowState *EnrollmentTermsListEnrollmentTermsWorkflowState, include *EnrollmentTermsListEnrollmentTermsInclude) (*EnrollmentTermsList, error) {
+func (c *Canvas) EnrollmentTermsListEnrollmentTerms(ctx context.Context, progress *task.Progress, workflowState *EnrollmentTermsListEnrollmentTermsWorkflowState, include *EnrollmentTermsListEnrollmentTermsInclude) (*EnrollmentTermsList, error) {
 	endpoint := fmt.Sprintf("accounts/1/terms")
 	params := map[string]interface{}{}
 	if workflowState != nil {
@@ -16744,7 +17187,7 @@ func (c *Canvas) EnrollmentTermsListEnrollmentTerms(progress *task.Progress, wor
 		res = obj.(*EnrollmentTermsList)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16752,7 +17195,7 @@ func (c *Canvas) EnrollmentTermsListEnrollmentTerms(progress *task.Progress, wor
 
 // EnrollmentTermsRetrieveEnrollmentTerm API call: Retrieves the details for an enrollment term in the account. Includes
 // overrides by default.
-func (c *Canvas) EnrollmentTermsRetrieveEnrollmentTerm(progress *task.Progress) (*EnrollmentTerm, error) {
+func (c *Canvas) EnrollmentTermsRetrieveEnrollmentTerm(ctx context.Context, progress *task.Progress) (*EnrollmentTerm, error) {
 	endpoint := fmt.Sprintf("accounts/1/terms/2")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16763,15 +17206,15 @@ func (c *Canvas) EnrollmentTermsRetrieveEnrollmentTerm(progress *task.Progress)
 		res = obj.(*EnrollmentTerm)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentTermsCreateEnrollmentTerm API call: Create a new enrollment term for the specified account.
-func (c *Canvas) EnrollmentTermsCreateEnrollmentTerm(progress *task.Progress, enrollmentTerm *string) (*EnrollmentTerm, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) EnrollmentTermsCreateEnrollmentTerm(ctx context.Context, progress *task.Progress, enrollmentTerm *string, accountID string) (*EnrollmentTerm, error) {
+	endpoint := fmt.Sprintf("accounts/%s/terms", accountID)
 	params := map[string]interface{}{}
 	if enrollmentTerm != nil {
 		params["enrollment_term"] = *enrollmentTerm
@@ -16784,15 +17227,15 @@ func (c *Canvas) EnrollmentTermsCreateEnrollmentTerm(progress *task.Progress, en
 		res = obj.(*EnrollmentTerm)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentTermsUpdateEnrollmentTerm API call: Update an existing enrollment term for the specified account.
-func (c *Canvas) EnrollmentTermsUpdateEnrollmentTerm(progress *task.Progress, enrollmentTerm *string) (*EnrollmentTerm, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) EnrollmentTermsUpdateEnrollmentTerm(ctx context.Context, progress *task.Progress, enrollmentTerm *string, accountID string, id string) (*EnrollmentTerm, error) {
+	endpoint := fmt.Sprintf("accounts/%s/terms/%s", accountID, id)
 	params := map[string]interface{}{}
 	if enrollmentTerm != nil {
 		params["enrollment_term"] = *enrollmentTerm
@@ -16805,15 +17248,15 @@ func (c *Canvas) EnrollmentTermsUpdateEnrollmentTerm(progress *task.Progress, en
 		res = obj.(*EnrollmentTerm)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // EnrollmentTermsDeleteEnrollmentTerm API call: Delete the specified enrollment term.
-func (c *Canvas) EnrollmentTermsDeleteEnrollmentTerm(progress *task.Progress) (*EnrollmentTerm, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) EnrollmentTermsDeleteEnrollmentTerm(ctx context.Context, progress *task.Progress, accountID string, id string) (*EnrollmentTerm, error) {
+	endpoint := fmt.Sprintf("accounts/%s/terms/%s", accountID, id)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &EnrollmentTerm{}
@@ -16823,15 +17266,15 @@ func (c *Canvas) EnrollmentTermsDeleteEnrollmentTerm(progress *task.Progress) (*
 		res = obj.(*EnrollmentTerm)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesSetUsageRights API call: Sets copyright and license information for one or more files
-func (c *Canvas) FilesSetUsageRights(progress *task.Progress, fileIds *interface{}, folderIds *interface{}, publish *bool, usageRights *FilesSetUsageRightsUsageRights) (*UsageRights, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) FilesSetUsageRights(ctx context.Context, progress *task.Progress, fileIds *interface{}, folderIds *interface{}, publish *bool, usageRights *FilesSetUsageRightsUsageRights, courseID string) (*UsageRights, error) {
+	endpoint := fmt.Sprintf("courses/%s/usage_rights", courseID)
 	params := map[string]interface{}{}
 	if fileIds != nil {
 		params["file_ids"] = *fileIds
@@ -16853,15 +17296,15 @@ func (c *Canvas) FilesSetUsageRights(progress *task.Progress, fileIds *interface
 		res = obj.(*UsageRights)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesRemoveUsageRights API call: Removes copyright and license information associated with one or more files
-func (c *Canvas) FilesRemoveUsageRights(progress *task.Progress, fileIds *interface{}, folderIds *interface{}) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) FilesRemoveUsageRights(ctx context.Context, progress *task.Progress, fileIds *interface{}, folderIds *interface{}, courseID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("courses/%s/usage_rights", courseID)
 	params := map[string]interface{}{}
 	if fileIds != nil {
 		params["file_ids"] = *fileIds
@@ -16877,14 +17320,14 @@ func (c *Canvas) FilesRemoveUsageRights(progress *task.Progress, fileIds *interf
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // FilesListLicenses API call: A paginated list of licenses that can be applied
-func (c *Canvas) FilesListLicenses(progress *task.Progress) ([]License, error) {
+func (c *Canvas) FilesListLicenses(ctx context.Context, progress *task.Progress) ([]License, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16896,7 +17339,7 @@ func (c *Canvas) FilesListLicenses(progress *task.Progress) ([]License, error) {
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16906,7 +17349,7 @@ func (c *Canvas) FilesListLicenses(progress *task.Progress) ([]License, error) {
 // users are allowed to list their own observees. Administrators can list other users' observees. The returned observees
 // will include an attribute "observation_link_root_account_ids", a list of ids for the root accounts the observer and
 // observee are linked on. The observer will only be able to observe in courses associated with these root accounts.
-func (c *Canvas) UserObserveesListObservees(progress *task.Progress, include *UserObserveesListObserveesInclude, userID string) ([]User, error) {
+func (c *Canvas) UserObserveesListObservees(ctx context.Context, progress *task.Progress, include *UserObserveesListObserveesInclude, userID string) ([]User, error) {
 	endpoint := fmt.Sprintf("users/%s/observees", userID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -16921,7 +17364,7 @@ func (c *Canvas) UserObserveesListObservees(progress *task.Progress, include *Us
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16931,7 +17374,7 @@ func (c *Canvas) UserObserveesListObservees(progress *task.Progress, include *Us
 // observee's credentials. *Note:* all users are allowed to add their own observees, given the observee's credentials or
 // access token are provided. Administrators can add observees given credentials, access token or the
 // {api:UserObserveesController#update observee's id}.
-func (c *Canvas) UserObserveesAddAnObserveeWithCredentials(progress *task.Progress, observee *string, accessToken *string, pairingCode *string, rootAccountID *int, userID string) (*User, error) {
+func (c *Canvas) UserObserveesAddAnObserveeWithCredentials(ctx context.Context, progress *task.Progress, observee *string, accessToken *string, pairingCode *string, rootAccountID *int, userID string) (*User, error) {
 	endpoint := fmt.Sprintf("users/%s/observees", userID)
 	params := map[string]interface{}{}
 	if observee != nil {
@@ -16954,7 +17397,7 @@ func (c *Canvas) UserObserveesAddAnObserveeWithCredentials(progress *task.Progre
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -16962,7 +17405,7 @@ func (c *Canvas) UserObserveesAddAnObserveeWithCredentials(progress *task.Progre
 
 // UserObserveesShowAnObservee API call: Gets information about an observed user. *Note:* all users are allowed to view
 // their own observees.
-func (c *Canvas) UserObserveesShowAnObservee(progress *task.Progress, userID string, observeeID string) (*User, error) {
+func (c *Canvas) UserObserveesShowAnObservee(ctx context.Context, progress *task.Progress, userID string, observeeID string) (*User, error) {
 	endpoint := fmt.Sprintf("users/%s/observees/%s", userID, observeeID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -16973,14 +17416,14 @@ func (c *Canvas) UserObserveesShowAnObservee(progress *task.Progress, userID str
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UserObserveesAddAnObservee API call: Registers a user as being observed by the given user.
-func (c *Canvas) UserObserveesAddAnObservee(progress *task.Progress, rootAccountID *int, userID string, observeeID string) (*User, error) {
+func (c *Canvas) UserObserveesAddAnObservee(ctx context.Context, progress *task.Progress, rootAccountID *int, userID string, observeeID string) (*User, error) {
 	endpoint := fmt.Sprintf("users/%s/observees/%s", userID, observeeID)
 	params := map[string]interface{}{}
 	if rootAccountID != nil {
@@ -16994,14 +17437,14 @@ func (c *Canvas) UserObserveesAddAnObservee(progress *task.Progress, rootAccount
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UserObserveesRemoveAnObservee API call: Unregisters a user as being observed by the given user.
-func (c *Canvas) UserObserveesRemoveAnObservee(progress *task.Progress, rootAccountID *int, userID string, observeeID string) (*User, error) {
+func (c *Canvas) UserObserveesRemoveAnObservee(ctx context.Context, progress *task.Progress, rootAccountID *int, userID string, observeeID string) (*User, error) {
 	endpoint := fmt.Sprintf("users/%s/observees/%s", userID, observeeID)
 	params := map[string]interface{}{}
 	if rootAccountID != nil {
@@ -17015,14 +17458,14 @@ func (c *Canvas) UserObserveesRemoveAnObservee(progress *task.Progress, rootAcco
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersListUsersInAccount API call: A paginated list of of users associated with this account.
-func (c *Canvas) UsersListUsersInAccount(progress *task.Progress, searchTerm *string, enrollmentType *string, sort *UsersListUsersInAccountSort, order *UsersListUsersInAccountOrder) ([]User, error) {
+func (c *Canvas) UsersListUsersInAccount(ctx context.Context, progress *task.Progress, searchTerm *string, enrollmentType *string, sort *UsersListUsersInAccountSort, order *UsersListUsersInAccountOrder) ([]User, error) {
 	endpoint := fmt.Sprintf("accounts/self/users")
 	params := map[string]interface{}{}
 	if searchTerm != nil {
@@ -17046,14 +17489,14 @@ func (c *Canvas) UsersListUsersInAccount(progress *task.Progress, searchTerm *st
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersListTheActivityStream API call: Returns the current user's global activity stream, paginated.
-func (c *Canvas) UsersListTheActivityStream(progress *task.Progress, onlyActiveCourses *bool) (*map[string]interface{}, error) {
+func (c *Canvas) UsersListTheActivityStream(ctx context.Context, progress *task.Progress, onlyActiveCourses *bool) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if onlyActiveCourses != nil {
@@ -17067,14 +17510,14 @@ func (c *Canvas) UsersListTheActivityStream(progress *task.Progress, onlyActiveC
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersActivityStreamSummary API call: Returns a summary of the current user's global activity stream.
-func (c *Canvas) UsersActivityStreamSummary(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) UsersActivityStreamSummary(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17085,14 +17528,14 @@ func (c *Canvas) UsersActivityStreamSummary(progress *task.Progress) (*map[strin
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersListTheTODOItems API call: A paginated list of the current user's list of todo items.
-func (c *Canvas) UsersListTheTODOItems(progress *task.Progress, include *UsersListTheTODOItemsInclude) (*map[string]interface{}, error) {
+func (c *Canvas) UsersListTheTODOItems(ctx context.Context, progress *task.Progress, include *UsersListTheTODOItemsInclude) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -17106,7 +17549,7 @@ func (c *Canvas) UsersListTheTODOItems(progress *task.Progress, include *UsersLi
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17114,7 +17557,7 @@ func (c *Canvas) UsersListTheTODOItems(progress *task.Progress, include *UsersLi
 
 // UsersListCountsForTodoItems API call: Counts of different todo items such as the number of assignments needing
 // grading as well as the number of assignments needing submitting.
-func (c *Canvas) UsersListCountsForTodoItems(progress *task.Progress, include *UsersListCountsForTodoItemsInclude) (*map[string]interface{}, error) {
+func (c *Canvas) UsersListCountsForTodoItems(ctx context.Context, progress *task.Progress, include *UsersListCountsForTodoItemsInclude) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -17128,14 +17571,14 @@ func (c *Canvas) UsersListCountsForTodoItems(progress *task.Progress, include *U
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersListUpcomingAssignmentsCalendarEvents API call: A paginated list of the current user's upcoming events.
-func (c *Canvas) UsersListUpcomingAssignmentsCalendarEvents(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) UsersListUpcomingAssignmentsCalendarEvents(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17146,7 +17589,7 @@ func (c *Canvas) UsersListUpcomingAssignmentsCalendarEvents(progress *task.Progr
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17155,7 +17598,7 @@ func (c *Canvas) UsersListUpcomingAssignmentsCalendarEvents(progress *task.Progr
 // UsersListMissingSubmissions API call: A paginated list of past-due assignments for which the student does not have a
 // submission. The user sending the request must either be the student, an admin or a parent observer using the parent
 // app
-func (c *Canvas) UsersListMissingSubmissions(progress *task.Progress, userID *interface{}, include *UsersListMissingSubmissionsInclude, filter *UsersListMissingSubmissionsFilter) ([]Assignment, error) {
+func (c *Canvas) UsersListMissingSubmissions(ctx context.Context, progress *task.Progress, userID *interface{}, include *UsersListMissingSubmissionsInclude, filter *UsersListMissingSubmissionsFilter) ([]Assignment, error) {
 	endpoint := fmt.Sprintf("")
 	params := map[string]interface{}{}
 	if userID != nil {
@@ -17176,14 +17619,14 @@ func (c *Canvas) UsersListMissingSubmissions(progress *task.Progress, userID *in
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersHideAStreamItem API call: Hide the given stream item.
-func (c *Canvas) UsersHideAStreamItem(progress *task.Progress, streamItemID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersHideAStreamItem(ctx context.Context, progress *task.Progress, streamItemID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/activity_stream/%s", streamItemID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17194,14 +17637,14 @@ func (c *Canvas) UsersHideAStreamItem(progress *task.Progress, streamItemID stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersHideAllStreamItems API call: Hide all stream items for the user
-func (c *Canvas) UsersHideAllStreamItems(progress *task.Progress) (*map[string]interface{}, error) {
+func (c *Canvas) UsersHideAllStreamItems(ctx context.Context, progress *task.Progress) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/activity_stream")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17212,7 +17655,7 @@ func (c *Canvas) UsersHideAllStreamItems(progress *task.Progress) (*map[string]i
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17222,8 +17665,8 @@ func (c *Canvas) UsersHideAllStreamItems(progress *task.Progress) (*map[string]i
 // uploading a file to a user's files. See the {file:file_uploads.html File Upload Documentation} for details on the
 // file upload workflow. Note that typically users will only be able to upload files to their own files section. Passing
 // a user_id of +self+ is an easy shortcut to specify the current user.
-func (c *Canvas) UsersUploadAFile(progress *task.Progress) (*map[string]interface{}, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) UsersUploadAFile(ctx context.Context, progress *task.Progress, userID string) (*map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("users/%s/files", userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
 		return &map[string]interface{}{}
@@ -17233,7 +17676,7 @@ func (c *Canvas) UsersUploadAFile(progress *task.Progress) (*map[string]interfac
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17243,7 +17686,7 @@ func (c *Canvas) UsersUploadAFile(progress *task.Progress) (*map[string]interfac
 // list of permissions for the user. Example: !!!javascript "permissions": { "can_update_name": true, // Whether the
 // user can update their name. "can_update_avatar": false, // Whether the user can update their avatar.
 // "limit_parent_app_web_access": false // Whether the user can interact with Canvas web from the Canvas Parent app.
-func (c *Canvas) UsersShowUserDetails(progress *task.Progress, include *UsersShowUserDetailsInclude) (*User, error) {
+func (c *Canvas) UsersShowUserDetails(ctx context.Context, progress *task.Progress, include *UsersShowUserDetailsInclude) (*User, error) {
 	endpoint := fmt.Sprintf("users/self")
 	params := map[string]interface{}{}
 	if include != nil {
@@ -17257,7 +17700,7 @@ func (c *Canvas) UsersShowUserDetails(progress *task.Progress, include *UsersSho
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17266,8 +17709,8 @@ func (c *Canvas) UsersShowUserDetails(progress *task.Progress, include *UsersSho
 // UsersCreateAUser API call: Create and return a new user and pseudonym for an account. If you don't have the "Modify
 // login details for users" permission, but self-registration is enabled on the account, you can still use this endpoint
 // to register new users. Certain fields will be required, and others will be ignored (see below).
-func (c *Canvas) UsersCreateAUser(progress *task.Progress, user *string, pseudonym *string, communicationChannel *string, forceValidations *bool, enableSisReactivation *bool, destination *string) (*User, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) UsersCreateAUser(ctx context.Context, progress *task.Progress, user *string, pseudonym *string, communicationChannel *string, forceValidations *bool, enableSisReactivation *bool, destination *string, accountID string) (*User, error) {
+	endpoint := fmt.Sprintf("accounts/%s/users", accountID)
 	params := map[string]interface{}{}
 	if user != nil {
 		params["user"] = *user
@@ -17295,7 +17738,7 @@ func (c *Canvas) UsersCreateAUser(progress *task.Progress, user *string, pseudon
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17303,8 +17746,8 @@ func (c *Canvas) UsersCreateAUser(progress *task.Progress, user *string, pseudon
 
 // UsersSelfRegisterAUser API call: Self register and return a new user and pseudonym for an account. If
 // self-registration is enabled on the account, you can use this endpoint to self register new users.
-func (c *Canvas) UsersSelfRegisterAUser(progress *task.Progress, user *string, pseudonym *string, communicationChannel *string) (*User, error) {
-	endpoint := fmt.Sprintf("")
+func (c *Canvas) UsersSelfRegisterAUser(ctx context.Context, progress *task.Progress, user *string, pseudonym *string, communicationChannel *string, accountID string) (*User, error) {
+	endpoint := fmt.Sprintf("accounts/%s/self_registration", accountID)
 	params := map[string]interface{}{}
 	if user != nil {
 		params["user"] = *user
@@ -17323,14 +17766,14 @@ func (c *Canvas) UsersSelfRegisterAUser(progress *task.Progress, user *string, p
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersUpdateUserSettings API call: Update an existing user's settings.
-func (c *Canvas) UsersUpdateUserSettings(progress *task.Progress, manualMarkAsRead *bool, collapseGlobalNav *bool, hideDashcardColorOverlays *bool, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersUpdateUserSettings(ctx context.Context, progress *task.Progress, manualMarkAsRead *bool, collapseGlobalNav *bool, hideDashcardColorOverlays *bool, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/settings", userID)
 	params := map[string]interface{}{}
 	if manualMarkAsRead != nil {
@@ -17350,14 +17793,14 @@ func (c *Canvas) UsersUpdateUserSettings(progress *task.Progress, manualMarkAsRe
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersGetCustomColors API call: Returns all custom colors that have been saved for a user.
-func (c *Canvas) UsersGetCustomColors(progress *task.Progress, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersGetCustomColors(ctx context.Context, progress *task.Progress, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/colors/", userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17368,7 +17811,7 @@ func (c *Canvas) UsersGetCustomColors(progress *task.Progress, userID string) (*
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17376,7 +17819,7 @@ func (c *Canvas) UsersGetCustomColors(progress *task.Progress, userID string) (*
 
 // UsersGetCustomColor API call: Returns the custom colors that have been saved for a user for a given context. The
 // asset_string parameter should be in the format 'context_id', for example 'course_42'.
-func (c *Canvas) UsersGetCustomColor(progress *task.Progress, userID string, assetString string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersGetCustomColor(ctx context.Context, progress *task.Progress, userID string, assetString string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/colors/%s", userID, assetString)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17387,7 +17830,7 @@ func (c *Canvas) UsersGetCustomColor(progress *task.Progress, userID string, ass
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17396,7 +17839,7 @@ func (c *Canvas) UsersGetCustomColor(progress *task.Progress, userID string, ass
 // UsersUpdateCustomColor API call: Updates a custom color for a user for a given context.  This allows colors for the
 // calendar and elsewhere to be customized on a user basis. The asset string parameter should be in the format
 // 'context_id', for example 'course_42'
-func (c *Canvas) UsersUpdateCustomColor(progress *task.Progress, hexcode *string, userID string, assetString string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersUpdateCustomColor(ctx context.Context, progress *task.Progress, hexcode *string, userID string, assetString string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/colors/%s", userID, assetString)
 	params := map[string]interface{}{}
 	if hexcode != nil {
@@ -17410,14 +17853,14 @@ func (c *Canvas) UsersUpdateCustomColor(progress *task.Progress, hexcode *string
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersGetDashboardPositions API call: Returns all dashboard positions that have been saved for a user.
-func (c *Canvas) UsersGetDashboardPositions(progress *task.Progress, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersGetDashboardPositions(ctx context.Context, progress *task.Progress, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/dashboard_positions/", userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17428,7 +17871,7 @@ func (c *Canvas) UsersGetDashboardPositions(progress *task.Progress, userID stri
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17437,7 +17880,7 @@ func (c *Canvas) UsersGetDashboardPositions(progress *task.Progress, userID stri
 // UsersUpdateDashboardPositions API call: Updates the dashboard positions for a user for a given context.  This allows
 // positions for the dashboard cards and elsewhere to be customized on a per user basis. The asset string parameter
 // should be in the format 'context_id', for example 'course_42'
-func (c *Canvas) UsersUpdateDashboardPositions(progress *task.Progress, userID string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersUpdateDashboardPositions(ctx context.Context, progress *task.Progress, userID string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/%s/dashboard_positions/", userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17448,14 +17891,14 @@ func (c *Canvas) UsersUpdateDashboardPositions(progress *task.Progress, userID s
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersEditAUser API call: Modify an existing user. To modify a user's login, see the documentation for logins.
-func (c *Canvas) UsersEditAUser(progress *task.Progress, user *string) (*User, error) {
+func (c *Canvas) UsersEditAUser(ctx context.Context, progress *task.Progress, user *string) (*User, error) {
 	endpoint := fmt.Sprintf("users/133.json")
 	params := map[string]interface{}{}
 	if user != nil {
@@ -17469,7 +17912,7 @@ func (c *Canvas) UsersEditAUser(progress *task.Progress, user *string) (*User, e
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17500,7 +17943,7 @@ func (c *Canvas) UsersEditAUser(progress *task.Progress, user *string) (*User, e
 // getting the uuid from an api that is in a context that was recorded from a merge event, an additional attribute is
 // added as past_uuid. When finding users by SIS ids in different accounts the destination_account_id is required. The
 // account can also be identified by passing the domain in destination_account_id.
-func (c *Canvas) UsersMergeUserIntoAnotherUser(progress *task.Progress, userID string, destinationAccountID string, destinationUserID string) (*User, error) {
+func (c *Canvas) UsersMergeUserIntoAnotherUser(ctx context.Context, progress *task.Progress, userID string, destinationAccountID string, destinationUserID string) (*User, error) {
 	endpoint := fmt.Sprintf("users/%s/merge_into/accounts/%s/users/%s", userID, destinationAccountID, destinationUserID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17511,7 +17954,7 @@ func (c *Canvas) UsersMergeUserIntoAnotherUser(progress *task.Progress, userID s
 		res = obj.(*User)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17545,7 +17988,7 @@ func (c *Canvas) UsersMergeUserIntoAnotherUser(progress *task.Progress, userID s
 // submission that were created in a course that was moved in enrollments are moved over to the from_user. Other notes:
 // Access Tokens are moved back on split. Conversations are moved back on split. Favorites that existing at the time of
 // merge are moved back on split. LTI ids are restored to how they were prior to merge.
-func (c *Canvas) UsersSplitMergedUsersIntoSeparateUsers(progress *task.Progress, userID string) ([]User, error) {
+func (c *Canvas) UsersSplitMergedUsersIntoSeparateUsers(ctx context.Context, progress *task.Progress, userID string) ([]User, error) {
 	endpoint := fmt.Sprintf("users/%s/split", userID)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17557,7 +18000,7 @@ func (c *Canvas) UsersSplitMergedUsersIntoSeparateUsers(progress *task.Progress,
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17565,7 +18008,7 @@ func (c *Canvas) UsersSplitMergedUsersIntoSeparateUsers(progress *task.Progress,
 
 // UsersGetAPandataEventsJwtTokenAndItsExpirationDate API call: Returns a jwt auth and props token that can be used to
 // send events to Pandata. NOTE: This is currently only available to the mobile developer keys.
-func (c *Canvas) UsersGetAPandataEventsJwtTokenAndItsExpirationDate(progress *task.Progress, appKey *string) (*map[string]interface{}, error) {
+func (c *Canvas) UsersGetAPandataEventsJwtTokenAndItsExpirationDate(ctx context.Context, progress *task.Progress, appKey *string) (*map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("users/self/pandata_events_token")
 	params := map[string]interface{}{}
 	if appKey != nil {
@@ -17579,14 +18022,14 @@ func (c *Canvas) UsersGetAPandataEventsJwtTokenAndItsExpirationDate(progress *ta
 		res = obj.(*map[string]interface{})
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // UsersGetAUsersMostRecentlyGradedSubmissions API call
-func (c *Canvas) UsersGetAUsersMostRecentlyGradedSubmissions(progress *task.Progress, include *UsersGetAUsersMostRecentlyGradedSubmissionsInclude, onlyCurrentEnrollments *bool, onlyPublishedAssignments *bool, userID string) ([]Submission, error) {
+func (c *Canvas) UsersGetAUsersMostRecentlyGradedSubmissions(ctx context.Context, progress *task.Progress, include *UsersGetAUsersMostRecentlyGradedSubmissionsInclude, onlyCurrentEnrollments *bool, onlyPublishedAssignments *bool, userID string) ([]Submission, error) {
 	endpoint := fmt.Sprintf("users/%s/graded_submissions", userID)
 	params := map[string]interface{}{}
 	if include != nil {
@@ -17607,14 +18050,14 @@ func (c *Canvas) UsersGetAUsersMostRecentlyGradedSubmissions(progress *task.Prog
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesShowFrontPage API call: Retrieve the content of the front page
-func (c *Canvas) PagesShowFrontPage(progress *task.Progress) (*Page, error) {
+func (c *Canvas) PagesShowFrontPage(ctx context.Context, progress *task.Progress) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/front_page")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17625,14 +18068,14 @@ func (c *Canvas) PagesShowFrontPage(progress *task.Progress) (*Page, error) {
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesDuplicatePage API call: Duplicate a wiki page
-func (c *Canvas) PagesDuplicatePage(progress *task.Progress) (*Page, error) {
+func (c *Canvas) PagesDuplicatePage(ctx context.Context, progress *task.Progress) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/14/duplicate")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17643,14 +18086,14 @@ func (c *Canvas) PagesDuplicatePage(progress *task.Progress) (*Page, error) {
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesUpdateCreateFrontPage API call: Update the title or contents of the front page
-func (c *Canvas) PagesUpdateCreateFrontPage(progress *task.Progress, wikiPage *string) (*Page, error) {
+func (c *Canvas) PagesUpdateCreateFrontPage(ctx context.Context, progress *task.Progress, wikiPage *string) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/front_page")
 	params := map[string]interface{}{}
 	if wikiPage != nil {
@@ -17664,14 +18107,14 @@ func (c *Canvas) PagesUpdateCreateFrontPage(progress *task.Progress, wikiPage *s
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesListPages API call: A paginated list of the wiki pages associated with a course or group
-func (c *Canvas) PagesListPages(progress *task.Progress, sort *PagesListPagesSort, order *PagesListPagesOrder, searchTerm *string, published *bool, courseID string) ([]Page, error) {
+func (c *Canvas) PagesListPages(ctx context.Context, progress *task.Progress, sort *PagesListPagesSort, order *PagesListPagesOrder, searchTerm *string, published *bool, courseID string) ([]Page, error) {
 	endpoint := fmt.Sprintf("courses/%s/pages", courseID)
 	params := map[string]interface{}{}
 	if sort != nil {
@@ -17695,14 +18138,14 @@ func (c *Canvas) PagesListPages(progress *task.Progress, sort *PagesListPagesSor
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesCreatePage API call: Create a new wiki page
-func (c *Canvas) PagesCreatePage(progress *task.Progress, wikiPage *string) (*Page, error) {
+func (c *Canvas) PagesCreatePage(ctx context.Context, progress *task.Progress, wikiPage *string) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/pages")
 	params := map[string]interface{}{}
 	if wikiPage != nil {
@@ -17716,14 +18159,14 @@ func (c *Canvas) PagesCreatePage(progress *task.Progress, wikiPage *string) (*Pa
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesShowPage API call: Retrieve the content of a wiki page
-func (c *Canvas) PagesShowPage(progress *task.Progress, courseID string, url string) (*Page, error) {
+func (c *Canvas) PagesShowPage(ctx context.Context, progress *task.Progress, courseID string, url string) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/%s/pages/%s", courseID, url)
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17734,14 +18177,14 @@ func (c *Canvas) PagesShowPage(progress *task.Progress, courseID string, url str
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesUpdateCreatePage API call: Update the title or contents of a wiki page
-func (c *Canvas) PagesUpdateCreatePage(progress *task.Progress, wikiPage *string) (*Page, error) {
+func (c *Canvas) PagesUpdateCreatePage(ctx context.Context, progress *task.Progress, wikiPage *string) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/the-page-url")
 	params := map[string]interface{}{}
 	if wikiPage != nil {
@@ -17755,14 +18198,14 @@ func (c *Canvas) PagesUpdateCreatePage(progress *task.Progress, wikiPage *string
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "PUT", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesDeletePage API call: Delete a wiki page
-func (c *Canvas) PagesDeletePage(progress *task.Progress) (*Page, error) {
+func (c *Canvas) PagesDeletePage(ctx context.Context, progress *task.Progress) (*Page, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/the-page-url")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17773,7 +18216,7 @@ func (c *Canvas) PagesDeletePage(progress *task.Progress) (*Page, error) {
 		res = obj.(*Page)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "DELETE", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17781,7 +18224,7 @@ func (c *Canvas) PagesDeletePage(progress *task.Progress) (*Page, error) {
 
 // PagesListRevisions API call: A paginated list of the revisions of a page. Callers must have update rights on the page
 // in order to see page history.
-func (c *Canvas) PagesListRevisions(progress *task.Progress) ([]PageRevision, error) {
+func (c *Canvas) PagesListRevisions(ctx context.Context, progress *task.Progress) ([]PageRevision, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/the-page-url/revisions")
 	params := map[string]interface{}{}
 	responseCtor := func() interface{} {
@@ -17793,7 +18236,7 @@ func (c *Canvas) PagesListRevisions(progress *task.Progress) ([]PageRevision, er
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -17801,7 +18244,7 @@ func (c *Canvas) PagesListRevisions(progress *task.Progress) ([]PageRevision, er
 
 // PagesShowRevision API call: Retrieve the metadata and optionally content of a revision of the page. Note that
 // retrieving historic versions of pages requires edit rights.
-func (c *Canvas) PagesShowRevision(progress *task.Progress, summary *bool) (*PageRevision, error) {
+func (c *Canvas) PagesShowRevision(ctx context.Context, progress *task.Progress, summary *bool) (*PageRevision, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/the-page-url/revisions/4")
 	params := map[string]interface{}{}
 	if summary != nil {
@@ -17815,14 +18258,14 @@ func (c *Canvas) PagesShowRevision(progress *task.Progress, summary *bool) (*Pag
 		res = obj.(*PageRevision)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "GET", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
 // PagesRevertToRevision API call: Revert a page to a prior revision.
-func (c *Canvas) PagesRevertToRevision(progress *task.Progress, revisionID *int) (*PageRevision, error) {
+func (c *Canvas) PagesRevertToRevision(ctx context.Context, progress *task.Progress, revisionID *int) (*PageRevision, error) {
 	endpoint := fmt.Sprintf("courses/123/pages/the-page-url/revisions/6")
 	params := map[string]interface{}{}
 	if revisionID != nil {
@@ -17836,7 +18279,7 @@ func (c *Canvas) PagesRevertToRevision(progress *task.Progress, revisionID *int)
 		res = obj.(*PageRevision)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "POST", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
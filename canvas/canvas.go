@@ -1,15 +1,17 @@
 package canvas
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 )
 
 // Canvas represents the Canvas API
 type Canvas struct {
-	subdomain       string
+	baseURL         *url.URL
 	token           string
 	parameterTypes  []parameterType
 	client          *http.Client
@@ -19,13 +21,45 @@ type Canvas struct {
 	quotaCalcMutex  sync.Mutex
 	quotaNotify     chan interface{}
 	pendingRequests int
+	pendingCost     float64
+	quotaMax        float64
+	requestCosts    map[string]float64
+	throttledUntil  time.Time
+	throttleBackoff time.Duration
+	connections     chan struct{}
+	tokenMutex      sync.Mutex
+	refreshMutex    sync.Mutex
+	oauth           *OAuthConfig
+	oauthToken      OAuthToken
+	onTokenRefresh  func(OAuthToken) error
 	RawSaveFolder   string
+	RetryPolicy     RetryPolicy
+	RequestTimeout  time.Duration
+	RawCacheBinary  bool
+	Offline         bool
 }
 
+// DefaultRequestTimeout is the longest a single request (including reading the response) may take before it is
+// abandoned
+const DefaultRequestTimeout = 5 * time.Minute
+
 // CreateCanvas creates a new Canvas object
-func CreateCanvas(subdomain, token string, rawSaveFolder string) (*Canvas, error) {
+func CreateCanvas(baseURL, token string, rawSaveFolder string) (*Canvas, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return nil, errors.New("Base URL must contain a scheme and a host")
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path = u.Path + "/"
+	}
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
 	c := &Canvas{
-		subdomain:      subdomain,
+		baseURL:        u,
 		token:          token,
 		parameterTypes: []parameterType{},
 		client: &http.Client{
@@ -39,7 +73,11 @@ func CreateCanvas(subdomain, token string, rawSaveFolder string) (*Canvas, error
 		quotaCalcMutex:  sync.Mutex{},
 		quotaNotify:     make(chan interface{}),
 		pendingRequests: 0,
+		requestCosts:    map[string]float64{},
+		connections:     make(chan struct{}, DefaultMaxConnections),
 		RawSaveFolder:   rawSaveFolder,
+		RetryPolicy:     DefaultRetryPolicy,
+		RequestTimeout:  DefaultRequestTimeout,
 	}
 	if err := c.registerDefaultParameterTypes(); err != nil {
 		return nil, err
@@ -47,12 +85,22 @@ func CreateCanvas(subdomain, token string, rawSaveFolder string) (*Canvas, error
 	return c, nil
 }
 
-// GetSubdomain of the Canvas API
-func (c *Canvas) GetSubdomain() string {
-	return c.subdomain
+// GetHost of the Canvas API (including the port, if there is one)
+func (c *Canvas) GetHost() string {
+	return c.baseURL.Host
 }
 
 // GetBaseURL of the Canvas API
 func (c *Canvas) GetBaseURL() string {
-	return fmt.Sprintf("https://%s.instructure.com/", c.subdomain)
+	return c.baseURL.String()
+}
+
+// isCanvasURL determines if a URL points to this Canvas instance
+func (c *Canvas) isCanvasURL(u *url.URL) bool {
+	return u.Scheme == c.baseURL.Scheme && u.Host == c.baseURL.Host && strings.HasPrefix(u.Path, c.baseURL.Path)
+}
+
+// SetTransport replaces the transport used to send HTTP requests, for example to record the responses
+func (c *Canvas) SetTransport(transport http.RoundTripper) {
+	c.client.Transport = transport
 }
@@ -0,0 +1,297 @@
+package canvastest
+
+import (
+	"fmt"
+)
+
+// IDs of the objects in the default fixtures
+const (
+	DefaultUserID         = 1
+	DefaultCourseID       = 101
+	DefaultAssignmentID   = 201
+	DefaultFileID         = 301
+	DefaultFolderID       = 401
+	DefaultModuleID       = 501
+	DefaultQuizID         = 601
+	DefaultConversationID = 701
+	DefaultTopicID        = 801
+	DefaultAnnouncementID = 802
+)
+
+// DefaultFixtures creates fixtures for a small Canvas instance with a single student enrolled in a single course that
+// has an object of each kind that gets synced
+func DefaultFixtures() *Fixtures {
+	f := CreateFixtures("http://canvas.test/")
+	course := fmt.Sprintf("/api/v1/courses/%d", DefaultCourseID)
+	file := fmt.Sprintf("http://canvas.test/files/%d/download", DefaultFileID)
+	f.AddJSON("/api/v1/users/self", fmt.Sprintf(`{
+		"id": %d,
+		"name": "Test Student",
+		"sortable_name": "Student, Test",
+		"short_name": "Test"
+	}`, DefaultUserID))
+	f.AddPages("/api/v1/courses", fmt.Sprintf(`[{
+		"id": %d,
+		"name": "Introduction to Testing",
+		"course_code": "TEST 101",
+		"workflow_state": "available",
+		"enrollments": [{"type": "student", "role": "StudentEnrollment", "user_id": %d, "enrollment_state": "active"}]
+	}]`, DefaultCourseID, DefaultUserID))
+	f.AddPages(fmt.Sprintf("%s/users", course), fmt.Sprintf(`[{
+		"id": %d,
+		"name": "Test Student",
+		"sortable_name": "Student, Test",
+		"enrollments": [{"type": "StudentEnrollment", "role": "StudentEnrollment", "enrollment_state": "active"}]
+	}, {
+		"id": 2,
+		"name": "Test Teacher",
+		"sortable_name": "Teacher, Test",
+		"enrollments": [{"type": "TeacherEnrollment", "role": "TeacherEnrollment", "enrollment_state": "active"}]
+	}]`, DefaultUserID))
+	assignment := fmt.Sprintf(`{
+		"id": %d,
+		"name": "First Assignment",
+		"description": "<p>Write a test.</p>",
+		"due_at": "2020-09-01T23:59:00Z",
+		"points_possible": 10,
+		"position": 1,
+		"course_id": %d,
+		"html_url": "http://canvas.test/courses/%d/assignments/%d"%%s
+	}`, DefaultAssignmentID, DefaultCourseID, DefaultCourseID, DefaultAssignmentID)
+	submission := fmt.Sprintf(`{
+		"assignment_id": %d,
+		"user_id": %d,
+		"score": 9,
+		"grade": "9",
+		"workflow_state": "graded",
+		"submitted_at": "2020-09-01T12:00:00Z",
+		"graded_at": "2020-09-02T12:00:00Z",
+		"posted_at": "2020-09-02T12:00:00Z",
+		"late": false,
+		"missing": false,
+		"excused": false,
+		"rubric_assessment": {
+			"crit_1": {"rating_id": "rat_1", "points": 6, "comments": "Good coverage."},
+			"crit_2": {"rating_id": "rat_4", "points": 3, "comments": ""}
+		}
+	}`, DefaultAssignmentID, DefaultUserID)
+	rubric := `,
+		"rubric": [{
+			"id": "crit_1",
+			"description": "Coverage",
+			"long_description": "Tests cover the code",
+			"points": 6,
+			"ratings": [
+				{"id": "rat_1", "description": "Full Marks", "points": 6},
+				{"id": "rat_2", "description": "No Marks", "points": 0}
+			]
+		}, {
+			"id": "crit_2",
+			"description": "Style",
+			"points": 4,
+			"ratings": [
+				{"id": "rat_3", "description": "Full Marks", "points": 4},
+				{"id": "rat_4", "description": "Some Issues", "points": 3},
+				{"id": "rat_5", "description": "No Marks", "points": 0}
+			]
+		}]`
+	f.AddPages(fmt.Sprintf("%s/assignments", course), fmt.Sprintf("[%s]", fmt.Sprintf(assignment, rubric)))
+	f.AddJSON(fmt.Sprintf("%s/assignments/%d/submissions/self", course, DefaultAssignmentID), submission)
+	f.AddPages(fmt.Sprintf("%s/assignment_groups", course), fmt.Sprintf(`[{
+		"id": 1,
+		"name": "Assignments",
+		"position": 1,
+		"group_weight": 100,
+		"rules": {},
+		"assignments": [%s]
+	}]`, fmt.Sprintf(assignment, fmt.Sprintf(",\n\t\t\"submission\": %s", submission))))
+	fileJSON := fmt.Sprintf(`{
+		"id": %d,
+		"folder_id": %d,
+		"display_name": "syllabus.txt",
+		"filename": "syllabus.txt",
+		"content-type": "text/plain",
+		"url": "%s",
+		"size": 21,
+		"created_at": "2020-08-01T12:00:00Z",
+		"updated_at": "2020-08-01T12:00:00Z",
+		"modified_at": "2020-08-01T12:00:00Z"
+	}`, DefaultFileID, DefaultFolderID, file)
+	f.AddPages(fmt.Sprintf("%s/files", course), fmt.Sprintf("[%s]", fileJSON))
+	f.AddJSON(fmt.Sprintf("/api/v1/files/%d", DefaultFileID), fileJSON)
+	f.AddJSON(fmt.Sprintf("%s/files/%d", course, DefaultFileID), fileJSON)
+	f.AddFile(fmt.Sprintf("/files/%d/download", DefaultFileID), "text/plain", []byte("Read the syllabus.\n\n\n"))
+	f.AddPages(fmt.Sprintf("%s/folders", course), fmt.Sprintf(`[{
+		"id": %d,
+		"name": "course files",
+		"full_name": "course files",
+		"context_id": %d,
+		"context_type": "Course",
+		"files_count": 1
+	}]`, DefaultFolderID, DefaultCourseID))
+	f.AddPages(fmt.Sprintf("%s/modules", course), fmt.Sprintf(`[{
+		"id": %d,
+		"name": "Week 1",
+		"position": 1,
+		"items_count": 4,
+		"items": [{
+			"id": 1,
+			"module_id": %d,
+			"position": 1,
+			"title": "Syllabus",
+			"type": "File",
+			"content_id": %d,
+			"url": "http://canvas.test%s/files/%d"
+		}, {
+			"id": 2,
+			"module_id": %d,
+			"position": 2,
+			"title": "Welcome",
+			"type": "Page",
+			"page_url": "welcome",
+			"url": "http://canvas.test%s/pages/welcome"
+		}, {
+			"id": 3,
+			"module_id": %d,
+			"position": 3,
+			"title": "Canvas Guides",
+			"type": "ExternalUrl",
+			"external_url": "https://guides.instructure.com/"
+		}, {
+			"id": 4,
+			"module_id": %d,
+			"position": 4,
+			"title": "First Quiz",
+			"type": "Quiz",
+			"content_id": %d,
+			"url": "http://canvas.test%s/quizzes/%d"
+		}]
+	}]`, DefaultModuleID, DefaultModuleID, DefaultFileID, course, DefaultFileID, DefaultModuleID, course, DefaultModuleID,
+		DefaultModuleID, DefaultQuizID, course, DefaultQuizID))
+	page := `{
+		"url": "welcome",
+		"title": "Welcome",
+		"created_at": "2020-08-01T12:00:00Z",
+		"updated_at": "2020-08-01T12:00:00Z",
+		"published": true,
+		"front_page": true%s
+	}`
+	f.AddPages(fmt.Sprintf("%s/pages", course), fmt.Sprintf("[%s]", fmt.Sprintf(page, "")))
+	f.AddJSON(fmt.Sprintf("%s/pages/welcome", course), fmt.Sprintf(page, `,
+		"body": "<p>Welcome to the course!</p>"`))
+	topic := `{
+		"id": %d,
+		"title": "%s",
+		"message": "<p>%s</p>",
+		"posted_at": "2020-08-02T12:00:00Z",
+		"discussion_type": "threaded",
+		"published": true,
+		"attachments": [%s]
+	}`
+	attachment := fmt.Sprintf(`{
+		"id": %d,
+		"display_name": "syllabus.txt",
+		"filename": "syllabus.txt",
+		"content-type": "text/plain",
+		"url": "%s"
+	}`, DefaultFileID, file)
+	f.AddPages(fmt.Sprintf("%s/discussion_topics", course), fmt.Sprintf("[%s]", fmt.Sprintf(topic, DefaultTopicID, "Introductions", "Introduce yourself.", attachment)))
+	f.AddPages("/api/v1/announcements", fmt.Sprintf("[%s]", fmt.Sprintf(topic, DefaultAnnouncementID, "First Day", "Class starts today.", "")))
+	for _, id := range []int{DefaultTopicID, DefaultAnnouncementID} {
+		f.AddJSON(fmt.Sprintf("%s/discussion_topics/%d/view", course, id), fmt.Sprintf(`{
+			"participants": [{"id": %d, "display_name": "Test Student"}],
+			"unread_entries": [],
+			"entry_ratings": {},
+			"forced_entries": [],
+			"view": [{
+				"id": 1,
+				"user_id": %d,
+				"message": "<p>Hello!</p>",
+				"created_at": "2020-08-03T12:00:00Z",
+				"updated_at": "2020-08-03T12:00:00Z"
+			}],
+			"new_entries": []
+		}`, DefaultUserID, DefaultUserID))
+	}
+	f.AddPages(fmt.Sprintf("%s/quizzes", course), fmt.Sprintf(`[{
+		"id": %d,
+		"title": "First Quiz",
+		"description": "<p>Answer the questions.</p>",
+		"quiz_type": "assignment",
+		"due_at": "2020-09-08T23:59:00Z",
+		"points_possible": 5,
+		"question_count": 5,
+		"time_limit": 30,
+		"allowed_attempts": 2,
+		"scoring_policy": "keep_highest",
+		"published": true,
+		"version_number": 1,
+		"html_url": "http://canvas.test/courses/%d/quizzes/%d"
+	}]`, DefaultQuizID, DefaultCourseID, DefaultQuizID))
+	quizSubmission := `{
+		"id": %d,
+		"quiz_id": %d,
+		"user_id": %d,
+		"attempt": %d,
+		"started_at": "%s",
+		"finished_at": "%s",
+		"time_spent": 600,
+		"score": %d,
+		"kept_score": 4,
+		"fudge_points": 0,
+		"workflow_state": "complete"
+	}`
+	f.AddPages(fmt.Sprintf("%s/quizzes/%d/submissions", course, DefaultQuizID), fmt.Sprintf(`{
+		"quiz_submissions": [%s, %s]
+	}`, fmt.Sprintf(quizSubmission, 1, DefaultQuizID, DefaultUserID, 1, "2020-09-07T12:00:00Z", "2020-09-07T12:10:00Z", 3),
+		fmt.Sprintf(quizSubmission, 2, DefaultQuizID, DefaultUserID, 2, "2020-09-08T12:00:00Z", "2020-09-08T12:10:00Z", 4)))
+	conversation := `{
+		"id": %d,
+		"subject": "Question about the assignment",
+		"workflow_state": "read",
+		"last_message": "See the attached syllabus.",
+		"last_message_at": "2020-08-05T12:00:00Z",
+		"message_count": 2,
+		"context_name": "Test Course",
+		"participants": [{"id": %d, "name": "Test Student"}, {"id": 2, "name": "Test Teacher"}]%s
+	}`
+	f.AddPages("/api/v1/conversations", fmt.Sprintf("[%s]", fmt.Sprintf(conversation, DefaultConversationID, DefaultUserID, "")))
+	f.AddJSON(fmt.Sprintf("/api/v1/conversations/%d", DefaultConversationID), fmt.Sprintf(conversation, DefaultConversationID, DefaultUserID, fmt.Sprintf(`,
+		"messages": [{
+			"id": 2,
+			"created_at": "2020-08-05T12:00:00Z",
+			"body": "See the attached syllabus.",
+			"author_id": 2,
+			"attachments": [%s]
+		}, {
+			"id": 1,
+			"created_at": "2020-08-04T12:00:00Z",
+			"body": "When is the first assignment due?",
+			"author_id": %d,
+			"attachments": []
+		}]`, attachment, DefaultUserID)))
+	f.AddPages("/api/v1/calendar_events", fmt.Sprintf(`[{
+		"id": 901,
+		"title": "Review Session",
+		"description": "<p>Bring your questions.</p>",
+		"start_at": "2020-09-04T18:00:00Z",
+		"end_at": "2020-09-04T19:00:00Z",
+		"location_name": "Room 101",
+		"context_code": "course_%d",
+		"workflow_state": "active",
+		"all_day": false,
+		"all_day_date": "2020-09-04",
+		"updated_at": "2020-08-20T12:00:00Z",
+		"html_url": "http://canvas.test/calendar?event_id=901"
+	}]`, DefaultCourseID))
+	f.AddPages("/api/v1/planner_notes", fmt.Sprintf(`[{
+		"id": 902,
+		"title": "Study for the quiz",
+		"description": "Chapters 1 and 2",
+		"todo_date": "2020-09-07T12:00:00Z",
+		"course_id": %d,
+		"user_id": %d,
+		"workflow_state": "active"
+	}]`, DefaultCourseID, DefaultUserID))
+	return f
+}
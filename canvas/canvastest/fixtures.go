@@ -0,0 +1,240 @@
+package canvastest
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+)
+
+// BaseURLFile is the name of the file in a fixture folder that contains the base URL of the Canvas instance the
+// fixtures were recorded from
+const BaseURLFile = "base.url"
+
+// Fixture is a canned response from the fake server
+type Fixture struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Fixtures are the responses the fake server sends, keyed by the path and query of the request
+type Fixtures struct {
+	// BaseURL of the Canvas instance the fixtures came from, which is replaced by the URL of the fake server in the
+	// headers and bodies of the responses
+	BaseURL   string
+	Responses map[string]Fixture
+}
+
+// CreateFixtures creates an empty set of fixtures
+func CreateFixtures(baseURL string) *Fixtures {
+	return &Fixtures{
+		BaseURL:   baseURL,
+		Responses: map[string]Fixture{},
+	}
+}
+
+// fixtureKey gets the key of the fixture for a path and query, which is the same no matter what order the query
+// parameters are in
+func fixtureKey(p string, query url.Values) string {
+	p = "/" + strings.TrimPrefix(p, "/")
+	if q := query.Encode(); len(q) > 0 {
+		return fmt.Sprintf("%s?%s", p, q)
+	}
+	return p
+}
+
+func parseFixtureKey(pathAndQuery string) (string, error) {
+	u, err := url.Parse(pathAndQuery)
+	if err != nil {
+		return "", err
+	}
+	return fixtureKey(u.Path, u.Query()), nil
+}
+
+// Add a fixture for a path and query (like "/api/v1/courses?page=2")
+func (f *Fixtures) Add(pathAndQuery string, fixture Fixture) {
+	key, err := parseFixtureKey(pathAndQuery)
+	if err != nil {
+		panic(err)
+	}
+	if fixture.Status == 0 {
+		fixture.Status = http.StatusOK
+	}
+	if fixture.Header == nil {
+		fixture.Header = http.Header{}
+	}
+	f.Responses[key] = fixture
+}
+
+// etag creates an entity tag for a body, which changes whenever the body does.  Like Canvas, API responses get weak
+// tags and files get strong tags, which can be used to resume downloads.
+func etag(body []byte, weak bool) string {
+	if weak {
+		return fmt.Sprintf("W/\"%x\"", sha1.Sum(body))
+	}
+	return fmt.Sprintf("\"%x\"", sha1.Sum(body))
+}
+
+// AddJSON adds a fixture with a JSON body
+func (f *Fixtures) AddJSON(pathAndQuery string, body string) {
+	if !json.Valid([]byte(body)) {
+		panic(fmt.Sprintf("Invalid JSON for fixture %s", pathAndQuery))
+	}
+	f.Add(pathAndQuery, Fixture{
+		Header: http.Header{
+			"Content-Type": []string{"application/json; charset=utf-8"},
+			"Etag":         []string{etag([]byte(body), true)},
+		},
+		Body: []byte(body),
+	})
+}
+
+// AddPages adds a paginated JSON response, with Link headers pointing from each page to the next
+func (f *Fixtures) AddPages(p string, pages ...string) {
+	for i, body := range pages {
+		query := url.Values{}
+		if i > 0 {
+			query.Set("page", fmt.Sprint(i+1))
+		}
+		f.AddJSON(fixtureKey(p, query), body)
+		fixture := f.Responses[fixtureKey(p, query)]
+		base := strings.TrimSuffix(f.BaseURL, "/")
+		links := []string{}
+		if i+1 < len(pages) {
+			links = append(links, fmt.Sprintf("<%s%s?page=%d&per_page=%d>; rel=\"next\"", base, p, i+2, canvas.DefaultPerPage))
+		}
+		links = append(links, fmt.Sprintf("<%s%s?page=%d&per_page=%d>; rel=\"last\"", base, p, len(pages), canvas.DefaultPerPage))
+		fixture.Header.Set("Link", strings.Join(links, ","))
+	}
+}
+
+// AddFile adds a file to download
+func (f *Fixtures) AddFile(p string, contentType string, content []byte) {
+	f.Add(p, Fixture{
+		Header: http.Header{
+			"Content-Type": []string{contentType},
+			"Etag":         []string{etag(content, false)},
+		},
+		Body: content,
+	})
+}
+
+// AddRedirect adds a redirect to another location
+func (f *Fixtures) AddRedirect(pathAndQuery string, location string) {
+	f.Add(pathAndQuery, Fixture{
+		Status: http.StatusFound,
+		Header: http.Header{
+			"Location": []string{location},
+		},
+	})
+}
+
+// find gets the fixture for a request.  If there is no fixture with the exact query, a fixture for the same path and
+// page with no other query parameters is used.
+func (f *Fixtures) find(u *url.URL) (Fixture, bool) {
+	query := u.Query()
+	if fixture, ok := f.Responses[fixtureKey(u.Path, query)]; ok {
+		return fixture, true
+	}
+	page := url.Values{}
+	if p := query.Get("page"); len(p) > 0 && p != "1" {
+		page.Set("page", p)
+	}
+	fixture, ok := f.Responses[fixtureKey(u.Path, page)]
+	return fixture, ok
+}
+
+// stripExtensions removes the raw cache file extensions from the end of a name, returning the name and extensions
+func stripExtensions(name string) (string, string) {
+	ext := ""
+	for {
+		found := false
+		for _, e := range canvas.FileAssociations {
+			if strings.HasSuffix(name, e) && len(name) > len(e) {
+				name = strings.TrimSuffix(name, e)
+				ext = e + ext
+				found = true
+				break
+			}
+		}
+		if !found {
+			return name, ext
+		}
+	}
+}
+
+// LoadFixtures reads fixtures from a folder laid out like the raw cache, for example one written by a Recorder
+func LoadFixtures(dir string) (*Fixtures, error) {
+	base, err := ioutil.ReadFile(path.Join(dir, BaseURLFile))
+	if err != nil {
+		return nil, err
+	}
+	f := CreateFixtures(strings.TrimSpace(string(base)))
+	headers := map[string]http.Header{}
+	statuses := map[string]int{}
+	if err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || path.Dir(p) == path.Clean(dir) {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path.Dir(p))
+		if err != nil {
+			return err
+		}
+		endpoint, ext := stripExtensions(filepath.ToSlash(rel))
+		if !strings.HasSuffix(info.Name(), ext) {
+			return nil
+		}
+		query := url.Values{}
+		if q := strings.TrimSuffix(info.Name(), ext); q != "default" {
+			raw, err := url.PathUnescape(q)
+			if err != nil {
+				return err
+			}
+			if query, err = url.ParseQuery(raw); err != nil {
+				return err
+			}
+		}
+		key := fixtureKey(endpoint, query)
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.HasSuffix(ext, canvas.FileAssociations[canvas.ResponseType]):
+			res, err := http.ReadResponse(bufio.NewReader(strings.NewReader(string(data))), nil)
+			if err != nil {
+				return err
+			}
+			res.Body.Close()
+			headers[key] = res.Header
+			statuses[key] = res.StatusCode
+		case strings.HasSuffix(ext, canvas.FileAssociations[canvas.RedirectType]):
+			f.AddRedirect(key, string(data))
+		default:
+			f.Add(key, Fixture{
+				Body: data,
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for key, header := range headers {
+		if fixture, ok := f.Responses[key]; ok {
+			fixture.Header = header
+			fixture.Status = statuses[key]
+			f.Responses[key] = fixture
+		}
+	}
+	return f, nil
+}
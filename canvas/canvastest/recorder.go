@@ -0,0 +1,110 @@
+package canvastest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+)
+
+// Recorder is an HTTP transport that saves every response from a Canvas instance as a fixture that LoadFixtures can
+// read
+type Recorder struct {
+	dir       string
+	baseURL   *url.URL
+	transport http.RoundTripper
+	mutex     sync.Mutex
+}
+
+// CreateRecorder creates a recorder that saves the responses from the Canvas instance at baseURL into dir, sending
+// the requests through transport (or the default transport if it is nil)
+func CreateRecorder(dir string, baseURL string, transport http.RoundTripper) (*Recorder, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(path.Join(dir, BaseURLFile), []byte(fmt.Sprintf("%s://%s/\n", u.Scheme, u.Host)), 0644); err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		dir:       dir,
+		baseURL:   u,
+		transport: transport,
+	}, nil
+}
+
+// RoundTrip sends a request and records the response if it came from the Canvas instance.  Responses to resumed
+// downloads and 304 Not Modified responses are not recorded, since they only make sense for the request that got them.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil || req.URL.Host != r.baseURL.Host || req.Header.Get("Range") != "" || res.StatusCode == http.StatusNotModified {
+		return res, err
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err = r.save(req, res, body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// location gets the file a response is saved to, using the same layout as the raw cache
+func (r *Recorder) location(u *url.URL, accept string) string {
+	ext := canvas.MimeToExt(accept)
+	name := "default"
+	if len(u.RawQuery) > 0 {
+		name = url.PathEscape(u.RawQuery)
+	}
+	return path.Join(r.dir, fmt.Sprintf("%s%s", u.EscapedPath(), ext), fmt.Sprintf("%s%s", name, ext))
+}
+
+func (r *Recorder) write(filename string, data []byte) error {
+	if err := os.MkdirAll(path.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0644)
+}
+
+func (r *Recorder) save(req *http.Request, res *http.Response, body []byte) error {
+	accept := strings.Split(req.Header.Get("Accept"), ",")[0]
+	if res.StatusCode >= 300 && res.StatusCode < 400 {
+		loc, err := req.URL.Parse(res.Header.Get("Location"))
+		if err != nil {
+			return err
+		}
+		return r.write(r.location(req.URL, fmt.Sprintf("%s+%s", accept, canvas.RedirectType)), []byte(loc.String()))
+	}
+	if err := r.write(r.location(req.URL, accept), body); err != nil {
+		return err
+	}
+	headers := &bytes.Buffer{}
+	fmt.Fprintf(headers, "%s %s\r\n", res.Proto, res.Status)
+	if err := res.Header.WriteSubset(headers, map[string]bool{
+		"Set-Cookie":        true,
+		"Content-Length":    true,
+		"Transfer-Encoding": true,
+	}); err != nil {
+		return err
+	}
+	fmt.Fprint(headers, "\r\n")
+	return r.write(r.location(req.URL, fmt.Sprintf("%s+%s", accept, canvas.ResponseType)), headers.Bytes())
+}
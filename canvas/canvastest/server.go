@@ -0,0 +1,102 @@
+package canvastest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+)
+
+// Token is the access token the fake server accepts
+const Token = "canvastest-token"
+
+// Server is a fake Canvas instance that serves fixtures over HTTP
+type Server struct {
+	*httptest.Server
+	Fixtures      *Fixtures
+	requests      []string
+	requestsMutex sync.Mutex
+}
+
+// CreateServer starts a fake Canvas server for a set of fixtures.  The server must be closed when it is no longer
+// needed.
+func CreateServer(fixtures *Fixtures) *Server {
+	s := &Server{
+		Fixtures: fixtures,
+		requests: []string{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// GetBaseURL of the fake server, which can be used as the base URL of a Canvas object
+func (s *Server) GetBaseURL() string {
+	return fmt.Sprintf("%s/", s.URL)
+}
+
+// CreateCanvas creates a Canvas object that talks to the fake server
+func (s *Server) CreateCanvas(rawSaveFolder string) (*canvas.Canvas, error) {
+	c, err := canvas.CreateCanvas(s.GetBaseURL(), Token, rawSaveFolder)
+	if err != nil {
+		return nil, err
+	}
+	c.SetTransport(s.Client().Transport)
+	c.RetryPolicy.MaxAttempts = 1
+	return c, nil
+}
+
+// Requests gets the path and query of every request the server has received, in order
+func (s *Server) Requests() []string {
+	s.requestsMutex.Lock()
+	defer s.requestsMutex.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// rewrite replaces the base URL of the fixtures with the URL of the fake server
+func (s *Server) rewrite(str string) string {
+	if len(s.Fixtures.BaseURL) == 0 {
+		return str
+	}
+	return strings.ReplaceAll(str, strings.TrimSuffix(s.Fixtures.BaseURL, "/"), s.URL)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requestsMutex.Lock()
+	s.requests = append(s.requests, r.URL.RequestURI())
+	s.requestsMutex.Unlock()
+	if strings.HasPrefix(r.URL.Path, "/api/") && r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", Token) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errors":[{"message":"Invalid access token."}]}`)
+		return
+	}
+	fixture, ok := s.Fixtures.find(r.URL)
+	if !ok {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[{"message":"The specified resource does not exist."}]}`)
+		return
+	}
+	for k, v := range fixture.Header {
+		if k == "Content-Length" {
+			continue
+		}
+		for _, value := range v {
+			w.Header().Add(k, s.rewrite(value))
+		}
+	}
+	w.Header().Set("X-Rate-Limit-Remaining", "700.0")
+	body := []byte(s.rewrite(string(fixture.Body)))
+	if fixture.Status != http.StatusOK {
+		w.WriteHeader(fixture.Status)
+		w.Write(body)
+		return
+	}
+	// ServeContent handles conditional and range requests the way Canvas's file storage does
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(body))
+}
@@ -0,0 +1,39 @@
+package canvas
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// cachedForValidation reads a successful response that has an ETag and its body from the raw cache, so that the
+// request can be made conditional on the response having changed.  It returns nil if there is no such response.
+func (c *Canvas) cachedForValidation(u url.URL, accept string) ([]byte, *http.Response, error) {
+	res, err := c.readResponse(u, accept)
+	if err != nil || res == nil {
+		return nil, nil, err
+	}
+	if res.StatusCode != http.StatusOK || len(res.Header.Get("ETag")) == 0 {
+		return nil, nil, nil
+	}
+	body, err := ioutil.ReadFile(c.getRawCacheLocation(u, accept))
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return body, res, nil
+}
+
+// notModified updates a cached response with the headers of a 304 Not Modified response to it, so the cached body can
+// be used in place of the response.  As with any HTTP cache, the stored Content-Length is kept.
+func notModified(cached *http.Response, res *http.Response) *http.Response {
+	for k, v := range res.Header {
+		if k != "Content-Length" {
+			cached.Header[k] = v
+		}
+	}
+	cached.Request = res.Request
+	return cached
+}
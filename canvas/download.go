@@ -0,0 +1,237 @@
+package canvas
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// IncompleteDownloadError is an error when the connection closes before the whole body of a response is received
+type IncompleteDownloadError struct {
+	URL      string
+	Expected int64
+	Received int64
+}
+
+func (err IncompleteDownloadError) Error() string {
+	return fmt.Sprintf("Incomplete download from URL %s: received %d of %d bytes", err.URL, err.Received, err.Expected)
+}
+
+// ResponseTimeoutError is an error when the response headers are not received within the request timeout
+type ResponseTimeoutError struct {
+	URL string
+}
+
+func (err ResponseTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for a response from URL %s", err.URL)
+}
+
+// Timeout is always true, so the request is retried like other timeouts
+func (err ResponseTimeoutError) Timeout() bool {
+	return true
+}
+
+// Temporary is always true, so the request is retried like other timeouts
+func (err ResponseTimeoutError) Temporary() bool {
+	return true
+}
+
+// isBinaryType determines if a MIME type has no file association, so it is most likely a file and not an API response
+func isBinaryType(accept string) bool {
+	_, ok := FileAssociations[strings.Split(accept, "+")[0]]
+	return !ok
+}
+
+// Download performs a raw HTTP request and streams the response into a file.  The response is first written to a
+// partial file in the same folder, which is renamed into place once its size matches the Content-Length, so the file
+// is never left half written.  If the download is interrupted, the partial file is kept and the next download of the
+// same file resumes it.  Transient failures are retried according to the retry policy, and each retry is added
+// to the progress, which may be nil.  Responses with binary content are only saved in the raw cache if RawCacheBinary
+// is set.
+func (c *Canvas) Download(ctx context.Context, url string, accept string, filename string, progress *task.Progress) (*http.Response, error) {
+	return c.download(ctx, url, accept, filename, 10, progress)
+}
+
+func (c *Canvas) download(ctx context.Context, url string, accept string, filename string, allowedRedirects int, progress *task.Progress) (*http.Response, error) {
+	req, err := c.newRequest(http.MethodGet, url, accept, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Offline {
+		return c.downloadCached(*req.URL, accept, filename)
+	}
+	var res *http.Response
+	if err = c.withRetries(ctx, progress, isRetryable, func() (*http.Response, error) {
+		release, err := c.acquireConnection(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		res, err = c.sendDownload(ctx, req, filename)
+		return res, err
+	}); err != nil {
+		return nil, err
+	}
+	loc, err := getRedirect(res, allowedRedirects)
+	if err != nil {
+		return nil, err
+	}
+	if len(loc) > 0 {
+		res, err := c.download(ctx, loc, accept, filename, allowedRedirects-1, progress)
+		if err == nil {
+			err = c.saveRequest(*req.URL, fmt.Sprintf("%s+%s", accept, RedirectType), []byte(loc), nil)
+		}
+		return res, err
+	}
+	if c.RawCacheBinary || !isBinaryType(accept) {
+		err = c.saveRequestFile(*req.URL, accept, filename, res)
+	}
+	return res, err
+}
+
+// sendDownload sends a request once and writes the response body into a partial file, which is renamed into place once
+// it is complete.  If a partial file was left by an earlier attempt, the server is asked to only send the rest of the
+// file if it has not changed since.  The request is abandoned if the response headers are not received within the
+// request timeout.
+func (c *Canvas) sendDownload(parent context.Context, req *http.Request, filename string) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	part, metaFile := filename+PartExtension, filename+PartExtension+PartMetaExtension
+	r := req.Clone(ctx)
+	var offset int64
+	if validator := readPartialDownload(metaFile).validator(); len(validator) > 0 {
+		if info, err := os.Stat(part); err == nil && info.Size() > 0 {
+			offset = info.Size()
+			r.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+			r.Header.Set("If-Range", validator)
+		}
+	}
+	var timer *time.Timer
+	if c.RequestTimeout > 0 {
+		timer = time.AfterFunc(c.RequestTimeout, cancel)
+	}
+	res, err := c.openRequest(ctx, r)
+	if timer != nil && !timer.Stop() && err != nil && parent.Err() == nil {
+		// The timer already cancelled the request
+		return res, ResponseTimeoutError{
+			URL: req.URL.String(),
+		}
+	}
+	if e, ok := err.(InvalidStatusCodeError); ok && e.Code == http.StatusRequestedRangeNotSatisfiable && offset > 0 {
+		// The partial file is longer than the file on the server, so it must be out of date
+		if err = discardPartialDownload(filename); err != nil {
+			return res, err
+		}
+		return c.sendDownload(parent, req, filename)
+	}
+	if err != nil {
+		return res, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return res, nil
+	}
+	var f *os.File
+	if res.StatusCode == http.StatusPartialContent && offset > 0 {
+		if start, ok := parseContentRange(res.Header.Get("Content-Range")); !ok || start != offset {
+			// The rest of the file cannot be trusted, so download all of it again
+			res.Body.Close()
+			if err = discardPartialDownload(filename); err != nil {
+				return res, err
+			}
+			return c.sendDownload(parent, req, filename)
+		}
+		if f, err = os.OpenFile(part, os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+			return res, err
+		}
+	} else {
+		// Either there was nothing to resume, the file changed, or the server does not support resuming downloads
+		offset = 0
+		if err = writePartialDownload(metaFile, res); err != nil {
+			return res, err
+		}
+		if f, err = os.OpenFile(part, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
+			return res, err
+		}
+	}
+	n, err := io.Copy(f, res.Body)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return res, err
+	}
+	if res.ContentLength >= 0 && n != res.ContentLength {
+		return res, IncompleteDownloadError{
+			URL:      req.URL.String(),
+			Expected: offset + res.ContentLength,
+			Received: offset + n,
+		}
+	}
+	if err = os.Rename(part, filename); err != nil {
+		return res, err
+	}
+	if err = os.Remove(metaFile); err != nil && !os.IsNotExist(err) {
+		return res, err
+	}
+	return res, nil
+}
+
+// writeFileAtomic writes a file by writing to a temporary file in the same folder and renaming it into place if the
+// write succeeds
+func writeFileAtomic(filename string, write func(io.Writer) error) error {
+	tmp, err := ioutil.TempFile(path.Dir(filename), fmt.Sprintf(".%s.*.tmp", path.Base(filename)))
+	if err != nil {
+		return err
+	}
+	if err = write(tmp); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), filename); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// saveRequestFile copies a downloaded file into the raw cache along with the status line and headers of the response
+func (c *Canvas) saveRequestFile(u url.URL, accept string, filename string, res *http.Response) error {
+	fname := c.getRawCacheLocation(u, accept)
+	if len(fname) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(path.Dir(fname), 0755); err != nil {
+		return err
+	}
+	src, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if err = writeFileAtomic(fname, func(w io.Writer) error {
+		_, err := io.Copy(w, src)
+		return err
+	}); err != nil {
+		return err
+	}
+	return c.saveResponse(u, accept, res)
+}
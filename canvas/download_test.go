@@ -0,0 +1,212 @@
+package canvas
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testETag = `"v1"`
+
+var testContent = bytes.Repeat([]byte("0123456789abcdef"), 64)
+
+// downloadTest is a server that serves a single file, along with a folder to download it into
+type downloadTest struct {
+	t        *testing.T
+	server   *httptest.Server
+	canvas   *Canvas
+	filename string
+	requests []http.Header
+	mutex    sync.Mutex
+}
+
+// createDownloadTest starts a server that passes each request to handle along with its number, starting at 0
+func createDownloadTest(t *testing.T, handle func(n int, w http.ResponseWriter, r *http.Request)) *downloadTest {
+	dir, err := ioutil.TempDir("", "canvas-download-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &downloadTest{
+		t:        t,
+		filename: path.Join(dir, "file.bin"),
+		requests: []http.Header{},
+	}
+	d.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.mutex.Lock()
+		n := len(d.requests)
+		d.requests = append(d.requests, r.Header.Clone())
+		d.mutex.Unlock()
+		handle(n, w, r)
+	}))
+	d.canvas, err = CreateCanvas("https://canvas.example.com/", "token", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.canvas.RetryPolicy = RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Multiplier:     1,
+	}
+	return d
+}
+
+func (d *downloadTest) close() {
+	d.server.Close()
+	os.RemoveAll(path.Dir(d.filename))
+}
+
+// writePartial leaves a partial file from an earlier attempt to download a version of the file with an ETag
+func (d *downloadTest) writePartial(data []byte, etag string) {
+	if err := ioutil.WriteFile(d.filename+PartExtension, data, 0644); err != nil {
+		d.t.Fatal(err)
+	}
+	meta := fmt.Sprintf(`{"etag":%q}`, etag)
+	if err := ioutil.WriteFile(d.filename+PartExtension+PartMetaExtension, []byte(meta), 0644); err != nil {
+		d.t.Fatal(err)
+	}
+}
+
+func (d *downloadTest) download() error {
+	_, err := d.canvas.Download(context.Background(), d.server.URL+"/file.bin", "application/octet-stream", d.filename, nil)
+	return err
+}
+
+// check that the downloaded file is complete and that the partial file was cleaned up
+func (d *downloadTest) check() {
+	data, err := ioutil.ReadFile(d.filename)
+	if err != nil {
+		d.t.Fatal(err)
+	}
+	if !bytes.Equal(data, testContent) {
+		d.t.Errorf("Downloaded %d bytes that do not match the %d byte file", len(data), len(testContent))
+	}
+	for _, f := range []string{d.filename + PartExtension, d.filename + PartExtension + PartMetaExtension} {
+		if _, err := os.Stat(f); !os.IsNotExist(err) {
+			d.t.Errorf("Partial download %s was not removed", f)
+		}
+	}
+}
+
+// checkRequests checks the number of requests and the Range header each one had
+func (d *downloadTest) checkRequests(ranges ...string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if len(d.requests) != len(ranges) {
+		d.t.Fatalf("Expected %d requests, but got %d", len(ranges), len(d.requests))
+	}
+	for i, r := range ranges {
+		if actual := d.requests[i].Get("Range"); actual != r {
+			d.t.Errorf("Expected request %d to have Range %q, but got %q", i, r, actual)
+		}
+	}
+}
+
+// serveFile serves the test content with ServeContent, which handles Range and If-Range
+func serveFile(etag string) func(n int, w http.ResponseWriter, r *http.Request) {
+	return func(n int, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		http.ServeContent(w, r, "file.bin", time.Time{}, bytes.NewReader(testContent))
+	}
+}
+
+func TestDownloadResume(t *testing.T) {
+	d := createDownloadTest(t, serveFile(testETag))
+	defer d.close()
+	d.writePartial(testContent[:100], testETag)
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests("bytes=100-")
+	if ifRange := d.requests[0].Get("If-Range"); ifRange != testETag {
+		t.Errorf("Expected If-Range %q, but got %q", testETag, ifRange)
+	}
+}
+
+func TestDownloadChangedFile(t *testing.T) {
+	d := createDownloadTest(t, serveFile(testETag))
+	defer d.close()
+	d.writePartial([]byte("an older version of the file"), `"v0"`)
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests("bytes=28-")
+}
+
+func TestDownloadRangeNotSupported(t *testing.T) {
+	d := createDownloadTest(t, func(n int, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Content-Length", fmt.Sprint(len(testContent)))
+		w.Write(testContent)
+	})
+	defer d.close()
+	d.writePartial(testContent[:100], testETag)
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests("bytes=100-")
+}
+
+func TestDownloadRangeNotSatisfiable(t *testing.T) {
+	d := createDownloadTest(t, serveFile(testETag))
+	defer d.close()
+	d.writePartial(append(append([]byte{}, testContent...), "more"...), testETag)
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests(fmt.Sprintf("bytes=%d-", len(testContent)+4), "")
+}
+
+func TestDownloadWrongOffset(t *testing.T) {
+	d := createDownloadTest(t, func(n int, w http.ResponseWriter, r *http.Request) {
+		if n > 0 {
+			serveFile(testETag)(n, w, r)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes 50-%d/%d", len(testContent)-1, len(testContent)))
+		w.Header().Set("Content-Length", fmt.Sprint(len(testContent)-50))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(testContent[50:])
+	})
+	defer d.close()
+	d.writePartial(testContent[:100], testETag)
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests("bytes=100-", "")
+}
+
+func TestDownloadTruncatedResume(t *testing.T) {
+	d := createDownloadTest(t, func(n int, w http.ResponseWriter, r *http.Request) {
+		if n > 0 {
+			serveFile(testETag)(n, w, r)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Content-Length", fmt.Sprint(len(testContent)))
+		w.Write(testContent[:300])
+		w.(http.Flusher).Flush()
+		// Close the connection before the whole body is sent
+		panic(http.ErrAbortHandler)
+	})
+	defer d.close()
+	if err := d.download(); err != nil {
+		t.Fatal(err)
+	}
+	d.check()
+	d.checkRequests("", "bytes=300-")
+}
@@ -16,10 +16,12 @@ var FileAssociations = map[string]string{
 	"application/json": ".json",
 	"text/html":        ".html",
 	RedirectType:       ".url",
+	ResponseType:       ".http",
 	DefaultType:        ".bin",
 }
 
-func mimeToExt(mime string) string {
+// MimeToExt gets the file extension used in the raw cache for a MIME type, which may be several types joined by "+"
+func MimeToExt(mime string) string {
 	mimeParts := strings.Split(mime, "+")
 	extParts := make([]string, len(mimeParts))
 	for i, p := range mimeParts {
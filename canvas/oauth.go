@@ -0,0 +1,323 @@
+package canvas
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultOAuthRedirectURL is where the browser is sent after the user authorizes the application, unless the developer
+// key needs a different one.  Since it has no port, a free port is picked each time.
+const DefaultOAuthRedirectURL = "http://127.0.0.1/oauth/callback"
+
+// oauthRefreshMargin is how long before an access token expires that it is refreshed
+const oauthRefreshMargin = time.Minute
+
+// OAuthConfig identifies the developer key used to authenticate with OAuth2
+type OAuthConfig struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	// RedirectURL must be a loopback address registered with the developer key.  If it has no port, any port is used.
+	RedirectURL string `json:"redirect_url"`
+}
+
+// OAuthToken is an access token from OAuth2, and the refresh token that gets a new one after it expires
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// OAuthError is an error from the OAuth2 endpoints, like when the user denies access or a refresh token is revoked
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (err OAuthError) Error() string {
+	if len(err.Description) > 0 {
+		return fmt.Sprintf("OAuth2 error %s: %s", err.Code, err.Description)
+	}
+	return fmt.Sprintf("OAuth2 error %s", err.Code)
+}
+
+type oauthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// SetOAuth authenticates with an OAuth2 token instead of a personal access token.  The access token is refreshed when
+// it is about to expire or Canvas rejects it, and onRefresh is called with each new token so it can be saved.
+func (c *Canvas) SetOAuth(config OAuthConfig, token OAuthToken, onRefresh func(OAuthToken) error) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.oauth = &config
+	c.oauthToken = token
+	c.token = token.AccessToken
+	c.onTokenRefresh = onRefresh
+}
+
+// GetOAuthToken gets the current OAuth2 token
+func (c *Canvas) GetOAuthToken() OAuthToken {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	return c.oauthToken
+}
+
+// getToken gets the token to authenticate with and whether it needs to be refreshed first
+func (c *Canvas) getToken() (string, bool) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	expiring := c.oauth != nil && !c.oauthToken.ExpiresAt.IsZero() && time.Until(c.oauthToken.ExpiresAt) < oauthRefreshMargin
+	return c.token, expiring
+}
+
+// isOAuthEndpoint determines if a URL is one of the OAuth2 endpoints, which never take an access token
+func (c *Canvas) isOAuthEndpoint(u *url.URL) bool {
+	return strings.HasPrefix(u.Path, fmt.Sprintf("%slogin/oauth2/", c.baseURL.Path))
+}
+
+// canRefresh determines if a request that was rejected as unauthorized can be sent again with a new access token
+func (c *Canvas) canRefresh(req *http.Request) bool {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	return c.oauth != nil && len(c.oauthToken.RefreshToken) > 0 && c.isCanvasURL(req.URL) && !c.isOAuthEndpoint(req.URL)
+}
+
+// authorize adds the access token to a request to Canvas, refreshing it first if it is about to expire.  It returns
+// the token that was added.
+func (c *Canvas) authorize(ctx context.Context, req *http.Request) (string, error) {
+	if !c.isCanvasURL(req.URL) || c.isOAuthEndpoint(req.URL) {
+		return "", nil
+	}
+	token, expiring := c.getToken()
+	if expiring && c.canRefresh(req) {
+		if err := c.refreshOAuth(ctx, token); err != nil {
+			return "", err
+		}
+		token, _ = c.getToken()
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return token, nil
+}
+
+// refreshOAuth gets a new access token to replace a stale one.  If another request already replaced it, nothing is
+// done, so requests that are rejected at the same time only refresh it once.
+func (c *Canvas) refreshOAuth(ctx context.Context, stale string) error {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	c.tokenMutex.Lock()
+	if c.token != stale {
+		c.tokenMutex.Unlock()
+		return nil
+	}
+	config, token := *c.oauth, c.oauthToken
+	c.tokenMutex.Unlock()
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("client_id", config.ClientID)
+	form.Set("client_secret", config.ClientSecret)
+	form.Set("refresh_token", token.RefreshToken)
+	res, err := c.requestOAuthToken(ctx, form)
+	if err != nil {
+		return err
+	}
+	token.AccessToken = res.AccessToken
+	if len(res.RefreshToken) > 0 {
+		token.RefreshToken = res.RefreshToken
+	}
+	token.ExpiresAt = expiresAt(res.ExpiresIn)
+	c.tokenMutex.Lock()
+	c.oauthToken = token
+	c.token = token.AccessToken
+	onRefresh := c.onTokenRefresh
+	c.tokenMutex.Unlock()
+	if onRefresh != nil {
+		return onRefresh(token)
+	}
+	return nil
+}
+
+// expiresAt gets when a token that expires in a number of seconds expires, or the zero time if it never does
+func expiresAt(expiresIn int) time.Time {
+	if expiresIn <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
+
+// requestOAuthToken sends a form to the OAuth2 token endpoint.  Tokens are refreshed by requests that already hold a
+// connection, so this does not wait for another one, which could wait forever if every connection needs a new token.
+func (c *Canvas) requestOAuthToken(ctx context.Context, form url.Values) (*oauthTokenResponse, error) {
+	req, err := c.newRequest(http.MethodPost, fmt.Sprintf("%slogin/oauth2/token", c.GetBaseURL()), "application/json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", FormType)
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
+	}
+	var body []byte
+	err = c.withRetries(ctx, nil, isRefused, func() (*http.Response, error) {
+		r := req.Clone(ctx)
+		if r.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+		res, err := c.sendOnce(ctx, r)
+		if err != nil {
+			return res, err
+		}
+		defer res.Body.Close()
+		body, err = ioutil.ReadAll(res.Body)
+		return res, err
+	})
+	if e, ok := err.(InvalidStatusCodeError); ok {
+		oauthErr := OAuthError{}
+		if json.Unmarshal([]byte(e.Body), &oauthErr) == nil && len(oauthErr.Code) > 0 {
+			return nil, oauthErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &oauthTokenResponse{}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	if len(res.AccessToken) == 0 {
+		return nil, errors.New("Canvas did not send an access token")
+	}
+	return res, nil
+}
+
+// listenLoopback starts listening on the loopback address of a redirect URL, returning the redirect URL with the port
+// that was picked
+func listenLoopback(redirectURL string) (net.Listener, *url.URL, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.Scheme != "http" {
+		return nil, nil, fmt.Errorf("OAuth2 redirect URL %s must use http", redirectURL)
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, nil, fmt.Errorf("OAuth2 redirect URL %s is not a loopback address", redirectURL)
+	}
+	port := u.Port()
+	if len(port) == 0 {
+		port = "0"
+	}
+	l, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, nil, err
+	}
+	u.Host = net.JoinHostPort(host, fmt.Sprint(l.Addr().(*net.TCPAddr).Port))
+	if len(u.Path) == 0 {
+		u.Path = "/"
+	}
+	return l, u, nil
+}
+
+// AuthorizeOAuth authenticates the user with the OAuth2 authorization code flow.  prompt is called with the URL the
+// user needs to open in their browser to let the application access their account, and Canvas then sends the browser
+// back to a server listening on the loopback address.  Once the user has authorized the application, the code is
+// exchanged for a token, which is used for every request after.
+func (c *Canvas) AuthorizeOAuth(ctx context.Context, config OAuthConfig, prompt func(authURL string)) (OAuthToken, error) {
+	if c.Offline {
+		return OAuthToken{}, errors.New("Cannot log in to Canvas in offline mode")
+	}
+	if len(config.RedirectURL) == 0 {
+		config.RedirectURL = DefaultOAuthRedirectURL
+	}
+	l, redirect, err := listenLoopback(config.RedirectURL)
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	state := make([]byte, 16)
+	if _, err = rand.Read(state); err != nil {
+		l.Close()
+		return OAuthToken{}, err
+	}
+	query := url.Values{}
+	query.Set("client_id", config.ClientID)
+	query.Set("response_type", "code")
+	query.Set("redirect_uri", redirect.String())
+	query.Set("state", hex.EncodeToString(state))
+	codes := make(chan string, 1)
+	errs := make(chan error, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != redirect.Path {
+				http.NotFound(w, r)
+				return
+			}
+			q := r.URL.Query()
+			if q.Get("state") != query.Get("state") {
+				http.Error(w, "The login request did not come from canvas-sync.", http.StatusBadRequest)
+				return
+			}
+			if e := q.Get("error"); len(e) > 0 {
+				http.Error(w, "canvas-sync was not given access to your account.", http.StatusForbidden)
+				select {
+				case errs <- OAuthError{
+					Code:        e,
+					Description: q.Get("error_description"),
+				}:
+				default:
+				}
+				return
+			}
+			fmt.Fprint(w, "canvas-sync now has access to your account. You can close this window.")
+			select {
+			case codes <- q.Get("code"):
+			default:
+			}
+		}),
+	}
+	go server.Serve(l)
+	defer server.Close()
+	prompt(fmt.Sprintf("%slogin/oauth2/auth?%s", c.GetBaseURL(), query.Encode()))
+	var code string
+	select {
+	case code = <-codes:
+		break
+	case err = <-errs:
+		return OAuthToken{}, err
+	case <-ctx.Done():
+		return OAuthToken{}, ctx.Err()
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("client_id", config.ClientID)
+	form.Set("client_secret", config.ClientSecret)
+	form.Set("redirect_uri", redirect.String())
+	form.Set("code", code)
+	res, err := c.requestOAuthToken(ctx, form)
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	token := OAuthToken{
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
+		ExpiresAt:    expiresAt(res.ExpiresIn),
+	}
+	c.tokenMutex.Lock()
+	c.oauth = &config
+	c.oauthToken = token
+	c.token = token.AccessToken
+	c.tokenMutex.Unlock()
+	return token, nil
+}
@@ -0,0 +1,278 @@
+package canvas
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testOAuthConfig = OAuthConfig{
+	ClientID:     "10000000000001",
+	ClientSecret: "secret",
+}
+
+// oauthTest is a server with the OAuth2 token endpoint and an API endpoint that only accepts the latest access token
+type oauthTest struct {
+	t         *testing.T
+	server    *httptest.Server
+	canvas    *Canvas
+	dir       string
+	mutex     sync.Mutex
+	token     string
+	refreshes int
+	requests  int
+	// refreshed gets each token passed to the refresh callback
+	refreshed []OAuthToken
+}
+
+func createOAuthTest(t *testing.T, token OAuthToken) *oauthTest {
+	dir, err := ioutil.TempDir("", "canvas-oauth-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := &oauthTest{
+		t:     t,
+		dir:   dir,
+		token: "access-1",
+	}
+	o.server = httptest.NewServer(http.HandlerFunc(o.serveHTTP))
+	if o.canvas, err = CreateCanvas(o.server.URL+"/", "", dir); err != nil {
+		t.Fatal(err)
+	}
+	o.canvas.RetryPolicy = testRetryPolicy
+	o.canvas.SetOAuth(testOAuthConfig, token, func(token OAuthToken) error {
+		o.mutex.Lock()
+		defer o.mutex.Unlock()
+		o.refreshed = append(o.refreshed, token)
+		return nil
+	})
+	return o
+}
+
+func (o *oauthTest) close() {
+	o.server.Close()
+	os.RemoveAll(o.dir)
+}
+
+func (o *oauthTest) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r.URL.Path == "/login/oauth2/token" {
+		if err := r.ParseForm(); err != nil {
+			o.t.Error(err)
+		}
+		if r.Form.Get("client_id") != testOAuthConfig.ClientID || r.Form.Get("client_secret") != testOAuthConfig.ClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client","error_description":"unknown client"}`)
+			return
+		}
+		switch r.Form.Get("grant_type") {
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_grant","error_description":"refresh_token not found"}`)
+				return
+			}
+		case "authorization_code":
+			if r.Form.Get("code") != "code" || len(r.Form.Get("redirect_uri")) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_grant","error_description":"authorization_code not found"}`)
+				return
+			}
+		}
+		// Give other requests a chance to be rejected while the token is refreshed
+		time.Sleep(10 * time.Millisecond)
+		o.mutex.Lock()
+		defer o.mutex.Unlock()
+		o.refreshes++
+		o.token = fmt.Sprintf("access-%d", o.refreshes+1)
+		fmt.Fprintf(w, `{"access_token":"%s","refresh_token":"refresh","expires_in":3600}`, o.token)
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.requests++
+	if r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", o.token) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errors":[{"message":"Invalid access token."}]}`)
+		return
+	}
+	fmt.Fprint(w, `{"id":1}`)
+}
+
+func (o *oauthTest) request() error {
+	_, _, err := o.canvas.RequestRaw(context.Background(), o.server.URL+"/api/v1/users/self", "application/json", 0, nil)
+	return err
+}
+
+// check checks how many times the token was refreshed and how many API requests were sent
+func (o *oauthTest) check(refreshes int, requests int) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.refreshes != refreshes {
+		o.t.Errorf("Expected %d refreshes, but got %d", refreshes, o.refreshes)
+	}
+	if o.requests != requests {
+		o.t.Errorf("Expected %d requests, but got %d", requests, o.requests)
+	}
+	if len(o.refreshed) != refreshes {
+		o.t.Errorf("Expected %d new tokens to be saved, but got %d", refreshes, len(o.refreshed))
+	}
+	if token := o.canvas.GetOAuthToken(); token.AccessToken != o.token || token.RefreshToken != "refresh" {
+		o.t.Errorf("Expected the access token %s, but got %+v", o.token, token)
+	}
+}
+
+func TestOAuthRefreshExpiring(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{
+		AccessToken:  "access-1",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(oauthRefreshMargin / 2),
+	})
+	defer o.close()
+	// The token is refreshed before the request is sent, so Canvas never rejects it
+	if err := o.request(); err != nil {
+		t.Fatal(err)
+	}
+	o.check(1, 1)
+	if expires := o.canvas.GetOAuthToken().ExpiresAt; time.Until(expires) < 59*time.Minute {
+		t.Errorf("Expected the new token to expire in an hour, but it expires at %v", expires)
+	}
+	if err := o.request(); err != nil {
+		t.Fatal(err)
+	}
+	o.check(1, 2)
+}
+
+func TestOAuthRefreshUnauthorized(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{
+		AccessToken:  "revoked",
+		RefreshToken: "refresh",
+	})
+	defer o.close()
+	if err := o.request(); err != nil {
+		t.Fatal(err)
+	}
+	o.check(1, 2)
+}
+
+func TestOAuthRefreshConcurrent(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{
+		AccessToken:  "revoked",
+		RefreshToken: "refresh",
+	})
+	defer o.close()
+	wg := sync.WaitGroup{}
+	errs := make(chan error, DefaultMaxConnections)
+	for i := 0; i < DefaultMaxConnections; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- o.request()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	o.mutex.Lock()
+	refreshes := o.refreshes
+	o.mutex.Unlock()
+	if refreshes != 1 {
+		t.Errorf("Expected the requests to share one refresh, but got %d", refreshes)
+	}
+}
+
+func TestOAuthRefreshRevoked(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{
+		AccessToken:  "revoked",
+		RefreshToken: "revoked",
+	})
+	defer o.close()
+	err := o.request()
+	if e, ok := err.(OAuthError); !ok || e.Code != "invalid_grant" {
+		t.Errorf("Expected an invalid_grant error, but got %v", err)
+	}
+	// The request is not sent again without a new token
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.requests != 1 || len(o.refreshed) != 0 {
+		t.Errorf("Expected 1 request and no new tokens, but got %d requests and %d new tokens", o.requests, len(o.refreshed))
+	}
+}
+
+// callback sends the browser back to the loopback server the way Canvas does, with the state from the authorization
+// URL unless another one is given
+func callback(t *testing.T, authURL string, state string, params url.Values) int {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state) == 0 {
+		state = u.Query().Get("state")
+	}
+	params.Set("state", state)
+	res, err := http.Get(fmt.Sprintf("%s?%s", u.Query().Get("redirect_uri"), params.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	return res.StatusCode
+}
+
+func TestAuthorizeOAuth(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{})
+	defer o.close()
+	config := testOAuthConfig
+	config.RedirectURL = "http://127.0.0.1/oauth/callback"
+	token, err := o.canvas.AuthorizeOAuth(context.Background(), config, func(authURL string) {
+		// A callback with the wrong state is ignored, so another site can't log in for the user
+		if code := callback(t, authURL, "forged", url.Values{"code": []string{"forged"}}); code != http.StatusBadRequest {
+			t.Errorf("Expected %d for the wrong state, but got %d", http.StatusBadRequest, code)
+		}
+		if code := callback(t, authURL, "", url.Values{"code": []string{"code"}}); code != http.StatusOK {
+			t.Errorf("Expected %d, but got %d", http.StatusOK, code)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-2" || token.RefreshToken != "refresh" {
+		t.Errorf("Expected the token from the code, but got %+v", token)
+	}
+	if err = o.request(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuthorizeOAuthDenied(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{})
+	defer o.close()
+	_, err := o.canvas.AuthorizeOAuth(context.Background(), testOAuthConfig, func(authURL string) {
+		if code := callback(t, authURL, "", url.Values{"error": []string{"access_denied"}}); code != http.StatusForbidden {
+			t.Errorf("Expected %d, but got %d", http.StatusForbidden, code)
+		}
+	})
+	if e, ok := err.(OAuthError); !ok || e.Code != "access_denied" {
+		t.Errorf("Expected an access_denied error, but got %v", err)
+	}
+}
+
+func TestAuthorizeOAuthTimeout(t *testing.T) {
+	o := createOAuthTest(t, OAuthToken{})
+	defer o.close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := o.canvas.AuthorizeOAuth(ctx, testOAuthConfig, func(string) {}); err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,153 @@
+package canvas
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// notCachedStatus is the status of the error returned for requests that were never saved in the raw cache
+const notCachedStatus = "404 Not Found in raw cache"
+
+// notCached creates the error returned when a request is made in offline mode that was never saved in the raw cache,
+// which tasks treat the same as the resource not existing
+func notCached(u url.URL) error {
+	return InvalidStatusCodeError{
+		URL:    u.String(),
+		Status: notCachedStatus,
+		Code:   http.StatusNotFound,
+	}
+}
+
+// IsNotCached determines if an error is from a request in offline mode that was never saved in the raw cache, like a
+// file download when binary responses are not cached
+func IsNotCached(err error) bool {
+	e, ok := err.(InvalidStatusCodeError)
+	return ok && e.Status == notCachedStatus
+}
+
+// requestCached serves a request from the raw cache instead of the network.  Redirect records are followed, and the
+// saved status and headers are replayed.  If the headers were not saved but the cache contains the next page of a
+// paginated response, a Link header pointing to it is added to the response.
+func (c *Canvas) requestCached(u url.URL, accept string, allowedRedirects int) ([]byte, *http.Response, error) {
+	fname := c.getRawCacheLocation(u, accept)
+	if len(fname) == 0 {
+		return nil, nil, notCached(u)
+	}
+	body, err := ioutil.ReadFile(fname)
+	if os.IsNotExist(err) {
+		loc, err := ioutil.ReadFile(c.getRawCacheLocation(u, fmt.Sprintf("%s+%s", accept, RedirectType)))
+		if os.IsNotExist(err) {
+			return nil, nil, notCached(u)
+		} else if err != nil {
+			return nil, nil, err
+		}
+		if allowedRedirects <= 0 {
+			return nil, nil, fmt.Errorf("Too many redirects")
+		}
+		next, err := u.Parse(string(loc))
+		if err != nil {
+			return nil, nil, err
+		}
+		return c.requestCached(*next, accept, allowedRedirects-1)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	res, err := c.readResponse(u, accept)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res != nil {
+		if res.StatusCode < 200 || res.StatusCode >= 400 {
+			return nil, nil, InvalidStatusCodeError{
+				URL:    u.String(),
+				Status: res.Status,
+				Code:   res.StatusCode,
+				Body:   string(body),
+			}
+		}
+		return body, res, nil
+	}
+	// Responses saved before headers were recorded are missing their Link header
+	res = &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Request: &http.Request{
+			Method: "GET",
+			URL:    &u,
+		},
+	}
+	if next, ok := c.findCachedNextPage(u, accept, path.Dir(fname)); ok {
+		res.Header.Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.String()))
+	}
+	return body, res, nil
+}
+
+// findCachedNextPage looks through the cached queries of an endpoint for the page after the one in a URL
+func (c *Canvas) findCachedNextPage(u url.URL, accept string, dir string) (*url.URL, bool) {
+	query := u.Query()
+	page := 1
+	if p := query.Get("page"); len(p) > 0 {
+		var err error
+		if page, err = strconv.Atoi(p); err != nil {
+			return nil, false
+		}
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, false
+	}
+	ext := MimeToExt(accept)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ext) {
+			continue
+		}
+		raw, err := url.PathUnescape(strings.TrimSuffix(f.Name(), ext))
+		if err != nil {
+			continue
+		}
+		candidate, err := url.ParseQuery(raw)
+		if err != nil || candidate.Get("page") != strconv.Itoa(page+1) || !samePageQuery(query, candidate) {
+			continue
+		}
+		next := u
+		next.RawQuery = raw
+		return &next, true
+	}
+	return nil, false
+}
+
+// samePageQuery determines if two queries are for pages of the same request
+func samePageQuery(a, b url.Values) bool {
+	for _, q := range [][2]url.Values{{a, b}, {b, a}} {
+		for k, v := range q[0] {
+			if k == "page" || k == "per_page" || len(strings.Join(v, "")) == 0 {
+				continue
+			}
+			if strings.Join(v, "\x00") != strings.Join(q[1][k], "\x00") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// downloadCached copies a file from the raw cache instead of downloading it.  Like a download, the file is written to a
+// temporary file first, so it is never left half written.
+func (c *Canvas) downloadCached(u url.URL, accept string, filename string) (*http.Response, error) {
+	body, res, err := c.requestCached(u, accept, 10)
+	if err != nil {
+		return nil, err
+	}
+	return res, writeFileAtomic(filename, func(w io.Writer) error {
+		_, err := w.Write(body)
+		return err
+	})
+}
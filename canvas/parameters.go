@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -95,5 +96,17 @@ func (c *Canvas) registerDefaultParameterTypes() error {
 	}, func(val interface{}) (string, error) {
 		return url.QueryEscape(reflect.ValueOf(val).String()), nil
 	})
+	c.RegisterParameterType4(reflect.TypeOf(false), func(val interface{}) (string, error) {
+		return strconv.FormatBool(val.(bool)), nil
+	})
+	c.RegisterParameterType3(func(t reflect.Type) (bool, error) {
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true, nil
+		}
+		return false, nil
+	}, func(val interface{}) (string, error) {
+		return strconv.FormatInt(reflect.ValueOf(val).Int(), 10), nil
+	})
 	return nil
 }
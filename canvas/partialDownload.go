@@ -0,0 +1,84 @@
+package canvas
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PartExtension is added to the name of a file while it is being downloaded
+	PartExtension = ".part"
+	// PartMetaExtension is added to the name of a partial file for the record used to resume downloading it
+	PartMetaExtension = ".syncmeta"
+)
+
+// partialDownload is the record kept next to a partial file of the version of the file that was being downloaded
+type partialDownload struct {
+	ETag         string `json:"etag"`
+	LastModified string `json:"last_modified"`
+}
+
+func readPartialDownload(metaFile string) partialDownload {
+	p := partialDownload{}
+	if data, err := ioutil.ReadFile(metaFile); err == nil {
+		json.Unmarshal(data, &p)
+	}
+	return p
+}
+
+func writePartialDownload(metaFile string, res *http.Response) error {
+	p := partialDownload{
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}
+	if len(p.validator()) == 0 {
+		// There is no way to know if the file changes, so it cannot be resumed
+		if err := os.Remove(metaFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(metaFile, data, 0644)
+}
+
+// validator gets the value for the If-Range header to resume the download with, or an empty string if it cannot be
+// resumed.  Weak ETags cannot be used with If-Range.
+func (p partialDownload) validator() string {
+	if len(p.ETag) > 0 && !strings.HasPrefix(p.ETag, "W/") {
+		return p.ETag
+	}
+	return p.LastModified
+}
+
+func discardPartialDownload(filename string) error {
+	for _, f := range []string{filename + PartExtension, filename + PartExtension + PartMetaExtension} {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseContentRange gets the first byte position from a Content-Range header, like "bytes 100-199/200"
+func parseContentRange(header string) (int64, bool) {
+	if !strings.HasPrefix(header, "bytes ") {
+		return 0, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(header, "bytes "), "-", 2)
+	if len(parts) != 2 {
+		return 0, false
+	}
+	start, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return start, true
+}
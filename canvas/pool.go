@@ -0,0 +1,79 @@
+package canvas
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// DefaultMaxConnections is the number of requests that may be made to Canvas at the same time by default
+const DefaultMaxConnections = 4
+
+// SetMaxConnections sets the number of requests that may be made at the same time by all of the tasks sharing this
+// Canvas object.  It must be called before any requests are made.
+func (c *Canvas) SetMaxConnections(n int) {
+	if n < 1 {
+		n = 1
+	}
+	c.connections = make(chan struct{}, n)
+}
+
+// GetMaxConnections gets the number of requests that may be made at the same time
+func (c *Canvas) GetMaxConnections() int {
+	return cap(c.connections)
+}
+
+// acquireConnection waits until fewer than the maximum number of requests are being made, returning a function that
+// must be called once the response has been read
+func (c *Canvas) acquireConnection(ctx context.Context) (func(), error) {
+	select {
+	case c.connections <- struct{}{}:
+		return func() {
+			<-c.connections
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// RunParallel calls fn for each index from 0 to count, running as many at the same time as there are connections.  It
+// returns the errors fn returned, in order, with nil for each call that succeeded, and a task.PanicError for each call
+// that panicked.  Requests made by fn still wait for a connection, so the pool only keeps the connections busy and
+// never adds to them.
+func (c *Canvas) RunParallel(count int, fn func(i int) error) []error {
+	errs := make([]error, count)
+	indices := make(chan int)
+	wg := sync.WaitGroup{}
+	workers := c.GetMaxConnections()
+	if workers > count {
+		workers = count
+	}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				errs[i] = runRecovered(i, fn)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return errs
+}
+
+// runRecovered calls fn, turning a panic into an error the same way a task does
+func runRecovered(i int, fn func(i int) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = task.PanicError{
+				Value: r,
+			}
+		}
+	}()
+	return fn(i)
+}
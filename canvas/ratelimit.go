@@ -1,56 +1,214 @@
 package canvas
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"time"
 )
 
+// Canvas throttles each user with a leaky bucket.  Every request costs some quota, which is reported in the
+// X-Request-Cost header, and the quota left is reported in the X-Rate-Limit-Remaining header.  The bucket leaks at a
+// fixed rate, and each request is charged a preflight cost up front while it is being handled.  The size of the bucket
+// is configured by each institution, so it is learned from the highest remaining quota seen, and the cost of each
+// endpoint is estimated from the costs of the previous requests to it.
 const (
-	rateLimitMax       = 700
-	rateLimitOutflow   = 10 // Hz
-	rateLimitPreflight = 50
+	rateLimitMax        = 700
+	rateLimitOutflow    = 10 // Hz
+	rateLimitPreflight  = 50
+	rateLimitCostWeight = 0.3
+	rateLimitMinBackoff = time.Second
+	rateLimitMaxBackoff = time.Minute
 )
 
-func (c *Canvas) onRequestStart() {
+var (
+	idSegmentRe = regexp.MustCompile("/(\\d+|self|sis_[^/]*)(/|$)")
+)
+
+// RateLimitState describes what the rate limiter knows about the quota
+type RateLimitState struct {
+	// Available is the quota that can be used by new requests
+	Available float64
+	// Max is the size of the bucket
+	Max float64
+	// Pending is the number of requests that have been sent but not answered
+	Pending int
+	// Throttled is how long until requests will be sent again after Canvas throttled a request (zero if it did not)
+	Throttled time.Duration
+}
+
+func (s RateLimitState) String() string {
+	if s.Throttled > 0 {
+		return fmt.Sprintf("Throttled: %.0fs", s.Throttled.Seconds())
+	}
+	return fmt.Sprintf("Quota: %.0f/%.0f (%d pending)", s.Available, s.Max, s.Pending)
+}
+
+// endpointKey gets the key that the cost of a request is estimated by, which is the path with the IDs removed
+func endpointKey(req *http.Request) string {
+	p := req.URL.Path
+	for {
+		next := idSegmentRe.ReplaceAllString(p, "/:id$2")
+		if next == p {
+			return p
+		}
+		p = next
+	}
+}
+
+// estimateCost estimates how much quota a request will use, which is never more than the whole bucket
+func (c *Canvas) estimateCost(key string) float64 {
+	cost, ok := c.requestCosts[key]
+	if !ok || cost < rateLimitPreflight {
+		cost = rateLimitPreflight
+	}
+	if max := c.bucketSize(); cost > max {
+		return max
+	}
+	return cost
+}
+
+// bucketSize gets the size of the bucket, which is the highest remaining quota seen, or a typical size before any
+// responses have been seen
+func (c *Canvas) bucketSize() float64 {
+	if c.quotaMax <= 0 {
+		return rateLimitMax
+	}
+	return c.quotaMax
+}
+
+// onRequestStart waits until there is enough quota for a request, then reserves it.  It returns the amount reserved,
+// which must be passed to onRequestFinish.
+func (c *Canvas) onRequestStart(ctx context.Context, req *http.Request) (float64, error) {
 	c.quotaMutex.Lock()
 	defer c.quotaMutex.Unlock()
-	for quota := c.GetQuotaAvailable(); quota < rateLimitPreflight; {
-		needed := (rateLimitPreflight - quota) / rateLimitOutflow
-		timer := time.NewTimer(time.Duration(needed * float64(time.Second)))
+	key := endpointKey(req)
+	for {
+		c.quotaCalcMutex.Lock()
+		cost := c.estimateCost(key)
+		quota := c.getQuotaAvailable()
+		wait := c.throttledUntil.Sub(time.Now())
+		if wait <= 0 && quota >= cost {
+			c.pendingRequests++
+			c.pendingCost += cost
+			c.quotaCalcMutex.Unlock()
+			return cost, nil
+		}
+		c.quotaCalcMutex.Unlock()
+		if needed := time.Duration((cost - quota) / rateLimitOutflow * float64(time.Second)); needed > wait {
+			wait = needed
+		}
+		timer := time.NewTimer(wait)
 		select {
 		case <-timer.C:
 			break
 		case <-c.quotaNotify:
 			break
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, ctx.Err()
 		}
 		timer.Stop()
 	}
-	c.pendingRequests++
 }
 
-func (c *Canvas) onRequestFinish(res *http.Response, err error) {
+// onRequestFinish releases the quota reserved for a request and learns from the rate limit headers of its response
+func (c *Canvas) onRequestFinish(req *http.Request, reserved float64, res *http.Response, err error) {
+	c.quotaCalcMutex.Lock()
+	defer c.quotaCalcMutex.Unlock()
+	defer c.notifyQuota()
 	c.pendingRequests--
+	c.pendingCost -= reserved
+	if err != nil {
+		return
+	}
 	now := time.Now()
 	header := res.Header.Get("X-Rate-Limit-Remaining")
 	if len(header) > 0 {
 		var rem float64
 		fmt.Sscanf(header, "%f", &rem)
-		c.quotaCalcMutex.Lock()
-		defer c.quotaCalcMutex.Unlock()
 		c.lastQuota = rem
 		c.lastQuotaTime = now
+		if rem > c.quotaMax {
+			c.quotaMax = rem
+		}
+	}
+	if header := res.Header.Get("X-Request-Cost"); len(header) > 0 {
+		var cost float64
+		if _, err := fmt.Sscanf(header, "%f", &cost); err == nil {
+			key := endpointKey(req)
+			if old, ok := c.requestCosts[key]; ok {
+				cost = old + rateLimitCostWeight*(cost-old)
+			}
+			c.requestCosts[key] = cost
+		}
+	}
+	if isThrottled(res) {
+		if c.throttleBackoff < rateLimitMinBackoff {
+			c.throttleBackoff = rateLimitMinBackoff
+		} else if c.throttleBackoff *= 2; c.throttleBackoff > rateLimitMaxBackoff {
+			c.throttleBackoff = rateLimitMaxBackoff
+		}
+		c.throttledUntil = now.Add(c.throttleBackoff)
+		c.lastQuota = 0
+		c.lastQuotaTime = now
+	} else if res.StatusCode < 400 {
+		c.throttleBackoff = 0
+	}
+}
+
+// isThrottled determines if Canvas refused a request because the quota ran out
+func isThrottled(res *http.Response) bool {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if res.StatusCode != http.StatusForbidden {
+		return false
+	}
+	var rem float64
+	_, err := fmt.Sscanf(res.Header.Get("X-Rate-Limit-Remaining"), "%f", &rem)
+	return err == nil && rem <= 0
+}
+
+// notifyQuota wakes up a request waiting for quota, if there is one
+func (c *Canvas) notifyQuota() {
+	select {
+	case c.quotaNotify <- nil:
+		break
+	default:
+		break
 	}
 }
 
+// getQuotaAvailable gets the quota available with quotaCalcMutex held
+func (c *Canvas) getQuotaAvailable() float64 {
+	dt := time.Now().Sub(c.lastQuotaTime)
+	tot := c.lastQuota + rateLimitOutflow*dt.Seconds()
+	if max := c.bucketSize(); tot >= max {
+		tot = max
+	}
+	return tot - c.pendingCost
+}
+
 // GetQuotaAvailable gets the amount of quota that is currently available
 func (c *Canvas) GetQuotaAvailable() float64 {
 	c.quotaCalcMutex.Lock()
 	defer c.quotaCalcMutex.Unlock()
-	dt := time.Now().Sub(c.lastQuotaTime)
-	tot := c.lastQuota + rateLimitOutflow*dt.Seconds()
-	if tot >= rateLimitMax {
-		return rateLimitMax
+	return c.getQuotaAvailable()
+}
+
+// GetRateLimitState gets what the rate limiter currently knows about the quota
+func (c *Canvas) GetRateLimitState() RateLimitState {
+	c.quotaCalcMutex.Lock()
+	defer c.quotaCalcMutex.Unlock()
+	state := RateLimitState{
+		Available: c.getQuotaAvailable(),
+		Max:       c.bucketSize(),
+		Pending:   c.pendingRequests,
+	}
+	if wait := c.throttledUntil.Sub(time.Now()); wait > 0 {
+		state.Throttled = wait
 	}
-	return tot - float64(rateLimitPreflight*c.pendingRequests)
+	return state
 }
@@ -0,0 +1,133 @@
+package canvas
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// finishRequest sends a request through the rate limiter as if Canvas responded with a status code and rate limit
+// headers, which are left out when empty
+func finishRequest(t *testing.T, c *Canvas, req *http.Request, code int, remaining string, cost string) {
+	reserved, err := c.onRequestStart(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+	}
+	if len(remaining) > 0 {
+		res.Header.Set("X-Rate-Limit-Remaining", remaining)
+	}
+	if len(cost) > 0 {
+		res.Header.Set("X-Request-Cost", cost)
+	}
+	c.onRequestFinish(req, reserved, res, nil)
+}
+
+func TestRateLimit(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		code      int
+		remaining string
+		costs     []string
+		available float64
+		max       float64
+		cost      float64
+		throttled bool
+	}{
+		{"NoHeaders", http.StatusOK, "", nil, rateLimitMax, rateLimitMax, rateLimitPreflight, false},
+		{"Remaining", http.StatusOK, "650.5", nil, 650.5, 650.5, rateLimitPreflight, false},
+		{"Cost", http.StatusOK, "500.0", []string{"120.0"}, 500, 500, 120, false},
+		{"AverageCost", http.StatusOK, "500.0", []string{"100.0", "200.0"}, 500, 500, 130, false},
+		{"CheapCost", http.StatusOK, "500.0", []string{"0.5"}, 500, 500, rateLimitPreflight, false},
+		{"ExpensiveCost", http.StatusOK, "300.0", []string{"1000.0"}, 300, 300, 300, false},
+		{"InvalidCost", http.StatusOK, "500.0", []string{"unknown"}, 500, 500, rateLimitPreflight, false},
+		{"TooManyRequests", http.StatusTooManyRequests, "", nil, 0, rateLimitMax, rateLimitPreflight, true},
+		{"RateLimitExceeded", http.StatusForbidden, "0.0", []string{"80.0"}, 0, rateLimitMax, 80, true},
+		{"Forbidden", http.StatusForbidden, "600.0", nil, 600, 600, rateLimitPreflight, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := createRetryTest(t, testRetryPolicy)
+			req, err := http.NewRequest(http.MethodGet, "https://canvas.example.com/api/v1/courses/1/pages", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(test.costs) == 0 {
+				finishRequest(t, c, req, test.code, test.remaining, "")
+			}
+			for _, cost := range test.costs {
+				finishRequest(t, c, req, test.code, test.remaining, cost)
+			}
+			state := c.GetRateLimitState()
+			// The bucket leaks while the test runs
+			if math.Abs(state.Available-test.available) > 1 {
+				t.Errorf("Expected %.1f quota available, but got %.1f", test.available, state.Available)
+			}
+			if state.Max != test.max {
+				t.Errorf("Expected a bucket of %.1f, but got %.1f", test.max, state.Max)
+			}
+			if state.Pending != 0 {
+				t.Errorf("Expected no pending requests, but got %d", state.Pending)
+			}
+			// The cost is learned for the endpoint, not the course
+			other, err := http.NewRequest(http.MethodGet, "https://canvas.example.com/api/v1/courses/2/pages", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cost := c.estimateCost(endpointKey(other)); math.Abs(cost-test.cost) > 0.001 {
+				t.Errorf("Expected a request to cost %.1f, but got %.1f", test.cost, cost)
+			}
+			if (state.Throttled > 0) != test.throttled {
+				t.Errorf("Expected throttled to be %v, but waiting %v", test.throttled, state.Throttled)
+			}
+
+			// Requests wait until the throttling is over
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			reserved, err := c.onRequestStart(ctx, other)
+			if test.throttled && err != context.DeadlineExceeded {
+				t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+			} else if !test.throttled && err != nil {
+				t.Error(err)
+			} else if err == nil {
+				c.onRequestFinish(other, reserved, nil, context.Canceled)
+			}
+		})
+	}
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	c := createRetryTest(t, testRetryPolicy)
+	req, err := http.NewRequest(http.MethodGet, "https://canvas.example.com/api/v1/courses", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each throttled response in a row doubles the wait, up to the maximum
+	for _, backoff := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		c.throttledUntil = time.Time{}
+		c.lastQuota = rateLimitMax
+		finishRequest(t, c, req, http.StatusForbidden, "0.0", "")
+		if c.throttleBackoff != backoff {
+			t.Errorf("Expected to wait %v, but got %v", backoff, c.throttleBackoff)
+		}
+	}
+	c.throttleBackoff = rateLimitMaxBackoff
+	c.throttledUntil = time.Time{}
+	c.lastQuota = rateLimitMax
+	finishRequest(t, c, req, http.StatusTooManyRequests, "", "")
+	if c.throttleBackoff != rateLimitMaxBackoff {
+		t.Errorf("Expected to wait %v, but got %v", rateLimitMaxBackoff, c.throttleBackoff)
+	}
+
+	// A successful response resets the backoff
+	c.throttledUntil = time.Time{}
+	c.lastQuota = rateLimitMax
+	finishRequest(t, c, req, http.StatusOK, "700.0", "")
+	if c.throttleBackoff != 0 {
+		t.Errorf("Expected the backoff to be reset, but got %v", c.throttleBackoff)
+	}
+}
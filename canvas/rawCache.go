@@ -1,18 +1,52 @@
 package canvas
 
 import (
+	"bufio"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 )
 
+// The raw cache stores every response from Canvas under RawSaveFolder.  Each endpoint gets a folder named after its
+// path with the file extension of the accepted MIME type, and each query string sent to it gets a file in that folder
+// named after the escaped query (or "default" when there is none) with the same extension.  For example, the response
+// to GET api/v1/courses?page=2 as JSON is saved to
+//
+//     api/v1/courses.json/page=2.json
+//
+// Next to the body, the same layout with ResponseType added to the accepted type (so with a ".http" extension) holds
+// the status line and headers of the response as they would appear on the wire, ending with a blank line:
+//
+//     api/v1/courses.json.http/page=2.json.http
+//
+//     HTTP/1.1 200 OK
+//     Content-Type: application/json; charset=utf-8
+//     Link: <https://canvas.example.edu/api/v1/courses?page=3>; rel="next"
+//
+// Requests that were redirected store the target location in the same layout with RedirectType added to the accepted
+// type (so with a ".url" extension) instead of a body.  Responses with an invalid status code are saved as well, so
+// that they are replayed the same way in offline mode, but never in place of a successful response.  Headers that
+// could contain credentials or that change on every request are not saved, so that the raw cache only changes when
+// the responses do.
+
+// unsavedHeaders are not written to the raw cache
+var unsavedHeaders = map[string]bool{
+	"Set-Cookie":             true,
+	"Date":                   true,
+	"X-Request-Id":           true,
+	"X-Runtime":              true,
+	"X-Rate-Limit-Remaining": true,
+	"X-Request-Cost":         true,
+}
+
 func (c *Canvas) getRawCacheLocation(u url.URL, accept string) string {
-	if u.Host == fmt.Sprintf("%s.instructure.com", c.subdomain) {
-		p := u.EscapedPath()
-		ext := mimeToExt(accept)
+	if c.isCanvasURL(&u) {
+		p := fmt.Sprintf("/%s", strings.TrimPrefix(u.EscapedPath(), c.baseURL.EscapedPath()))
+		ext := MimeToExt(accept)
 		if len(u.RawQuery) > 0 {
 			return path.Join(c.RawSaveFolder, fmt.Sprintf("%s%s", p, ext), fmt.Sprintf("%s%s", url.PathEscape(u.RawQuery), ext))
 		}
@@ -21,13 +55,100 @@ func (c *Canvas) getRawCacheLocation(u url.URL, accept string) string {
 	return ""
 }
 
+// saveRequest saves a response body in the raw cache, and if res is not nil, the status line and headers as well
 func (c *Canvas) saveRequest(u url.URL, accept string, body []byte, res *http.Response) error {
+	if c.Offline {
+		return nil
+	}
 	if fname := c.getRawCacheLocation(u, accept); len(fname) > 0 {
 		dirname := path.Dir(fname)
 		if err := os.MkdirAll(dirname, 0755); err != nil {
 			return err
 		}
-		return ioutil.WriteFile(fname, body, 0644)
+		// Another request for the same URL may be reading the cache at the same time
+		if err := writeFileAtomic(fname, func(w io.Writer) error {
+			_, err := w.Write(body)
+			return err
+		}); err != nil {
+			return err
+		}
+		if res != nil {
+			return c.saveResponse(u, accept, res)
+		}
 	}
 	return nil
 }
+
+// saveFailedRequest saves a response with an invalid status code in the raw cache, unless a successful response is
+// already saved for the same request, since that is what offline mode should replay
+func (c *Canvas) saveFailedRequest(u url.URL, accept string, body []byte, res *http.Response) error {
+	if c.Offline {
+		return nil
+	}
+	cached, err := c.readResponse(u, accept)
+	if err != nil {
+		return err
+	}
+	if cached != nil && cached.StatusCode < 300 {
+		return nil
+	}
+	if cached == nil {
+		// Bodies saved without their status line were successful
+		if fname := c.getRawCacheLocation(u, accept); len(fname) > 0 {
+			if _, err := os.Stat(fname); err == nil {
+				return nil
+			}
+		}
+	}
+	return c.saveRequest(u, accept, body, res)
+}
+
+// saveResponse saves the status line and headers of a response in the raw cache
+func (c *Canvas) saveResponse(u url.URL, accept string, res *http.Response) error {
+	fname := c.getRawCacheLocation(u, fmt.Sprintf("%s+%s", accept, ResponseType))
+	if len(fname) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(path.Dir(fname), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(fname, func(f io.Writer) error {
+		w := bufio.NewWriter(f)
+		proto := res.Proto
+		if len(proto) == 0 {
+			proto = "HTTP/1.1"
+		}
+		fmt.Fprintf(w, "%s %s\r\n", proto, res.Status)
+		if err := res.Header.WriteSubset(w, unsavedHeaders); err != nil {
+			return err
+		}
+		fmt.Fprint(w, "\r\n")
+		return w.Flush()
+	})
+}
+
+// readResponse reads the status line and headers of a response from the raw cache, returning nil if they were not
+// saved
+func (c *Canvas) readResponse(u url.URL, accept string) (*http.Response, error) {
+	fname := c.getRawCacheLocation(u, fmt.Sprintf("%s+%s", accept, ResponseType))
+	if len(fname) == 0 {
+		return nil, nil
+	}
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	req := &http.Request{
+		Method: "GET",
+		URL:    &u,
+	}
+	res, err := http.ReadResponse(bufio.NewReader(f), req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package canvas
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRawCacheKeepsSuccess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "canvas-raw-cache-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-id")
+		w.Header().Set("X-Runtime", "0.1")
+		if count++; count > 1 || r.URL.Path == "/api/v1/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"message":"The specified resource does not exist."}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+	c, err := CreateCanvas(server.URL+"/", "token", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RetryPolicy = testRetryPolicy
+	if _, _, err = c.RequestRaw(context.Background(), server.URL+"/api/v1/courses/1", "application/json", 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(server.URL + "/api/v1/courses/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ioutil.ReadFile(c.getRawCacheLocation(*u, "application/json+"+ResponseType))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, header := range []string{"Date", "X-Request-Id", "X-Runtime"} {
+		if strings.Contains(string(res), header+":") {
+			t.Errorf("Expected %s not to be saved, but got %q", header, res)
+		}
+	}
+
+	// A later failure does not replace the response that is replayed offline
+	for _, endpoint := range []string{"api/v1/courses/1", "api/v1/missing"} {
+		if _, _, err = c.RequestRaw(context.Background(), server.URL+"/"+endpoint, "application/json", 0, nil); err == nil {
+			t.Fatalf("Expected %s to fail", endpoint)
+		}
+	}
+	c.Offline = true
+	body, _, err := c.RequestRaw(context.Background(), server.URL+"/api/v1/courses/1", "application/json", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"id":1}` {
+		t.Errorf("Expected the successful response to be replayed, but got %q", body)
+	}
+	_, _, err = c.RequestRaw(context.Background(), server.URL+"/api/v1/missing", "application/json", 0, nil)
+	if e, ok := err.(InvalidStatusCodeError); !ok || e.Code != http.StatusNotFound {
+		t.Errorf("Expected the failed response to be replayed, but got %v", err)
+	}
+}
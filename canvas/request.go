@@ -1,12 +1,16 @@
 package canvas
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/zachdeibert/canvas-sync/task"
@@ -28,55 +32,181 @@ func (err InvalidStatusCodeError) Error() string {
 	return fmt.Sprintf("Invalid status code at URL %s: %s", err.URL, err.Status)
 }
 
-// RequestRaw performs a raw HTTP request
-func (c *Canvas) RequestRaw(url string, accept string, allowedRedirects int) ([]byte, *http.Response, error) {
-	req, err := http.NewRequest("GET", url, nil)
+// RequestRaw performs a raw HTTP request, retrying it according to the retry policy if it fails for a transient
+// reason.  Each retry is added to the progress, which may be nil.  The request is abandoned when ctx is done.  In
+// offline mode, the response is read from the raw cache instead.  If the raw cache has a response with an ETag for the
+// same URL, the request is sent with If-None-Match, and the cached body is returned if the server responds with 304 Not
+// Modified.
+func (c *Canvas) RequestRaw(ctx context.Context, url string, accept string, allowedRedirects int, progress *task.Progress) ([]byte, *http.Response, error) {
+	req, err := c.newRequest(http.MethodGet, url, accept, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	if req.URL.Host == fmt.Sprintf("%s.instructure.com", c.subdomain) {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if c.Offline {
+		return c.requestCached(*req.URL, accept, allowedRedirects)
 	}
-	req.Header.Add("Accept", accept)
-	c.onRequestStart()
-	res, err := c.client.Do(req)
-	c.onRequestFinish(res, err)
+	cachedBody, cached, err := c.cachedForValidation(*req.URL, accept)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cached != nil {
+		req.Header.Set("If-None-Match", cached.Header.Get("ETag"))
+	}
+	var body []byte
+	var res *http.Response
+	if err = c.withRetries(ctx, progress, isRetryable, func() (*http.Response, error) {
+		body, res, err = c.sendRequest(ctx, req)
+		return res, err
+	}); err != nil {
+		if e, ok := err.(InvalidStatusCodeError); ok && res != nil {
+			if err := c.saveFailedRequest(*req.URL, accept, []byte(e.Body), res); err != nil {
+				return nil, nil, err
+			}
+		}
+		return nil, nil, err
+	}
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		res = notModified(cached, res)
+		return cachedBody, res, c.saveResponse(*req.URL, accept, res)
+	}
+	loc, err := getRedirect(res, allowedRedirects)
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(loc) > 0 {
+		body, res, err := c.RequestRaw(ctx, loc, accept, allowedRedirects-1, progress)
+		if err == nil {
+			err = c.saveRequest(*req.URL, fmt.Sprintf("%s+%s", accept, RedirectType), []byte(loc), nil)
+		}
+		return body, res, err
+	}
+	err = c.saveRequest(*req.URL, accept, body, res)
+	return body, res, err
+}
+
+func (c *Canvas) newRequest(method string, url string, accept string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", accept)
+	return req, nil
+}
+
+// getRedirect gets the absolute location a response redirects to, or an empty string if it is not a redirect
+func getRedirect(res *http.Response, allowedRedirects int) (string, error) {
+	if res.StatusCode < 300 {
+		return "", nil
+	}
+	loc := res.Header.Get("Location")
+	if len(loc) == 0 {
+		return "", errors.New("Redirect requested with no target location")
+	}
+	if allowedRedirects <= 0 {
+		return "", errors.New("Too many redirects")
+	}
+	u, err := res.Request.URL.Parse(loc)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// openRequest sends a request once and returns the response with its body still open, or an error if the server
+// responds with an invalid status code.  Requests to Canvas are authenticated with the current access token, and if
+// Canvas rejects an OAuth2 access token, it is refreshed and the request is sent again.
+func (c *Canvas) openRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
+	token, err := c.authorize(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.sendOnce(ctx, req)
+	if e, ok := err.(InvalidStatusCodeError); !ok || e.Code != http.StatusUnauthorized || !c.canRefresh(req) {
+		return res, err
+	}
+	if req.Body != nil {
+		if req.GetBody == nil {
+			return res, err
+		}
+		if req.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.refreshOAuth(ctx, token); err != nil {
+		return nil, err
+	}
+	if _, err = c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	return c.sendOnce(ctx, req)
+}
+
+// sendOnce sends a request exactly once, waiting for quota first
+func (c *Canvas) sendOnce(ctx context.Context, req *http.Request) (*http.Response, error) {
+	reserved, err := c.onRequestStart(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.client.Do(req.WithContext(ctx))
+	c.onRequestFinish(req, reserved, res, err)
+	if err != nil {
+		return nil, err
+	}
 	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		defer res.Body.Close()
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, nil, InvalidStatusCodeError{
-			URL:    url,
+		return res, InvalidStatusCodeError{
+			URL:    req.URL.String(),
 			Status: res.Status,
 			Code:   res.StatusCode,
 			Body:   string(body),
 		}
 	}
-	if res.StatusCode >= 300 {
-		loc := res.Header.Get("Location")
-		if len(loc) > 0 {
-			if allowedRedirects > 0 {
-				body, res, err := c.RequestRaw(loc, accept, allowedRedirects-1)
-				if err == nil {
-					err = c.saveRequest(*req.URL, fmt.Sprintf("%s+%s", accept, RedirectType), []byte(loc), res)
-				}
-				return body, res, err
-			}
-			return nil, nil, errors.New("Too many redirects")
-		}
-		return nil, nil, errors.New("Redirect requested with no target location")
+	return res, nil
+}
+
+// sendRequest sends a request once and reads the response, giving up after the request timeout.  The time spent
+// waiting for a connection does not count towards the timeout.
+func (c *Canvas) sendRequest(ctx context.Context, req *http.Request) ([]byte, *http.Response, error) {
+	release, err := c.acquireConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
 	}
+	res, err := c.openRequest(ctx, req)
+	if err != nil {
+		return nil, res, err
+	}
+	defer res.Body.Close()
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, nil, err
+		return nil, res, err
 	}
-	err = c.saveRequest(*req.URL, accept, body, res)
-	return body, res, err
+	return body, res, nil
+}
+
+// DefaultPerPage is the number of objects requested in each page of a paginated response, unless the request sets
+// per_page itself
+const DefaultPerPage = 100
+
+// endpointURL gets the URL of an API end point, which is relative to api/v1 unless it starts with a slash
+func (c *Canvas) endpointURL(endpoint string) string {
+	if strings.HasPrefix(endpoint, "/") {
+		return fmt.Sprintf("%s%s", c.GetBaseURL(), endpoint[1:])
+	}
+	return fmt.Sprintf("%sapi/v1/%s", c.GetBaseURL(), endpoint)
 }
 
-// Request sends a request to the API
-func (c *Canvas) Request(endpoint string, params map[string]interface{}, progress *task.Progress, responseCtor func() interface{}, callback func(interface{}) error) error {
+// Request sends a request to the API and calls callback with each page of the response, in order.  If the response
+// says how many pages there are, the remaining pages are fetched at the same time, as many at once as there are
+// connections.  Otherwise, each page is fetched after the one before it.  Requests with a method that changes something
+// send the parameters as a form in the body instead, and are never paginated.
+func (c *Canvas) Request(ctx context.Context, method string, endpoint string, params map[string]interface{}, progress *task.Progress, responseCtor func() interface{}, callback func(interface{}) error) error {
 	sParams := make([]string, len(params))
 	i := 0
 	if params != nil {
@@ -88,55 +218,117 @@ func (c *Canvas) Request(endpoint string, params map[string]interface{}, progres
 			i++
 		}
 	}
-	url := fmt.Sprintf("https://%s.instructure.com/api/v1/%s?%s", c.subdomain, endpoint, strings.Join(sParams, "&"))
-	progress.AddWork(1)
-	first := true
-	for i = 1; ; i++ {
-		body, res, err := c.RequestRaw(url, "application/json", 10)
-		if err != nil {
+	if isWrite(method) {
+		return c.requestWrite(ctx, method, endpoint, FormType, []byte(strings.Join(sParams, "&")), progress, responseCtor, callback)
+	}
+	if _, ok := params["per_page"]; !ok {
+		sParams = append(sParams, fmt.Sprintf("per_page=%d", DefaultPerPage))
+	}
+	url := fmt.Sprintf("%s?%s", c.endpointURL(endpoint), strings.Join(sParams, "&"))
+	handle := func(body []byte) error {
+		response := responseCtor()
+		if err := json.Unmarshal(body, response); err != nil {
 			return err
 		}
-		response := responseCtor()
-		if err = json.Unmarshal(body, response); err != nil {
+		return callback(response)
+	}
+	progress.AddWork(1)
+	for {
+		body, res, err := c.RequestRaw(ctx, url, "application/json", 10, progress)
+		if err != nil {
 			return err
 		}
-		if err = callback(response); err != nil {
+		if err = handle(body); err != nil {
 			return err
 		}
 		progress.Finish(1)
-		links := linkRe.FindAllStringSubmatch(res.Header.Get("Link"), -1)
-		if links == nil {
+		next, last := getPageLinks(res)
+		if len(next) == 0 {
 			return nil
 		}
-		found := false
-		for _, link := range links {
-			switch link[2] {
-			case "next":
-				url = link[1]
-				found = true
-				break
-			case "last":
-				if first {
-					query := strings.Split(strings.Split(link[1], "?")[1], "&")
-					for _, param := range query {
-						parts := strings.Split(param, "=")
-						if parts[0] == "page" {
-							var numPages int
-							fmt.Sscanf(parts[1], "%d", &numPages)
-							progress.AddWork(numPages - i)
-							first = false
-							break
-						}
-					}
-				}
-				break
-			}
+		if pages := getPageURLs(next, last); pages != nil {
+			return c.requestPages(ctx, pages, progress, handle)
+		}
+		progress.AddWork(1)
+		url = next
+	}
+}
+
+// getPageLinks gets the URLs of the next and last pages from the Link header of a paginated response
+func getPageLinks(res *http.Response) (string, string) {
+	next, last := "", ""
+	for _, link := range linkRe.FindAllStringSubmatch(res.Header.Get("Link"), -1) {
+		switch link[2] {
+		case "next":
+			next = link[1]
+			break
+		case "last":
+			last = link[1]
+			break
 		}
-		if first && found {
-			progress.AddWork(1)
+	}
+	return next, last
+}
+
+// getPageURLs gets the URLs of every page from next to last, or nil if the pages are not numbered (Canvas uses opaque
+// bookmarks for some endpoints, and leaves out the last page when it is expensive to count)
+func getPageURLs(next string, last string) []string {
+	if len(last) == 0 {
+		return nil
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return nil
+	}
+	lastURL, err := url.Parse(last)
+	if err != nil {
+		return nil
+	}
+	first, err := strconv.Atoi(nextURL.Query().Get("page"))
+	if err != nil || first < 1 {
+		return nil
+	}
+	count, err := strconv.Atoi(lastURL.Query().Get("page"))
+	if err != nil || count < first {
+		return nil
+	}
+	pages := make([]string, 0, count-first+1)
+	for page := first; page <= count; page++ {
+		u := *nextURL
+		u.RawQuery = setPage(nextURL.RawQuery, page)
+		pages = append(pages, u.String())
+	}
+	return pages
+}
+
+// setPage replaces the page number in a query, leaving the rest of the query exactly as Canvas sent it
+func setPage(rawQuery string, page int) string {
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		if strings.SplitN(param, "=", 2)[0] == "page" {
+			params[i] = fmt.Sprintf("page=%d", page)
 		}
-		if !found {
-			return nil
+	}
+	return strings.Join(params, "&")
+}
+
+// requestPages fetches pages at the same time and handles them in order
+func (c *Canvas) requestPages(ctx context.Context, pages []string, progress *task.Progress, handle func([]byte) error) error {
+	progress.AddWork(len(pages))
+	bodies := make([][]byte, len(pages))
+	errs := c.RunParallel(len(pages), func(i int) error {
+		var err error
+		bodies[i], _, err = c.RequestRaw(ctx, pages[i], "application/json", 10, progress)
+		return err
+	})
+	for i, body := range bodies {
+		if errs[i] != nil {
+			return errs[i]
 		}
+		if err := handle(body); err != nil {
+			return err
+		}
+		progress.Finish(1)
 	}
+	return nil
 }
@@ -0,0 +1,37 @@
+package canvas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetPageURLs(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		next  string
+		last  string
+		pages []string
+	}{
+		{"Numbered", "https://canvas.example.com/api/v1/courses?page=2&per_page=100", "https://canvas.example.com/api/v1/courses?page=4&per_page=100", []string{
+			"https://canvas.example.com/api/v1/courses?page=2&per_page=100",
+			"https://canvas.example.com/api/v1/courses?page=3&per_page=100",
+			"https://canvas.example.com/api/v1/courses?page=4&per_page=100",
+		}},
+		{"QueryOrder", "https://canvas.example.com/api/v1/courses?per_page=100&include%5B%5D=term&page=2", "https://canvas.example.com/api/v1/courses?per_page=100&include%5B%5D=term&page=3", []string{
+			"https://canvas.example.com/api/v1/courses?per_page=100&include%5B%5D=term&page=2",
+			"https://canvas.example.com/api/v1/courses?per_page=100&include%5B%5D=term&page=3",
+		}},
+		{"Unescaped", "https://canvas.example.com/api/v1/courses?include[]=term&page=2&per_page=10", "https://canvas.example.com/api/v1/courses?include[]=term&page=2&per_page=10", []string{
+			"https://canvas.example.com/api/v1/courses?include[]=term&page=2&per_page=10",
+		}},
+		{"NoLast", "https://canvas.example.com/api/v1/courses?page=2", "", nil},
+		{"Bookmark", "https://canvas.example.com/api/v1/courses?page=bookmark:WzEwXQ", "https://canvas.example.com/api/v1/courses?page=bookmark:WzIwXQ", nil},
+		{"LastBeforeNext", "https://canvas.example.com/api/v1/courses?page=3", "https://canvas.example.com/api/v1/courses?page=2", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if pages := getPageURLs(test.next, test.last); !reflect.DeepEqual(pages, test.pages) {
+				t.Errorf("Expected pages %v, but got %v", test.pages, pages)
+			}
+		})
+	}
+}
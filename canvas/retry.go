@@ -0,0 +1,161 @@
+package canvas
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// RetryPolicy controls how requests that fail for transient reasons are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent before giving up (1 disables retrying)
+	MaxAttempts int
+	// InitialBackoff is how long to wait before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff is the longest time to wait between retries, even if the server asks for longer with Retry-After
+	MaxBackoff time.Duration
+	// Multiplier is how much the backoff grows after each retry
+	Multiplier float64
+	// Jitter is the fraction of each backoff that is randomized so concurrent requests do not retry together
+	Jitter float64
+}
+
+// DefaultRetryPolicy is the retry policy used by new Canvas objects
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: time.Second,
+	MaxBackoff:     time.Minute,
+	Multiplier:     2,
+	Jitter:         0.5,
+}
+
+// isRetryable determines if an error from a request is transient and the request should be sent again
+func isRetryable(err error) bool {
+	if _, ok := err.(OAuthError); ok {
+		// The access token could not be refreshed, which will not change by trying again
+		return false
+	}
+	e, ok := err.(InvalidStatusCodeError)
+	if !ok {
+		return isTransientNetworkError(err)
+	}
+	switch e.Code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusForbidden:
+		return strings.Contains(e.Body, "Rate Limit Exceeded")
+	default:
+		return false
+	}
+}
+
+// isTransientNetworkError determines if an error sending a request or reading its response is likely to go away by
+// itself, like a timeout, a connection that was reset or refused, or a response that was cut off.  Other errors, like
+// invalid URLs, certificates that cannot be verified and requests that were cancelled, happen again every time.
+func isTransientNetworkError(err error) bool {
+	if _, ok := err.(IncompleteDownloadError); ok {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout() || netErr.Temporary()
+	}
+	return false
+}
+
+// isRefused determines if an error from a request means Canvas refused it without handling it, so a request that
+// changes something can be sent again without making the change twice
+func isRefused(err error) bool {
+	e, ok := err.(InvalidStatusCodeError)
+	if !ok {
+		return false
+	}
+	switch e.Code {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusForbidden:
+		return strings.Contains(e.Body, "Rate Limit Exceeded")
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header of a response, which can be either a number of seconds or a date
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	header := strings.TrimSpace(res.Header.Get("Retry-After"))
+	if len(header) == 0 {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// delay gets how long to wait before sending a request again after it failed attempt number of times
+func (p RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if d, ok := retryAfter(res); ok {
+		// A server that asks for a very long wait would otherwise stall the whole sync
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			d = p.MaxBackoff
+		}
+		return d
+	}
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if max := float64(p.MaxBackoff); p.MaxBackoff > 0 && d > max {
+		d = max
+	}
+	d -= d * p.Jitter * rand.Float64()
+	return time.Duration(d)
+}
+
+// withRetries calls attempt until it succeeds, fails with an error that retryable rejects, or the retry policy gives
+// up.  Each retry is added to the progress, which may be nil.
+func (c *Canvas) withRetries(ctx context.Context, progress *task.Progress, retryable func(error) bool, attempt func() (*http.Response, error)) error {
+	for n := 1; ; n++ {
+		res, err := attempt()
+		if err == nil || ctx.Err() != nil || n >= c.RetryPolicy.MaxAttempts || !retryable(err) {
+			return err
+		}
+		if progress != nil {
+			progress.AddWork(1)
+		}
+		timer := time.NewTimer(c.RetryPolicy.delay(n, res))
+		select {
+		case <-timer.C:
+			break
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+		if progress != nil {
+			progress.Finish(1)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package canvas
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+	Multiplier:     1,
+}
+
+// connectionReset is the error from reading a response when the server resets the connection
+var connectionReset = &net.OpError{
+	Op:  "read",
+	Net: "tcp",
+	Err: os.NewSyscallError("read", syscall.ECONNRESET),
+}
+
+func createRetryTest(t *testing.T, policy RetryPolicy) *Canvas {
+	c, err := CreateCanvas("https://canvas.example.com/", "token", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RetryPolicy = policy
+	return c
+}
+
+// attempts fails with each error in turn and then succeeds, counting the number of attempts
+func attempts(count *int, errs ...error) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		*count++
+		if *count <= len(errs) {
+			return nil, errs[*count-1]
+		}
+		return nil, nil
+	}
+}
+
+func TestRetries(t *testing.T) {
+	serviceUnavailable := InvalidStatusCodeError{Code: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	for _, test := range []struct {
+		name     string
+		errs     []error
+		attempts int
+		err      error
+	}{
+		{"Success", nil, 1, nil},
+		{"ConnectionReset", []error{connectionReset}, 2, nil},
+		{"TruncatedResponse", []error{IncompleteDownloadError{}, IncompleteDownloadError{}}, 3, nil},
+		{"ServiceUnavailable", []error{serviceUnavailable}, 2, nil},
+		{"GiveUp", []error{serviceUnavailable, serviceUnavailable, serviceUnavailable}, 3, serviceUnavailable},
+		{"RateLimitExceeded", []error{InvalidStatusCodeError{Code: http.StatusForbidden, Body: "403 Forbidden (Rate Limit Exceeded)"}}, 2, nil},
+		{"Forbidden", []error{InvalidStatusCodeError{Code: http.StatusForbidden, Body: "unauthorized"}}, 1, InvalidStatusCodeError{Code: http.StatusForbidden, Body: "unauthorized"}},
+		{"NotFound", []error{InvalidStatusCodeError{Code: http.StatusNotFound}}, 1, InvalidStatusCodeError{Code: http.StatusNotFound}},
+		{"Unprocessable", []error{InvalidStatusCodeError{Code: http.StatusUnprocessableEntity}}, 1, InvalidStatusCodeError{Code: http.StatusUnprocessableEntity}},
+		{"OAuth", []error{OAuthError{}}, 1, OAuthError{}},
+		{"Other", []error{errors.New("invalid URL")}, 1, errors.New("invalid URL")},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := createRetryTest(t, testRetryPolicy)
+			count := 0
+			err := c.withRetries(context.Background(), nil, isRetryable, attempts(&count, test.errs...))
+			if count != test.attempts {
+				t.Errorf("Expected %d attempts, but got %d", test.attempts, count)
+			}
+			if (err == nil) != (test.err == nil) || (err != nil && err.Error() != test.err.Error()) {
+				t.Errorf("Expected error %v, but got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Minute,
+		Multiplier:     1,
+		Jitter:         0.5,
+	}
+	for _, test := range []struct {
+		name   string
+		header string
+		delay  time.Duration
+	}{
+		{"Seconds", "30", 30 * time.Second},
+		{"Zero", "0", 0},
+		{"Negative", "-5", 0},
+		{"Past", "Mon, 02 Jan 2006 15:04:05 GMT", 0},
+		{"TooLong", "86400", time.Minute},
+		{"TooLongDate", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), time.Minute},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			res := &http.Response{
+				Header: http.Header{},
+			}
+			res.Header.Set("Retry-After", test.header)
+			if d := policy.delay(1, res); d != test.delay {
+				t.Errorf("Expected to wait %v, but got %v", test.delay, d)
+			}
+		})
+	}
+
+	// The wait asked for is used instead of the backoff
+	c := createRetryTest(t, testRetryPolicy)
+	c.RetryPolicy.MaxBackoff = time.Minute
+	count := 0
+	start := time.Now()
+	if err := c.withRetries(context.Background(), nil, isRetryable, func() (*http.Response, error) {
+		if count++; count > 1 {
+			return nil, nil
+		}
+		res := &http.Response{
+			Header: http.Header{},
+		}
+		res.Header.Set("Retry-After", "1")
+		return res, InvalidStatusCodeError{Code: http.StatusTooManyRequests}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait for a second before retrying, but only waited %v", elapsed)
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	c := createRetryTest(t, testRetryPolicy)
+	c.RetryPolicy.InitialBackoff = time.Hour
+	c.RetryPolicy.MaxBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	count := 0
+	start := time.Now()
+	err := c.withRetries(ctx, nil, isRetryable, attempts(&count, connectionReset, connectionReset))
+	if err != context.Canceled {
+		t.Errorf("Expected %v, but got %v", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 attempt, but got %d", count)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected the backoff to stop when cancelled, but it took %v", elapsed)
+	}
+}
+
+func TestRetryConnectionReset(t *testing.T) {
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count++; count == 1 {
+			// Reset the connection instead of closing it cleanly
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.(*net.TCPConn).SetLinger(0)
+			conn.Close()
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	c := createRetryTest(t, testRetryPolicy)
+	body, _, err := c.RequestRaw(context.Background(), server.URL+"/api/v1/courses", "application/json", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "{}" || count != 2 {
+		t.Errorf("Expected the request to succeed on the second attempt, but got %q after %d attempts", body, count)
+	}
+}
@@ -0,0 +1,323 @@
+package canvas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// uploadProgressUnit is the number of bytes of a file that make up one unit of work in the progress of an upload
+const uploadProgressUnit = 64 * 1024
+
+// UploadOptions describe where a file is uploaded to and what it is called.  Any of them may be left empty.
+type UploadOptions struct {
+	// Name of the file on Canvas, which defaults to the name of the local file
+	Name string
+	// ContentType of the file, which defaults to a guess from the extension of the name
+	ContentType string
+	// ParentFolderPath is the path of the folder to upload into, relative to the root folder of the files.  It is
+	// created if it does not exist.
+	ParentFolderPath string
+	// ParentFolderID is the ID of the folder to upload into, instead of ParentFolderPath
+	ParentFolderID string
+	// OnDuplicate is what to do if there is already a file with the same name, which is either "overwrite" (the
+	// default) or "rename"
+	OnDuplicate string
+}
+
+// uploadSlot is the response to the first step of an upload, which says where to send the file
+type uploadSlot struct {
+	UploadURL    string                 `json:"upload_url"`
+	UploadParams map[string]interface{} `json:"upload_params"`
+	FileParam    string                 `json:"file_param"`
+}
+
+// uploadResult is the response to the second step of an upload, which is either the file or where to confirm it
+type uploadResult struct {
+	File
+	Location string `json:"location"`
+}
+
+// Upload sends a file to Canvas with the file upload workflow.  endpoint is the API endpoint that starts the upload,
+// like "users/self/files".  First Canvas is told about the file and says where to send it, then the file is posted
+// there as a form, and finally the upload is confirmed with Canvas, which responds with the new file.  The file is
+// read from disk on each attempt instead of being held in memory, and the progress is updated as it is sent.
+func (c *Canvas) Upload(ctx context.Context, endpoint string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	if c.Offline {
+		return nil, ErrOfflineWrite
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("Cannot upload folder %s", filename)
+	}
+	if len(opts.Name) == 0 {
+		opts.Name = path.Base(filename)
+	}
+	if len(opts.ContentType) == 0 {
+		opts.ContentType = mime.TypeByExtension(path.Ext(opts.Name))
+	}
+	if len(opts.ContentType) == 0 {
+		opts.ContentType = DefaultType
+	}
+	units := int((info.Size() + uploadProgressUnit - 1) / uploadProgressUnit)
+	progress.AddWork(units + 1)
+	slot, err := c.requestUploadSlot(ctx, endpoint, info.Size(), opts, progress)
+	if err != nil {
+		return nil, err
+	}
+	body, res, err := c.sendUpload(ctx, slot, filename, info.Size(), opts, progress)
+	if err != nil {
+		return nil, err
+	}
+	// The last unit is only part of one, so the reader never finishes it
+	progress.Finish(units - int(info.Size()/uploadProgressUnit))
+	file, err := c.confirmUpload(ctx, body, res, progress)
+	if err != nil {
+		return nil, err
+	}
+	progress.Finish(1)
+	return file, nil
+}
+
+// requestUploadSlot tells Canvas about a file that is about to be uploaded
+func (c *Canvas) requestUploadSlot(ctx context.Context, endpoint string, size int64, opts UploadOptions, progress *task.Progress) (*uploadSlot, error) {
+	form := url.Values{}
+	form.Set("name", opts.Name)
+	form.Set("size", fmt.Sprint(size))
+	form.Set("content_type", opts.ContentType)
+	if len(opts.ParentFolderID) > 0 {
+		form.Set("parent_folder_id", opts.ParentFolderID)
+	} else if len(opts.ParentFolderPath) > 0 {
+		form.Set("parent_folder_path", opts.ParentFolderPath)
+	}
+	if len(opts.OnDuplicate) > 0 {
+		form.Set("on_duplicate", opts.OnDuplicate)
+	}
+	var slot *uploadSlot
+	if err := c.requestWrite(ctx, http.MethodPost, endpoint, FormType, []byte(form.Encode()), progress, func() interface{} {
+		return &uploadSlot{}
+	}, func(obj interface{}) error {
+		slot = obj.(*uploadSlot)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if slot == nil || len(slot.UploadURL) == 0 {
+		return nil, errors.New("Canvas did not say where to upload the file")
+	}
+	return slot, nil
+}
+
+// progressReader reports the bytes read from a file to the progress.  Bytes that were already reported by an earlier
+// attempt are not reported again.
+type progressReader struct {
+	io.Reader
+	progress *task.Progress
+	read     int64
+	reported *int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += int64(n)
+	if units := r.read/uploadProgressUnit - *r.reported/uploadProgressUnit; units > 0 && r.read > *r.reported {
+		r.progress.Finish(int(units))
+		*r.reported = r.read
+	}
+	return n, err
+}
+
+// sendUpload posts a file to the location Canvas gave for it.  The form fields Canvas asked for are sent before the
+// file, since storage services like S3 ignore any fields that come after it.  The length of the body is worked out up
+// front because those services do not accept chunked uploads.
+func (c *Canvas) sendUpload(ctx context.Context, slot *uploadSlot, filename string, size int64, opts UploadOptions, progress *task.Progress) ([]byte, *http.Response, error) {
+	head := &bytes.Buffer{}
+	w := multipart.NewWriter(head)
+	for k, v := range slot.UploadParams {
+		if v == nil {
+			continue
+		}
+		if err := w.WriteField(k, formatUploadParam(v)); err != nil {
+			return nil, nil, err
+		}
+	}
+	fileParam := slot.FileParam
+	if len(fileParam) == 0 {
+		fileParam = "file"
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf("form-data; name=\"%s\"; filename=\"%s\"", escapeQuotes(fileParam), escapeQuotes(opts.Name)))
+	header.Set("Content-Type", opts.ContentType)
+	if _, err := w.CreatePart(header); err != nil {
+		return nil, nil, err
+	}
+	headLen := head.Len()
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+	tail := append([]byte{}, head.Bytes()[headLen:]...)
+	head.Truncate(headLen)
+	var reported int64
+	open := func() (io.ReadCloser, error) {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{
+			Reader: io.MultiReader(bytes.NewReader(head.Bytes()), &progressReader{
+				Reader:   io.LimitReader(f, size),
+				progress: progress,
+				reported: &reported,
+			}, bytes.NewReader(tail)),
+			Closer: f,
+		}, nil
+	}
+	req, err := c.newRequest(http.MethodPost, slot.UploadURL, "application/json", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.ContentLength = int64(headLen) + size + int64(len(tail))
+	var res *http.Response
+	var resBody []byte
+	if err = c.withRetries(ctx, progress, isRefused, func() (*http.Response, error) {
+		r := req.Clone(ctx)
+		if r.Body, err = open(); err != nil {
+			return nil, err
+		}
+		r.GetBody = open
+		resBody, res, err = c.sendRequest(ctx, r)
+		return res, err
+	}); err != nil {
+		return nil, nil, err
+	}
+	return resBody, res, nil
+}
+
+// confirmUpload finishes an upload.  Depending on where the file was sent, the response to it is either a redirect to
+// a location that confirms the upload, the new file with the location of its API endpoint, or just the new file.
+func (c *Canvas) confirmUpload(ctx context.Context, body []byte, res *http.Response, progress *task.Progress) (*File, error) {
+	loc, err := getRedirect(res, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(loc) == 0 {
+		result := uploadResult{}
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+		if result.ID != 0 {
+			return &result.File, nil
+		}
+		if len(result.Location) == 0 {
+			return nil, errors.New("Canvas did not confirm the upload")
+		}
+		u, err := res.Request.URL.Parse(result.Location)
+		if err != nil {
+			return nil, err
+		}
+		loc = u.String()
+	}
+	req, err := c.newRequest(http.MethodGet, loc, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.withRetries(ctx, progress, isRetryable, func() (*http.Response, error) {
+		body, res, err = c.sendRequest(ctx, req)
+		return res, err
+	}); err != nil {
+		return nil, err
+	}
+	file := &File{}
+	if err = json.Unmarshal(body, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// formatUploadParam formats a form field Canvas asked for in an upload.  Numbers are written out in full, since JSON
+// numbers are decoded as float64s and fmt.Sprint writes large ones, like expiry times, with exponents.
+func formatUploadParam(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// escapeQuotes escapes a value in a quoted header parameter the same way mime/multipart does
+func escapeQuotes(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s)
+}
+
+// UploadUserFile uploads a file to the personal files of a user, which may be "self"
+func (c *Canvas) UploadUserFile(ctx context.Context, userID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("users/%s/files", userID), filename, opts, progress)
+}
+
+// UploadCourseFile uploads a file to the files of a course
+func (c *Canvas) UploadCourseFile(ctx context.Context, courseID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("courses/%s/files", courseID), filename, opts, progress)
+}
+
+// UploadGroupFile uploads a file to the files of a group
+func (c *Canvas) UploadGroupFile(ctx context.Context, groupID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("groups/%s/files", groupID), filename, opts, progress)
+}
+
+// UploadFolderFile uploads a file into a folder
+func (c *Canvas) UploadFolderFile(ctx context.Context, folderID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("folders/%s/files", folderID), filename, opts, progress)
+}
+
+// UploadSubmissionFile uploads a file for a user, which may be "self", to submit to an assignment.  The file is not
+// submitted until its ID is passed to SubmitFiles.
+func (c *Canvas) UploadSubmissionFile(ctx context.Context, courseID string, assignmentID string, userID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/files", courseID, assignmentID, userID), filename, opts, progress)
+}
+
+// UploadSubmissionCommentFile uploads a file to attach to a comment on the submission of a user, which may be "self"
+func (c *Canvas) UploadSubmissionCommentFile(ctx context.Context, courseID string, assignmentID string, userID string, filename string, opts UploadOptions, progress *task.Progress) (*File, error) {
+	return c.Upload(ctx, fmt.Sprintf("courses/%s/assignments/%s/submissions/%s/comments/files", courseID, assignmentID, userID), filename, opts, progress)
+}
+
+// SubmitFiles submits files uploaded with UploadSubmissionFile to an assignment, with an optional comment
+func (c *Canvas) SubmitFiles(ctx context.Context, courseID string, assignmentID string, fileIDs []int, comment string, progress *task.Progress) (*Submission, error) {
+	form := url.Values{}
+	form.Set("submission[submission_type]", "online_upload")
+	for _, id := range fileIDs {
+		form.Add("submission[file_ids][]", fmt.Sprint(id))
+	}
+	if len(comment) > 0 {
+		form.Set("comment[text_comment]", comment)
+	}
+	var res *Submission
+	if err := c.requestWrite(ctx, http.MethodPost, fmt.Sprintf("courses/%s/assignments/%s/submissions", courseID, assignmentID), FormType, []byte(form.Encode()), progress, func() interface{} {
+		return &Submission{}
+	}, func(obj interface{}) error {
+		res = obj.(*Submission)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
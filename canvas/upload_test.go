@@ -0,0 +1,74 @@
+package canvas
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+func TestUploadParams(t *testing.T) {
+	fields := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1024); err != nil {
+			t.Error(err)
+		}
+		for k, v := range r.MultipartForm.Value {
+			fields[k] = v[0]
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "canvas-upload-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := path.Join(dir, "file.txt")
+	if err = ioutil.WriteFile(filename, []byte("contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := CreateCanvas("https://canvas.example.com/", "token", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slot := &uploadSlot{}
+	if err = json.Unmarshal([]byte(`{
+		"upload_url": "`+server.URL+`",
+		"upload_params": {
+			"key": "files/file.txt",
+			"expires": 1602345678,
+			"size": 8,
+			"ratio": 0.5,
+			"success_action_redirect": null
+		}
+	}`), slot); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.sendUpload(context.Background(), slot, filename, 8, UploadOptions{
+		Name:        "file.txt",
+		ContentType: "text/plain",
+	}, task.CreateProgress()); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"key":     "files/file.txt",
+		"expires": "1602345678",
+		"size":    "8",
+		"ratio":   "0.5",
+	}
+	for k, v := range expected {
+		if fields[k] != v {
+			t.Errorf("Expected field %s to be %q, but got %q", k, v, fields[k])
+		}
+	}
+	if len(fields) != len(expected) {
+		t.Errorf("Expected %d fields, but got %v", len(expected), fields)
+	}
+}
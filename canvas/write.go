@@ -0,0 +1,92 @@
+package canvas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// FormType is the content type of a request body with form encoded parameters
+const FormType = "application/x-www-form-urlencoded"
+
+// ErrOfflineWrite is the error when a request that changes something on Canvas is made in offline mode
+var ErrOfflineWrite = errors.New("Cannot change anything on Canvas in offline mode")
+
+// isWrite determines if an HTTP method changes something on the server
+func isWrite(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// RequestWrite sends a request that changes something on the server, like a POST, PUT, PATCH or DELETE, with a body of
+// the given content type.  Unlike RequestRaw, the response is never saved in the raw cache and the request is only
+// retried if Canvas refused it without handling it.  If the response redirects somewhere else, the new location is
+// fetched with a GET request.
+func (c *Canvas) RequestWrite(ctx context.Context, method string, url string, accept string, contentType string, body []byte, progress *task.Progress) ([]byte, *http.Response, error) {
+	if c.Offline {
+		return nil, nil, ErrOfflineWrite
+	}
+	req, err := c.newRequest(method, url, accept, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(contentType) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	var resBody []byte
+	var res *http.Response
+	if err = c.withRetries(ctx, progress, isRefused, func() (*http.Response, error) {
+		// Each attempt needs its own copy of the body
+		r := req.Clone(ctx)
+		if r.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+		resBody, res, err = c.sendRequest(ctx, r)
+		return res, err
+	}); err != nil {
+		return nil, nil, err
+	}
+	loc, err := getRedirect(res, 10)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(loc) > 0 {
+		return c.RequestRaw(ctx, loc, accept, 9, progress)
+	}
+	return resBody, res, nil
+}
+
+// requestWrite sends a request that changes something to an API endpoint and calls callback with the response, if
+// there is one
+func (c *Canvas) requestWrite(ctx context.Context, method string, endpoint string, contentType string, body []byte, progress *task.Progress, responseCtor func() interface{}, callback func(interface{}) error) error {
+	url := c.endpointURL(endpoint)
+	progress.AddWork(1)
+	resBody, _, err := c.RequestWrite(ctx, method, url, "application/json", contentType, body, progress)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(resBody)) > 0 {
+		response := responseCtor()
+		if err = json.Unmarshal(resBody, response); err != nil {
+			return err
+		}
+		if err = callback(response); err != nil {
+			return err
+		}
+	}
+	progress.Finish(1)
+	return nil
+}
+
+// RequestJSON sends a request that changes something to the API with a JSON body, for the endpoints that take
+// structured data that can't be form encoded
+func (c *Canvas) RequestJSON(ctx context.Context, method string, endpoint string, body interface{}, progress *task.Progress, responseCtor func() interface{}, callback func(interface{}) error) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.requestWrite(ctx, method, endpoint, "application/json", data, progress, responseCtor, callback)
+}
@@ -0,0 +1,138 @@
+package canvas
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+// writeRequest is what the server received for a request that changes something
+type writeRequest struct {
+	method      string
+	path        string
+	contentType string
+	body        string
+}
+
+func TestRequestWrite(t *testing.T) {
+	var received *writeRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		received = &writeRequest{r.Method, r.URL.RequestURI(), r.Header.Get("Content-Type"), string(body)}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if r.Method == http.MethodDelete {
+			// Some endpoints respond to a delete with no content
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+	c, err := CreateCanvas(server.URL+"/", "token", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RetryPolicy = testRetryPolicy
+	responseCtor := func() interface{} {
+		return &map[string]interface{}{}
+	}
+	for _, test := range []struct {
+		name    string
+		send    func(progress *task.Progress, callback func(interface{}) error) error
+		request writeRequest
+		form    url.Values
+		json    interface{}
+		// Only responses with a body are passed to the callback
+		responses int
+	}{
+		{"Post", func(progress *task.Progress, callback func(interface{}) error) error {
+			return c.Request(context.Background(), http.MethodPost, "courses/1/discussion_topics", map[string]interface{}{
+				"title":             "Week 1 & 2",
+				"specific_sections": []string{"1", "2"},
+			}, progress, responseCtor, callback)
+		}, writeRequest{http.MethodPost, "/api/v1/courses/1/discussion_topics", FormType, ""}, url.Values{
+			"title":               []string{"Week 1 & 2"},
+			"specific_sections[]": []string{"1", "2"},
+		}, nil, 1},
+		{"Put", func(progress *task.Progress, callback func(interface{}) error) error {
+			return c.RequestJSON(context.Background(), http.MethodPut, "courses/1/pages/welcome", map[string]interface{}{
+				"wiki_page": map[string]string{
+					"body": "<p>Welcome</p>",
+				},
+			}, progress, responseCtor, callback)
+		}, writeRequest{http.MethodPut, "/api/v1/courses/1/pages/welcome", "application/json", ""}, nil, map[string]interface{}{
+			"wiki_page": map[string]interface{}{
+				"body": "<p>Welcome</p>",
+			},
+		}, 1},
+		{"Delete", func(progress *task.Progress, callback func(interface{}) error) error {
+			return c.Request(context.Background(), http.MethodDelete, "courses/1/pages/welcome", nil, progress, responseCtor, callback)
+		}, writeRequest{http.MethodDelete, "/api/v1/courses/1/pages/welcome", FormType, ""}, nil, nil, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			received = nil
+			responses := 0
+			progress := task.CreateProgress()
+			if err := test.send(progress, func(interface{}) error {
+				responses++
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if received == nil {
+				t.Fatal("Expected a request to be sent")
+			}
+			body := received.body
+			received.body = ""
+			if *received != test.request {
+				t.Errorf("Expected %+v, but got %+v", test.request, *received)
+			}
+			switch {
+			case test.form != nil:
+				form, err := url.ParseQuery(body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(form, test.form) {
+					t.Errorf("Expected the form %v, but got %v", test.form, form)
+				}
+			case test.json != nil:
+				var data interface{}
+				if err := json.Unmarshal([]byte(body), &data); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(data, test.json) {
+					t.Errorf("Expected the JSON %v, but got %s", test.json, body)
+				}
+			case len(body) > 0:
+				t.Errorf("Expected no body, but got %q", body)
+			}
+			if responses != test.responses {
+				t.Errorf("Expected %d responses, but got %d", test.responses, responses)
+			}
+			if status := progress.GetStatus(); status != 1 {
+				t.Errorf("Expected the request to be finished, but the progress is %f", status)
+			}
+		})
+	}
+
+	// Nothing is sent in offline mode
+	c.Offline = true
+	received = nil
+	err = c.Request(context.Background(), http.MethodPost, "courses/1/discussion_topics", nil, task.CreateProgress(), responseCtor, func(interface{}) error {
+		return nil
+	})
+	if err != ErrOfflineWrite || received != nil {
+		t.Errorf("Expected %v without sending anything, but got %v", ErrOfflineWrite, err)
+	}
+}
@@ -0,0 +1,85 @@
+package canvassync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+type accountName struct {
+	index int
+	name  string
+}
+
+type accountPlan struct {
+	account Account
+	db      string
+	courses []courseDiscoveryResult
+}
+
+func finishedChannel(t *task.Task) <-chan error {
+	ch := make(chan error, 1)
+	t.AddFinishListener(func(t *task.Task) {
+		ch <- t.GetError()
+	})
+	return ch
+}
+
+func accountTask(ctx context.Context, a Account, opts Options, taskLimit int, index int, names chan<- accountName, plans chan<- accountPlan) func(*task.Task, func()) {
+	return func(t *task.Task, finish func()) {
+		t.InheritProgress()
+		c := a.Canvas
+		name := make(chan string, 1)
+		dbCh := make(chan string, 1)
+		checked := finishedChannel(t.CreateSubtask("Database Check", databaseCheckTask(ctx, c, opts, name, dbCh)))
+		task.CreateManager(t, []int{0, 1, taskLimit}, 0)
+		coursesCh := make(chan []courseDiscoveryResult, 1)
+		var discovered <-chan error
+		var db string
+		for {
+			select {
+			case n := <-name:
+				names <- accountName{
+					index: index,
+					name:  n,
+				}
+				break
+			case err := <-checked:
+				if err != nil {
+					// The failure has already been reported by the Database Check task
+					finish()
+					return
+				}
+				db = <-dbCh
+				discovered = finishedChannel(t.CreateSubtask("Course Discovery", courseDiscoveryTask(ctx, c, a, coursesCh)))
+				break
+			case err := <-discovered:
+				if err != nil {
+					finish()
+					return
+				}
+				courses := <-coursesCh
+				if opts.DryRun {
+					plans <- accountPlan{
+						account: a,
+						db:      db,
+						courses: courses,
+					}
+					finish()
+					return
+				}
+				shared := coursetasks.WithSharedResults(ctx)
+				for _, course := range courses {
+					t.CreateSubtask(fmt.Sprintf("Sync '%s'", course.name), courseTaskGroup(shared, c, db, course, a.tasksFor(course))).Start()
+				}
+				t.CreateSubtask("Sync User", userTaskGroup(shared, c, db, courses, a.Tasks)).Start()
+				t.CreateSubtask("Write Database to Disk", writeDatabaseTask(db)).AddFinishListener(func(_ *task.Task) {
+					finish()
+				})
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package canvassync
 
 import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
 	"github.com/zachdeibert/canvas-sync/canvas"
 	"github.com/zachdeibert/canvas-sync/task"
 )
@@ -10,15 +15,16 @@ type courseDiscoveryResult struct {
 	name string
 }
 
-func courseDiscoveryTask(c *canvas.Canvas, coursesCh chan<- []courseDiscoveryResult) func(*task.Task, func()) {
-	return func(t *task.Task, finish func()) {
-		courses, err := c.CoursesListYourCourses(t.CreateProgress(1), nil, nil, nil, nil, nil, nil, nil)
+func courseDiscoveryTask(ctx context.Context, c *canvas.Canvas, a Account, coursesCh chan<- []courseDiscoveryResult) func(*task.Task, func()) {
+	return task.FailOnError(func(t *task.Task) error {
+		courses, err := c.CoursesListYourCourses(ctx, t.CreateProgress(1), nil, nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		res := []courseDiscoveryResult{}
 		for _, course := range courses {
-			if course.Name != "" {
+			if course.Name != "" && matchCourse(a.Courses, course.ID, course.Name) &&
+				(len(a.ExcludeCourses) == 0 || !matchCourse(a.ExcludeCourses, course.ID, course.Name)) {
 				res = append(res, courseDiscoveryResult{
 					id:   course.ID,
 					name: course.Name,
@@ -26,6 +32,22 @@ func courseDiscoveryTask(c *canvas.Canvas, coursesCh chan<- []courseDiscoveryRes
 			}
 		}
 		coursesCh <- res
-		finish()
+		return nil
+	})
+}
+
+func matchCourse(filter []string, id int, name string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	lowerName := strings.ToLower(name)
+	for _, f := range filter {
+		if f == fmt.Sprint(id) || strings.ToLower(f) == lowerName {
+			return true
+		}
+		if match, err := path.Match(strings.ToLower(f), lowerName); err == nil && match {
+			return true
+		}
 	}
+	return false
 }
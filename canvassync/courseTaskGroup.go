@@ -1,6 +1,7 @@
 package canvassync
 
 import (
+	"context"
 	"fmt"
 	"path"
 
@@ -9,11 +10,15 @@ import (
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
-func courseTaskGroup(c *canvas.Canvas, db string, course courseDiscoveryResult) func(*task.Task, func()) {
+func courseTaskGroup(ctx context.Context, c *canvas.Canvas, db string, course courseDiscoveryResult, tasks []string) func(*task.Task, func()) {
 	return func(t *task.Task, finish func()) {
 		t.InheritProgress()
 		var done int = 0
-		children := coursetasks.CreateTasks(t, c, path.Join(db, fmt.Sprintf("%d - %s", course.id, coursetasks.InvalidPathRunes.ReplaceAllLiteralString(course.name, "_"))), course.id)
+		children := coursetasks.CreateTasks(ctx, t, c, path.Join(db, fmt.Sprintf("%d - %s", course.id, coursetasks.InvalidPathRunes.ReplaceAllLiteralString(course.name, "_"))), course.id, tasks)
+		if len(children) == 0 {
+			finish()
+			return
+		}
 		listener := func(_ *task.Task) {
 			if done++; done == len(children) {
 				finish()
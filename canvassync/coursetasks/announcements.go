@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,11 +12,11 @@ import (
 )
 
 func init() {
-	registerHTMLWithFileAttachments("Announcements", html.AnnouncementChildCtor, func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerHTMLWithFileAttachments("Announcements", html.AnnouncementChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
 		startDate := time.Unix(0, 0)
 		endDate := time.Now().Add(time.Hour * 24)
-		a, err := c.AnnouncementsListAnnouncements(p, []string{
+		a, err := c.AnnouncementsListAnnouncements(ctx, p, []string{
 			fmt.Sprintf("course_%d", courseId),
 		}, &startDate, &endDate, nil, nil)
 		var o []interface{} = nil
@@ -45,7 +46,7 @@ func init() {
 			}
 		}
 		return true
-	}, func(o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) {
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) error {
 		// createDoc
 		announcement := o.(canvas.DiscussionTopic)
 		doc.Title = announcement.Title
@@ -58,9 +59,9 @@ func init() {
 		}
 		doc.AppendChild(a)
 		if announcement.DiscussionSubentryCount > 0 {
-			view, err := c.DiscussionTopicsGetTheFullTopic(t.CreateProgress(0.01), fmt.Sprint(courseId), fmt.Sprint(announcement.ID))
+			view, err := c.DiscussionTopicsGetTheFullTopic(ctx, t.CreateProgress(0.01), fmt.Sprint(courseId), fmt.Sprint(announcement.ID))
 			if err != nil {
-				panic(err)
+				return err
 			}
 			for _, rv1 := range view.View {
 				r1 := html.CreateAnnouncementReply()
@@ -85,5 +86,6 @@ func init() {
 				a.AppendChild(r1)
 			}
 		}
+		return nil
 	})
 }
@@ -1,33 +1,123 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
 	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks/html"
+	"github.com/zachdeibert/canvas-sync/csvgen"
 	"github.com/zachdeibert/canvas-sync/htmlgen"
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
+// rubricCSVFilename is the name of the file in the folder for an assignment that the rubric is exported to, which the
+// HTML links to
+const rubricCSVFilename = "Rubric.csv"
+
 type assignmentData struct {
 	Assignment canvas.Assignment
 	Submission *canvas.Submission
 	LastUpdate time.Time
 }
 
+// assignmentRubric is the rubric of an assignment along with the grader's assessment of the submission, if it has been
+// assessed
+type assignmentRubric struct {
+	Criteria   []canvas.RubricCriteria
+	Assessment map[string]canvas.SubmissionRubricAssessment
+}
+
+func (a assignmentData) rubric() *assignmentRubric {
+	if len(a.Assignment.Rubric) == 0 {
+		return nil
+	}
+	r := &assignmentRubric{
+		Criteria: a.Assignment.Rubric,
+	}
+	if a.Submission != nil {
+		r.Assessment = a.Submission.RubricAssessment
+	}
+	return r
+}
+
+// criterion gets the columns for a criterion: all of its ratings, then the selected rating, points and comments (which
+// are blank if it has not been assessed)
+func (r assignmentRubric) criterion(c canvas.RubricCriteria) (ratings, rating, points, comments string) {
+	names := make([]string, len(c.Ratings))
+	for i, v := range c.Ratings {
+		names[i] = fmt.Sprintf("%s (%.2f)", v.Description, v.Points)
+	}
+	ratings = strings.Join(names, ", ")
+	if assessment, ok := r.Assessment[c.ID]; ok {
+		for _, v := range c.Ratings {
+			if v.ID == assessment.RatingID {
+				rating = v.Description
+				break
+			}
+		}
+		points = fmt.Sprintf("%.2f", assessment.Points)
+		comments = assessment.Comments
+	}
+	return
+}
+
+// total gets the total score (blank if it has not been assessed) and points possible, leaving out criteria that are
+// not used for scoring
+func (r assignmentRubric) total() (string, float64) {
+	var score, possible float64
+	for _, c := range r.Criteria {
+		if c.IgnoreForScoring {
+			continue
+		}
+		possible += c.Points
+		if assessment, ok := r.Assessment[c.ID]; ok {
+			score += assessment.Points
+		}
+	}
+	if len(r.Assessment) == 0 {
+		return "", possible
+	}
+	return fmt.Sprintf("%.2f", score), possible
+}
+
+func (r assignmentRubric) csv(csv csvgen.CSV) {
+	for _, c := range r.Criteria {
+		ratings, rating, points, comments := r.criterion(c)
+		csv.AddRow(c.Description, c.LongDescription, ratings, rating, points, c.Points, comments)
+	}
+	score, possible := r.total()
+	csv.AddRow("Total", "", "", "", score, possible, "")
+}
+
+func (r assignmentRubric) writeCSV(filename string) error {
+	csv := csvgen.CreateCSV()
+	csv.AddColumn("Criterion", "%s")
+	csv.AddColumn("Description", "%s")
+	csv.AddColumn("Ratings", "%s")
+	csv.AddColumn("Rating", "%s")
+	csv.AddColumn("Points", "%s")
+	csv.AddColumn("Points Possible", "%.2f")
+	csv.AddColumn("Comments", "%s")
+	r.csv(csv)
+	return csv.WriteFile(filename)
+}
+
 func init() {
-	registerHTMLWithFileAttachments("Assignments", html.AssignmentChildCtor, func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerHTMLWithAttachments("Assignments", html.AssignmentChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
-		l, err := c.AssignmentsListAssignments(p, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
+		l, err := c.AssignmentsListAssignments(ctx, p, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
 		var o []interface{} = nil
 		if l != nil {
 			o = make([]interface{}, len(l))
 			p.AddWork(len(l))
 			np := task.CreateProgress()
 			for i, v := range l {
-				s, err := c.SubmissionsGetASingleSubmission(np, []canvas.SubmissionsGetASingleSubmissionInclude{
+				s, err := c.SubmissionsGetASingleSubmission(ctx, np, []canvas.SubmissionsGetASingleSubmissionInclude{
 					canvas.SubmissionsGetASingleSubmissionIncludeSubmissionComments,
+					canvas.SubmissionsGetASingleSubmissionIncludeRubricAssessment,
 				}, fmt.Sprint(courseId), fmt.Sprint(v.ID), "self")
 				if err != nil {
 					return nil, err
@@ -65,14 +155,41 @@ func init() {
 		// getFilename
 		a := o.(assignmentData)
 		return fmt.Sprintf("%d - %s", a.Assignment.ID, a.Assignment.Name)
-	}, func(o interface{}) []canvas.FileAttachment {
+	}, func(o interface{}) []interface{} {
 		// getAttachments
 		a := o.(assignmentData)
-		attachments := a.Submission.Attachments
-		for _, comment := range a.Submission.SubmissionComments {
-			attachments = append(attachments, comment.Attachments...)
+		attachments := []interface{}{}
+		if a.Submission != nil {
+			for _, attachment := range a.Submission.Attachments {
+				attachments = append(attachments, attachment)
+			}
+			for _, comment := range a.Submission.SubmissionComments {
+				for _, attachment := range comment.Attachments {
+					attachments = append(attachments, attachment)
+				}
+			}
+		}
+		if r := a.rubric(); r != nil {
+			attachments = append(attachments, *r)
 		}
 		return attachments
+	}, func(a interface{}) string {
+		// getAttachmentFilename
+		if _, ok := a.(assignmentRubric); ok {
+			return rubricCSVFilename
+		}
+		return fileAttachmentFilename(a)
+	}, func(ctx context.Context, a interface{}, filename string, c *canvas.Canvas) error {
+		// downloadAttachment
+		if r, ok := a.(assignmentRubric); ok {
+			return r.writeCSV(filename)
+		}
+		return downloadFileAttachment(ctx, a, filename, c)
+	}, func(a interface{}, filename string) bool {
+		// attachmentChanged
+		// The rubric is written again whenever the assignment is, since the assessment could have changed
+		_, ok := a.(assignmentRubric)
+		return ok
 	}, func(o interface{}, doc *htmlgen.Document) bool {
 		// isModified
 		assignment := o.(assignmentData)
@@ -85,13 +202,24 @@ func init() {
 			}
 		}
 		return true
-	}, func(o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) {
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) error {
 		// createDoc
 		assignment := o.(assignmentData)
 		doc.Title = assignment.Assignment.Name
 		a := html.CreateAssignment()
 		a.LastUpdate = assignment.LastUpdate
 		a.Data = assignment.Assignment
+		if r := assignment.rubric(); r != nil {
+			rubric := html.CreateAssignmentRubric()
+			rubric.Score, rubric.PointsPossible = r.total()
+			for _, criterion := range r.Criteria {
+				cr := html.CreateAssignmentRubricCriterion()
+				cr.Data = criterion
+				cr.Ratings, cr.Rating, cr.Points, cr.Comments = r.criterion(criterion)
+				rubric.AppendChild(cr)
+			}
+			a.AppendChild(rubric)
+		}
 		if assignment.Submission != nil {
 			s := html.CreateAssignmentSubmission()
 			s.Data = *assignment.Submission
@@ -113,5 +241,6 @@ func init() {
 			a.AppendChild(s)
 		}
 		doc.AppendChild(a)
+		return nil
 	})
 }
@@ -0,0 +1,143 @@
+package coursetasks
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/icsgen"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+var (
+	htmlTagRegex    = regexp.MustCompile("<[^>]*>")
+	htmlBlockRegex  = regexp.MustCompile("(?i:</p>|<br\\s*/?>|</li>|</h[1-6]>)")
+	blankLinesRegex = regexp.MustCompile("\\n\\s*\\n\\s*")
+)
+
+// plainText converts HTML into text for a calendar, which cannot show HTML
+func plainText(str string) string {
+	str = htmlTagRegex.ReplaceAllLiteralString(htmlBlockRegex.ReplaceAllString(str, "$0\n"), "")
+	return strings.TrimSpace(blankLinesRegex.ReplaceAllLiteralString(html.UnescapeString(str), "\n\n"))
+}
+
+// calendarUID gets the UID of the event for a Canvas object, which only depends on its kind and ID so that the event
+// stays the same when the object changes
+func calendarUID(c *canvas.Canvas, kind string, id int) string {
+	return fmt.Sprintf("%s-%d@%s", kind, id, c.GetHost())
+}
+
+func addCalendarEvents(cal *icsgen.Calendar, c *canvas.Canvas, events []canvas.CalendarEvent) {
+	for _, event := range events {
+		if event.Hidden || event.WorkflowState == "deleted" {
+			continue
+		}
+		e := icsgen.CreateEvent(calendarUID(c, "calendar-event", event.ID), event.Title, event.StartAt)
+		e.End = event.EndAt
+		if event.AllDay {
+			if date, err := time.Parse("2006-01-02", event.AllDayDate); err == nil {
+				e.Start, e.End = date, date
+			}
+			e.AllDay = true
+		}
+		e.Description = plainText(event.Description)
+		e.Location = strings.Trim(fmt.Sprintf("%s, %s", event.LocationName, event.LocationAddress), ", ")
+		e.URL = event.HTMLURL
+		e.LastModified = event.UpdatedAt
+		cal.AddEvent(e)
+	}
+}
+
+func addAssignmentDueDates(cal *icsgen.Calendar, c *canvas.Canvas, assignments []canvas.Assignment) {
+	for _, assignment := range assignments {
+		if assignment.DueAt.IsZero() {
+			continue
+		}
+		e := icsgen.CreateEvent(calendarUID(c, "assignment", assignment.ID), fmt.Sprintf("%s due", assignment.Name), assignment.DueAt)
+		e.Description = plainText(assignment.Description)
+		e.URL = assignment.HTMLURL
+		e.LastModified = assignment.UpdatedAt
+		cal.AddEvent(e)
+	}
+}
+
+func addPlannerNotes(cal *icsgen.Calendar, c *canvas.Canvas, notes []canvas.PlannerNote) {
+	for _, note := range notes {
+		if note.TodoDate.IsZero() || note.WorkflowState == "deleted" {
+			continue
+		}
+		e := icsgen.CreateEvent(calendarUID(c, "planner-note", note.ID), note.Title, note.TodoDate)
+		e.Description = plainText(note.Description)
+		e.URL = note.LinkedObjectHTMLURL
+		cal.AddEvent(e)
+	}
+}
+
+// courseCalendar adds the events, assignment due dates and planner notes of a course to a calendar.  They are only
+// fetched once for each sync, since the calendar of every course needs them too.
+func courseCalendar(ctx context.Context, t *task.Task, c *canvas.Canvas, courseId int, cal *icsgen.Calendar) error {
+	course, err := getShared(ctx, fmt.Sprintf("calendar/%d", courseId), func() (interface{}, error) {
+		course := icsgen.CreateCalendar("")
+		return course, fetchCourseCalendar(ctx, t, c, courseId, course)
+	})
+	if err != nil {
+		return err
+	}
+	for _, e := range course.(*icsgen.Calendar).Events {
+		cal.AddEvent(e)
+	}
+	return nil
+}
+
+func fetchCourseCalendar(ctx context.Context, t *task.Task, c *canvas.Canvas, courseId int, cal *icsgen.Calendar) error {
+	codes := []string{fmt.Sprintf("course_%d", courseId)}
+	eventType := "event"
+	allEvents := true
+	events, err := c.CalendarEventsListCalendarEvents(ctx, t.CreateProgress(1), &eventType, nil, nil, nil, &allEvents, codes, nil)
+	if err = ignoreForbidden(err); err != nil {
+		return err
+	}
+	addCalendarEvents(cal, c, events)
+	assignments, err := c.AssignmentsListAssignments(ctx, t.CreateProgress(1), nil, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
+	if err = ignoreForbidden(err); err != nil {
+		return err
+	}
+	addAssignmentDueDates(cal, c, assignments)
+	notes, err := c.PlannerListPlannerNotes(ctx, t.CreateProgress(1), nil, nil, codes)
+	if err = ignoreForbidden(err); err != nil {
+		return err
+	}
+	addPlannerNotes(cal, c, notes)
+	return nil
+}
+
+func init() {
+	registerICS("Calendar", courseCalendar)
+	registerUserICS("Calendar", func(ctx context.Context, t *task.Task, c *canvas.Canvas, courseIDs []int, cal *icsgen.Calendar) error {
+		cal.Name = c.GetHost()
+		// Without any context codes, only the events on the user's own calendar are listed
+		eventType := "event"
+		allEvents := true
+		events, err := c.CalendarEventsListCalendarEvents(ctx, t.CreateProgress(1), &eventType, nil, nil, nil, &allEvents, nil, nil)
+		if err != nil {
+			return err
+		}
+		addCalendarEvents(cal, c, events)
+		notes, err := c.PlannerListPlannerNotes(ctx, t.CreateProgress(1), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		addPlannerNotes(cal, c, notes)
+		errs := multiError{}
+		for _, courseId := range courseIDs {
+			if err := courseCalendar(ctx, t, c, courseId, cal); err != nil {
+				errs = append(errs, fmt.Errorf("course %d: %v", courseId, err))
+			}
+		}
+		return errs.orNil()
+	})
+}
@@ -1,8 +1,10 @@
 package coursetasks
 
 import (
+	"context"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
 	"github.com/zachdeibert/canvas-sync/task"
@@ -10,12 +12,22 @@ import (
 
 type courseTask struct {
 	name string
-	f    func(*task.Task, *canvas.Canvas, string, int, func())
+	f    func(context.Context, *task.Task, *canvas.Canvas, string, int) error
 }
 
-var tasks []courseTask
+// userTask is a task that is run once for the whole account instead of once for each course, like an inbox or a
+// calendar of every course
+type userTask struct {
+	name string
+	f    func(context.Context, *task.Task, *canvas.Canvas, string, []int) error
+}
 
-func register(name string, f func(*task.Task, *canvas.Canvas, string, int, func())) {
+var (
+	tasks     []courseTask
+	userTasks []userTask
+)
+
+func register(name string, f func(context.Context, *task.Task, *canvas.Canvas, string, int) error) {
 	t := courseTask{
 		name: name,
 		f:    f,
@@ -27,21 +39,102 @@ func register(name string, f func(*task.Task, *canvas.Canvas, string, int, func(
 	}
 }
 
-func createTask(d courseTask, c *canvas.Canvas, db string, courseID int) func(*task.Task, func()) {
-	return func(t *task.Task, finish func()) {
+func registerUser(name string, f func(context.Context, *task.Task, *canvas.Canvas, string, []int) error) {
+	userTasks = append(userTasks, userTask{
+		name: name,
+		f:    f,
+	})
+}
+
+func createTask(ctx context.Context, d courseTask, c *canvas.Canvas, db string, courseID int) func(*task.Task, func()) {
+	return task.FailOnError(func(t *task.Task) error {
 		dir := path.Join(db, d.name)
 		if err := os.MkdirAll(dir, 0755); err != nil {
-			panic(err)
+			return err
 		}
-		d.f(t, c, dir, courseID, finish)
-	}
+		return d.f(ctx, t, c, dir, courseID)
+	})
 }
 
-// CreateTasks creates all the tasks for a course under a parent task
-func CreateTasks(parent *task.Task, c *canvas.Canvas, db string, courseID int) []*task.Task {
-	res := make([]*task.Task, len(tasks))
+func createUserTask(ctx context.Context, d userTask, c *canvas.Canvas, db string, courseIDs []int) func(*task.Task, func()) {
+	return task.FailOnError(func(t *task.Task) error {
+		dir := path.Join(db, d.name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		return d.f(ctx, t, c, dir, courseIDs)
+	})
+}
+
+// TaskNames gets the names of all the tasks that can be run for a course or for the user
+func TaskNames() []string {
+	names := make([]string, len(tasks))
 	for i, d := range tasks {
-		res[i] = parent.CreateSubtask(d.name, createTask(d, c, db, courseID))
+		names[i] = d.name
+	}
+	for _, d := range userTasks {
+		if !matchTask(names, d.name) {
+			names = append(names, d.name)
+		}
+	}
+	return names
+}
+
+// FilterTaskNames gets the names of the tasks that would be run with a filter (all tasks if the filter is empty)
+func FilterTaskNames(filter []string) []string {
+	names := []string{}
+	for _, d := range tasks {
+		if matchTask(filter, d.name) {
+			names = append(names, d.name)
+		}
+	}
+	return names
+}
+
+// FilterUserTaskNames gets the names of the user tasks that would be run with a filter (all tasks if the filter is
+// empty)
+func FilterUserTaskNames(filter []string) []string {
+	names := []string{}
+	for _, d := range userTasks {
+		if matchTask(filter, d.name) {
+			names = append(names, d.name)
+		}
+	}
+	return names
+}
+
+func matchTask(filter []string, name string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if strings.EqualFold(f, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTasks creates the tasks for a course under a parent task, optionally only the ones named in the filter.  The
+// tasks stop making requests once ctx is done.
+func CreateTasks(ctx context.Context, parent *task.Task, c *canvas.Canvas, db string, courseID int, filter []string) []*task.Task {
+	res := []*task.Task{}
+	for _, d := range tasks {
+		if matchTask(filter, d.name) {
+			res = append(res, parent.CreateSubtask(d.name, createTask(ctx, d, c, db, courseID)))
+		}
+	}
+	return res
+}
+
+// CreateUserTasks creates the tasks for the user under a parent task, optionally only the ones named in the filter.
+// Each task gets its own folder in db and the IDs of the courses being synced.
+func CreateUserTasks(ctx context.Context, parent *task.Task, c *canvas.Canvas, db string, courseIDs []int, filter []string) []*task.Task {
+	res := []*task.Task{}
+	for _, d := range userTasks {
+		if matchTask(filter, d.name) {
+			res = append(res, parent.CreateSubtask(d.name, createUserTask(ctx, d, c, db, courseIDs)))
+		}
 	}
 	return res
 }
@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"path"
 
@@ -9,19 +10,18 @@ import (
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
-func registerCSV(name string, genCSV func(*task.Task, *canvas.Canvas, int, csvgen.CSV), cols ...string) {
+func registerCSV(name string, genCSV func(context.Context, *task.Task, *canvas.Canvas, int, csvgen.CSV) error, cols ...string) {
 	if len(cols)%2 != 0 {
 		panic("Invalid column spec for CSV")
 	}
-	register(name, func(t *task.Task, c *canvas.Canvas, db string, courseId int, finish func()) {
+	register(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int) error {
 		csv := csvgen.CreateCSV()
 		for i := 0; i < len(cols); i += 2 {
 			csv.AddColumn(cols[i], cols[i+1])
 		}
-		genCSV(t, c, courseId, csv)
-		if err := csv.WriteFile(path.Join(db, fmt.Sprintf("%s.csv", name))); err != nil {
-			panic(err)
+		if err := genCSV(ctx, t, c, courseId, csv); err != nil {
+			return err
 		}
-		finish()
+		return csv.WriteFile(path.Join(db, fmt.Sprintf("%s.csv", name)))
 	})
 }
@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
@@ -10,9 +11,9 @@ import (
 )
 
 func init() {
-	registerHTMLWithFileAttachments("Discussions", html.DiscussionRootChildCtor, func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerHTMLWithFileAttachments("Discussions", html.DiscussionRootChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
-		l, err := c.DiscussionTopicsListDiscussionTopics(p, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
+		l, err := c.DiscussionTopicsListDiscussionTopics(ctx, p, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
 		var o []interface{} = nil
 		if l != nil {
 			o = make([]interface{}, len(l))
@@ -40,16 +41,16 @@ func init() {
 			}
 		}
 		return true
-	}, func(o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) {
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) error {
 		// createDoc
 		topic := o.(canvas.DiscussionTopic)
 		doc.Title = topic.Title
 		d := html.CreateDiscussionRoot()
 		d.Data = topic
 		if topic.DiscussionSubentryCount > 0 && topic.UserCanSeePosts {
-			view, err := c.DiscussionTopicsGetTheFullTopic(t.CreateProgress(0.01), fmt.Sprint(courseId), fmt.Sprint(topic.ID))
+			view, err := c.DiscussionTopicsGetTheFullTopic(ctx, t.CreateProgress(0.01), fmt.Sprint(courseId), fmt.Sprint(topic.ID))
 			if err != nil {
-				panic(err)
+				return err
 			}
 			for _, rv1 := range view.View {
 				r1 := html.CreateDiscussionReply()
@@ -75,5 +76,6 @@ func init() {
 			}
 		}
 		doc.AppendChild(d)
+		return nil
 	})
 }
@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"math"
@@ -15,71 +16,81 @@ import (
 var errFileLocked = fmt.Errorf("File locked")
 
 func registerFileStructure(name string,
-	apiGet func(*task.Progress, *canvas.Canvas, int) ([]interface{}, error),
+	apiGet func(context.Context, *task.Progress, *canvas.Canvas, int) ([]interface{}, error),
 	getFilename func(interface{}) string,
-	determineLastModTime func(*task.Task, *canvas.Canvas, interface{}) (*time.Time, error),
-	downloadFile func(*task.Task, *canvas.Canvas, interface{}) ([]byte, error)) {
-	register(name, func(t *task.Task, c *canvas.Canvas, db string, courseId int, finish func()) {
-		files, err := apiGet(t.CreateProgress(0.1), c, courseId)
+	determineLastModTime func(context.Context, *task.Task, *canvas.Canvas, interface{}) (*time.Time, error),
+	downloadFile func(context.Context, *task.Task, *canvas.Canvas, interface{}, string) error) {
+	register(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int) error {
+		files, err := apiGet(ctx, t.CreateProgress(0.1), c, courseId)
 		if err != nil {
 			if e, ok := err.(canvas.InvalidStatusCodeError); ok && e.Code == 401 {
-				finish()
-				return
+				return nil
 			}
-			panic(err)
+			return err
 		}
 		p := t.CreateProgress(1)
 		p.SetWork(len(files))
 		metaFolderRoot := path.Join(db, ".syncmeta")
-		for _, file := range files {
-			name := getFilename(file)
-			filename := path.Join(db, name)
-			modFile := path.Join(metaFolderRoot, fmt.Sprintf("%s.txt", name))
-			if err := os.MkdirAll(path.Dir(filename), 0755); err != nil {
-				panic(err)
-			}
-			if err := os.MkdirAll(path.Dir(modFile), 0755); err != nil {
-				panic(err)
-			}
-			newMod, err := determineLastModTime(t, c, file)
+		// Files are independent, so they are synced in parallel on the connections shared with the other tasks
+		results := c.RunParallel(len(files), func(i int) error {
+			defer p.Finish(1)
+			return syncFile(ctx, t, c, db, metaFolderRoot, files[i], getFilename, determineLastModTime, downloadFile)
+		})
+		errs := multiError{}
+		for i, err := range results {
 			if err != nil {
-				panic(err)
-			}
-			if newMod != nil {
-				if _, err1 := os.Stat(filename); err1 == nil {
-					if _, err2 := os.Stat(modFile); err2 == nil {
-						modRaw, err := ioutil.ReadFile(modFile)
-						if err != nil {
-							panic(err)
-						}
-						mod, err := time.Parse(time.RFC3339, string(modRaw))
-						if math.Abs(mod.Sub(*newMod).Seconds()) < 2 {
-							p.Finish(1)
-							continue
-						}
-					} else if !os.IsNotExist(err2) {
-						panic(err2)
-					}
-				} else if !os.IsNotExist(err1) {
-					panic(err1)
-				}
+				errs = append(errs, fmt.Errorf("%s: %v", getFilename(files[i]), err))
 			}
-			data, err := downloadFile(t, c, file)
-			if err != errFileLocked {
+		}
+		return errs.orNil()
+	})
+}
+
+func syncFile(ctx context.Context, t *task.Task, c *canvas.Canvas, db, metaFolderRoot string, file interface{},
+	getFilename func(interface{}) string,
+	determineLastModTime func(context.Context, *task.Task, *canvas.Canvas, interface{}) (*time.Time, error),
+	downloadFile func(context.Context, *task.Task, *canvas.Canvas, interface{}, string) error) error {
+	name := getFilename(file)
+	filename := path.Join(db, name)
+	modFile := path.Join(metaFolderRoot, fmt.Sprintf("%s.txt", name))
+	if err := os.MkdirAll(path.Dir(filename), 0755); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(modFile), 0755); err != nil {
+		return err
+	}
+	newMod, err := determineLastModTime(ctx, t, c, file)
+	if err != nil {
+		return err
+	}
+	if newMod != nil {
+		if _, err1 := os.Stat(filename); err1 == nil {
+			if _, err2 := os.Stat(modFile); err2 == nil {
+				modRaw, err := ioutil.ReadFile(modFile)
 				if err != nil {
-					panic(err)
+					return err
 				}
-				if err = ioutil.WriteFile(filename, data, 0644); err != nil {
-					panic(err)
-				}
-				if newMod != nil {
-					if err = ioutil.WriteFile(modFile, []byte(newMod.Format(time.RFC3339)), 0644); err != nil {
-						panic(err)
-					}
+				mod, err := time.Parse(time.RFC3339, string(modRaw))
+				if err == nil && math.Abs(mod.Sub(*newMod).Seconds()) < 2 {
+					return nil
 				}
+			} else if !os.IsNotExist(err2) {
+				return err2
 			}
-			p.Finish(1)
+		} else if !os.IsNotExist(err1) {
+			return err1
 		}
-		finish()
-	})
+	}
+	err = downloadFile(ctx, t, c, file, filename)
+	if err == errFileLocked || canvas.IsNotCached(err) {
+		// Files that were never saved in the raw cache are left as they are when offline
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if newMod != nil {
+		return ioutil.WriteFile(modFile, []byte(newMod.Format(time.RFC3339)), 0644)
+	}
+	return nil
 }
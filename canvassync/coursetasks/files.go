@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"path"
@@ -24,13 +25,13 @@ type fileEntry struct {
 }
 
 func init() {
-	registerFileStructure("Files", func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerFileStructure("Files", func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
-		files, err := c.FilesListFiles(p, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
+		files, err := c.FilesListFiles(ctx, p, nil, nil, nil, nil, nil, nil, nil, fmt.Sprint(courseId))
 		if err != nil {
 			return nil, err
 		}
-		folders, err := c.FilesListAllFolders(p, fmt.Sprint(courseId))
+		folders, err := c.FilesListAllFolders(ctx, p, fmt.Sprint(courseId))
 		if err != nil {
 			return nil, err
 		}
@@ -68,13 +69,13 @@ func init() {
 	}, func(f interface{}) string {
 		// getFilename
 		return f.(fileEntry).Filename
-	}, func(t *task.Task, c *canvas.Canvas, f interface{}) (*time.Time, error) {
+	}, func(ctx context.Context, t *task.Task, c *canvas.Canvas, f interface{}) (*time.Time, error) {
 		// determineLastModTime
 		return f.(fileEntry).ModTime, nil
-	}, func(t *task.Task, c *canvas.Canvas, f interface{}) ([]byte, error) {
+	}, func(ctx context.Context, t *task.Task, c *canvas.Canvas, f interface{}, filename string) error {
 		// downloadFile
 		file := f.(fileEntry)
-		data, _, err := c.RequestRaw(file.URL, file.ContentType, 10)
-		return data, err
+		_, err := c.Download(ctx, file.URL, file.ContentType, filename, nil)
+		return err
 	})
 }
@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -207,13 +208,13 @@ func (g grades) csv(csv csvgen.CSV) {
 }
 
 func init() {
-	registerCSV("Grades", func(t *task.Task, c *canvas.Canvas, courseId int, csv csvgen.CSV) {
-		groups, err := c.AssignmentGroupsListAssignmentGroups(t.CreateProgress(1), []canvas.AssignmentGroupsListAssignmentGroupsInclude{
+	registerCSV("Grades", func(ctx context.Context, t *task.Task, c *canvas.Canvas, courseId int, csv csvgen.CSV) error {
+		groups, err := c.AssignmentGroupsListAssignmentGroups(ctx, t.CreateProgress(1), []canvas.AssignmentGroupsListAssignmentGroupsInclude{
 			canvas.AssignmentGroupsListAssignmentGroupsIncludeAssignments,
 			canvas.AssignmentGroupsListAssignmentGroupsIncludeSubmission,
 		}, nil, nil, nil, nil, fmt.Sprint(courseId))
 		if err != nil {
-			panic(err)
+			return err
 		}
 		g := &grades{
 			Sections: make([]*gradeSection, len(groups)),
@@ -255,6 +256,7 @@ func init() {
 		}
 		g.calculateRealWeights()
 		g.csv(csv)
+		return nil
 	}, "Assignment Group", "%s", "Assignment Name", "%s", "Due Date", "%s", "Score", "%.0f", "Max Score", "%.0f",
 		"Percentage", "%.2f%%", "Status", "%s", "Total Grade Contribution", "%.2f%%", "Max Grade Contribution", "%.2f%%")
 }
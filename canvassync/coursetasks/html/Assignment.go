@@ -12,6 +12,7 @@ var (
 	// AssignmentChildCtor for parsing a template
 	AssignmentChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
 		return CreateAssignment(), []htmlgen.ChildConstructor{
+			AssignmentRubricChildCtor,
 			AssignmentSubmissionChildCtor,
 		}
 	}
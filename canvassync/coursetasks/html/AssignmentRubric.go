@@ -0,0 +1,79 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	assignmentRubricTemplate *AssignmentRubric
+	// AssignmentRubricChildCtor for parsing a template
+	AssignmentRubricChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateAssignmentRubric(), []htmlgen.ChildConstructor{
+			AssignmentRubricCriterionChildCtor,
+		}
+	}
+)
+
+// AssignmentRubric HTML template
+type AssignmentRubric struct {
+	Score          string
+	PointsPossible float64
+	format         *htmlgen.FormatSection
+}
+
+// CreateAssignmentRubric creates a new template
+func CreateAssignmentRubric() *AssignmentRubric {
+	obj := &AssignmentRubric{}
+	args := []interface{}{
+		htmlgen.FormatSectionChild,
+		&obj.Score,
+		&obj.PointsPossible,
+	}
+	if assignmentRubricTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<h2>Rubric</h2>
+	<table>
+		<tr>
+			<th>Criterion</th>
+			<th>Ratings</th>
+			<th>Rating</th>
+			<th>Points</th>
+			<th>Comments</th>
+		</tr>
+		%s
+	</table>
+	<p>Total: %s / %.2f (<a href="Rubric.csv">download as CSV</a>)</p>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = assignmentRubricTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	assignmentRubricTemplate = CreateAssignmentRubric()
+}
+
+// AppendChild adds a child to the section
+func (t *AssignmentRubric) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *AssignmentRubric) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *AssignmentRubric) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *AssignmentRubric) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
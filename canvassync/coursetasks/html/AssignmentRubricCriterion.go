@@ -0,0 +1,78 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	assignmentRubricCriterionTemplate *AssignmentRubricCriterion
+	// AssignmentRubricCriterionChildCtor for parsing a template
+	AssignmentRubricCriterionChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateAssignmentRubricCriterion(), []htmlgen.ChildConstructor{}
+	}
+)
+
+// AssignmentRubricCriterion HTML template
+type AssignmentRubricCriterion struct {
+	Data     canvas.RubricCriteria
+	Ratings  string
+	Rating   string
+	Points   string
+	Comments string
+	format   *htmlgen.FormatSection
+}
+
+// CreateAssignmentRubricCriterion creates a new template
+func CreateAssignmentRubricCriterion() *AssignmentRubricCriterion {
+	obj := &AssignmentRubricCriterion{}
+	args := []interface{}{
+		&obj.Data.Description,
+		&obj.Data.LongDescription,
+		&obj.Ratings,
+		&obj.Rating,
+		&obj.Points,
+		&obj.Data.Points,
+		&obj.Comments,
+	}
+	if assignmentRubricCriterionTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<tr>
+	<td><strong>%s</strong><br />%s</td>
+	<td>%s</td>
+	<td>%s</td>
+	<td>%s / %.2f</td>
+	<td>%s</td>
+</tr>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = assignmentRubricCriterionTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	assignmentRubricCriterionTemplate = CreateAssignmentRubricCriterion()
+}
+
+// AppendChild adds a child to the section
+func (t *AssignmentRubricCriterion) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *AssignmentRubricCriterion) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *AssignmentRubricCriterion) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *AssignmentRubricCriterion) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
@@ -0,0 +1,79 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	conversationTemplate *Conversation
+	// ConversationChildCtor for parsing a template
+	ConversationChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateConversation(), []htmlgen.ChildConstructor{
+			ConversationMessageChildCtor,
+		}
+	}
+)
+
+// Conversation HTML template
+type Conversation struct {
+	Data         canvas.Conversation
+	Participants string
+	format       *htmlgen.FormatSection
+}
+
+// CreateConversation creates a new template
+func CreateConversation() *Conversation {
+	obj := &Conversation{}
+	args := []interface{}{
+		&obj.Data.Subject,
+		&obj.Participants,
+		&obj.Data.ContextName,
+		htmlgen.FormatSectionChild,
+		htmlgen.CreateDateTimeFormat(&obj.Data.LastMessageAt),
+	}
+	if conversationTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<main>
+		<h1>%s</h1>
+		<h3>Between %s</h3>
+		<p>In %s</p>
+	</main>
+	%s
+	<footer>
+		Last message %s
+	</footer>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = conversationTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	conversationTemplate = CreateConversation()
+}
+
+// AppendChild adds a child to the section
+func (t *Conversation) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *Conversation) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *Conversation) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *Conversation) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
@@ -0,0 +1,65 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	conversationAttachmentTemplate *ConversationAttachment
+	// ConversationAttachmentChildCtor for parsing a template
+	ConversationAttachmentChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateConversationAttachment(), []htmlgen.ChildConstructor{}
+	}
+)
+
+// ConversationAttachment HTML template
+type ConversationAttachment struct {
+	Data   canvas.FileAttachment
+	format *htmlgen.FormatSection
+}
+
+// CreateConversationAttachment creates a new template
+func CreateConversationAttachment() *ConversationAttachment {
+	obj := &ConversationAttachment{}
+	args := []interface{}{
+		&obj.Data.Filename,
+		&obj.Data.DisplayName,
+	}
+	if conversationAttachmentTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<p>Attached file <a href="%s">%s</a>.</p>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = conversationAttachmentTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	conversationAttachmentTemplate = CreateConversationAttachment()
+}
+
+// AppendChild adds a child to the section
+func (t *ConversationAttachment) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *ConversationAttachment) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *ConversationAttachment) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *ConversationAttachment) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
@@ -0,0 +1,72 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	conversationMessageTemplate *ConversationMessage
+	// ConversationMessageChildCtor for parsing a template
+	ConversationMessageChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateConversationMessage(), []htmlgen.ChildConstructor{
+			ConversationAttachmentChildCtor,
+		}
+	}
+)
+
+// ConversationMessage HTML template
+type ConversationMessage struct {
+	Data   canvas.ConversationMessage
+	Author string
+	format *htmlgen.FormatSection
+}
+
+// CreateConversationMessage creates a new template
+func CreateConversationMessage() *ConversationMessage {
+	obj := &ConversationMessage{}
+	args := []interface{}{
+		&obj.Author,
+		htmlgen.CreateDateTimeFormat(&obj.Data.CreatedAt),
+		&obj.Data.Body,
+		htmlgen.FormatSectionChild,
+	}
+	if conversationMessageTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<h3>From %s at %s:</h3>
+	<div style="white-space: pre-wrap">%s</div>
+	%s
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = conversationMessageTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	conversationMessageTemplate = CreateConversationMessage()
+}
+
+// AppendChild adds a child to the section
+func (t *ConversationMessage) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *ConversationMessage) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *ConversationMessage) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *ConversationMessage) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
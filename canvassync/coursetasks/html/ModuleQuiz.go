@@ -0,0 +1,65 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	moduleQuizTemplate *ModuleQuiz
+	// ModuleQuizChildCtor for parsing a template
+	ModuleQuizChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateModuleQuiz(), []htmlgen.ChildConstructor{}
+	}
+)
+
+// ModuleQuiz HTML template, which links a module item to the quiz archived by the Quizzes task
+type ModuleQuiz struct {
+	Href   string
+	Title  string
+	format *htmlgen.FormatSection
+}
+
+// CreateModuleQuiz creates a new template
+func CreateModuleQuiz() *ModuleQuiz {
+	obj := &ModuleQuiz{}
+	args := []interface{}{
+		&obj.Href,
+		&obj.Title,
+	}
+	if moduleQuizTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<p>Quiz <a href="%s">%s</a>.</p>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = moduleQuizTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	moduleQuizTemplate = CreateModuleQuiz()
+}
+
+// AppendChild adds a child to the section
+func (t *ModuleQuiz) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *ModuleQuiz) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *ModuleQuiz) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *ModuleQuiz) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
@@ -0,0 +1,108 @@
+package html
+
+import (
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	quizTemplate *Quiz
+	// QuizChildCtor for parsing a template
+	QuizChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateQuiz(), []htmlgen.ChildConstructor{
+			QuizSubmissionChildCtor,
+		}
+	}
+)
+
+// Quiz HTML template
+type Quiz struct {
+	Data          canvas.Quiz
+	QuizType      string
+	ScoringPolicy string
+	KeptScore     float64
+	LastUpdate    time.Time
+	format        *htmlgen.FormatSection
+}
+
+// CreateQuiz creates a new template
+func CreateQuiz() *Quiz {
+	obj := &Quiz{}
+	args := []interface{}{
+		&obj.Data.Title,
+		&obj.Data.Description,
+		&obj.QuizType,
+		&obj.Data.PointsPossible,
+		&obj.Data.QuestionCount,
+		&obj.Data.TimeLimit,
+		&obj.Data.AllowedAttempts,
+		&obj.ScoringPolicy,
+		htmlgen.FormatSectionChild,
+		&obj.KeptScore,
+		htmlgen.CreateDateTimeFormat(&obj.Data.UnlockAt),
+		htmlgen.CreateDateTimeFormat(&obj.Data.DueAt),
+		htmlgen.CreateDateTimeFormat(&obj.Data.LockAt),
+		&obj.Data.VersionNumber,
+		htmlgen.CreateDateTimeFormat(&obj.LastUpdate),
+	}
+	if quizTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<h1>%s</h1>
+	<main>
+		%s
+	</main>
+	<ul>
+		<li>Quiz type: %s</li>
+		<li>Points possible: %.2f</li>
+		<li>Questions: %d</li>
+		<li>Time limit: %d minutes</li>
+		<li>Allowed attempts: %d</li>
+		<li>Scoring policy: %s</li>
+	</ul>
+	<div>
+		%s
+	</div>
+	<footer>
+		<p>Kept score: %.2f</p>
+		<p>Unlocked at: %s</p>
+		<p>Due at: %s</p>
+		<p>Locked at: %s</p>
+		<p>Version: %d</p>
+		<p>Last submitted at: %s</p>
+	</footer>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = quizTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	quizTemplate = CreateQuiz()
+}
+
+// AppendChild adds a child to the section
+func (t *Quiz) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *Quiz) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *Quiz) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *Quiz) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
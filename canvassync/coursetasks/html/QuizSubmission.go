@@ -0,0 +1,73 @@
+package html
+
+import (
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+)
+
+var (
+	quizSubmissionTemplate *QuizSubmission
+	// QuizSubmissionChildCtor for parsing a template
+	QuizSubmissionChildCtor = func() (htmlgen.Section, []htmlgen.ChildConstructor) {
+		return CreateQuizSubmission(), []htmlgen.ChildConstructor{}
+	}
+)
+
+// QuizSubmission HTML template
+type QuizSubmission struct {
+	Data          canvas.QuizSubmission
+	WorkflowState string
+	format        *htmlgen.FormatSection
+}
+
+// CreateQuizSubmission creates a new template
+func CreateQuizSubmission() *QuizSubmission {
+	obj := &QuizSubmission{}
+	args := []interface{}{
+		&obj.Data.Attempt,
+		&obj.WorkflowState,
+		htmlgen.CreateDateTimeFormat(&obj.Data.StartedAt),
+		htmlgen.CreateDateTimeFormat(&obj.Data.FinishedAt),
+		&obj.Data.TimeSpent,
+		&obj.Data.Score,
+		&obj.Data.FudgePoints,
+	}
+	if quizSubmissionTemplate == nil {
+		var err error
+		if obj.format, err = htmlgen.CreateFormatSection(`
+<div>
+	<p>Attempt #%d (%s)</p>
+	<p>Started at %s and finished at %s (%d seconds)</p>
+	<p>Score: %.2f (%.2f fudge points)</p>
+</div>
+`, args); err != nil {
+			panic(err)
+		}
+	} else {
+		obj.format = quizSubmissionTemplate.format.Clone(args)
+	}
+	return obj
+}
+
+func init() {
+	quizSubmissionTemplate = CreateQuizSubmission()
+}
+
+// AppendChild adds a child to the section
+func (t *QuizSubmission) AppendChild(child htmlgen.Section) {
+	t.format.AppendChild(child)
+}
+
+// Children gets the child elements
+func (t *QuizSubmission) Children() []htmlgen.Section {
+	return t.format.Children()
+}
+
+func (t *QuizSubmission) String() string {
+	return t.format.String()
+}
+
+// Parse the template
+func (t *QuizSubmission) Parse(str string, childCtors []htmlgen.ChildConstructor) (string, bool) {
+	return t.format.Parse(str, childCtors)
+}
@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -13,152 +14,251 @@ import (
 )
 
 func registerHTML(name string, docType htmlgen.ChildConstructor,
-	apiGet func(*task.Progress, *canvas.Canvas, int) ([]interface{}, error),
+	apiGet func(context.Context, *task.Progress, *canvas.Canvas, int) ([]interface{}, error),
 	getFilename func(interface{}) string,
 	isModified func(interface{}, *htmlgen.Document) bool,
-	createDoc func(interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int)) {
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int) error) {
 	registerHTMLWithFileAttachments(name, docType, apiGet, getFilename, func(_ interface{}) []canvas.FileAttachment {
 		return []canvas.FileAttachment{}
 	}, isModified, createDoc)
 }
 
 func registerHTMLWithFileAttachments(name string, docType htmlgen.ChildConstructor,
-	apiGet func(*task.Progress, *canvas.Canvas, int) ([]interface{}, error),
+	apiGet func(context.Context, *task.Progress, *canvas.Canvas, int) ([]interface{}, error),
 	getFilename func(interface{}) string,
 	getAttachments func(interface{}) []canvas.FileAttachment,
 	isModified func(interface{}, *htmlgen.Document) bool,
-	createDoc func(interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int)) {
-	registerHTMLWithAttachments(name, docType, apiGet, getFilename, func(o interface{}) []interface{} {
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int) error) {
+	registerHTMLWithAttachments(name, docType, apiGet, getFilename, fileAttachments(getAttachments), fileAttachmentFilename, downloadFileAttachment, fileAttachmentChanged, isModified, createDoc)
+}
+
+// registerUserHTMLWithFileAttachments is registerHTMLWithFileAttachments for a user task, which gets the IDs of all the
+// courses instead of one course.  The objects from apiGet only need enough to check if they are modified, and getDetails
+// is only called for the ones that are (like when the list API leaves out the attachments).
+func registerUserHTMLWithFileAttachments(name string, docType htmlgen.ChildConstructor,
+	apiGet func(context.Context, *task.Progress, *canvas.Canvas, []int) ([]interface{}, error),
+	getDetails func(context.Context, *task.Progress, *canvas.Canvas, interface{}) (interface{}, error),
+	getFilename func(interface{}) string,
+	getAttachments func(interface{}) []canvas.FileAttachment,
+	isModified func(interface{}, *htmlgen.Document) bool,
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task) error) {
+	registerUser(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseIDs []int) error {
+		return syncHTMLList(ctx, t, c, db, 0, func(p *task.Progress) ([]interface{}, error) {
+			return apiGet(ctx, p, c, courseIDs)
+		}, getDetails, docType, getFilename, fileAttachments(getAttachments), fileAttachmentFilename, downloadFileAttachment, fileAttachmentChanged, isModified, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, _ int) error {
+			return createDoc(ctx, o, doc, c, t)
+		})
+	})
+}
+
+func fileAttachments(getAttachments func(interface{}) []canvas.FileAttachment) func(interface{}) []interface{} {
+	return func(o interface{}) []interface{} {
 		a := getAttachments(o)
 		b := make([]interface{}, len(a))
 		for i, v := range a {
 			b[i] = v
 		}
 		return b
-	}, func(a interface{}) string {
-		str, err := url.QueryUnescape(a.(canvas.FileAttachment).Filename)
-		if err != nil {
-			panic(err)
-		}
-		return InvalidPathRunes.ReplaceAllLiteralString(str, "_")
-	}, func(o interface{}, filename string, c *canvas.Canvas) {
-		a := o.(canvas.FileAttachment)
-		body, _, err := c.RequestRaw(a.URL, a.ContentType, 10)
-		if err != nil {
-			panic(err)
-		}
-		if err := ioutil.WriteFile(filename, body, 0644); err != nil {
-			panic(err)
-		}
-	}, func(a interface{}, filename string) bool {
-		return false
-	}, isModified, createDoc)
+	}
+}
+
+func fileAttachmentFilename(a interface{}) string {
+	str, err := url.QueryUnescape(a.(canvas.FileAttachment).Filename)
+	if err != nil {
+		str = a.(canvas.FileAttachment).Filename
+	}
+	return InvalidPathRunes.ReplaceAllLiteralString(str, "_")
+}
+
+func downloadFileAttachment(ctx context.Context, o interface{}, filename string, c *canvas.Canvas) error {
+	a := o.(canvas.FileAttachment)
+	_, err := c.Download(ctx, a.URL, a.ContentType, filename, nil)
+	if canvas.IsNotCached(err) {
+		// Attachments that were never saved in the raw cache are left out when offline
+		return nil
+	}
+	return err
+}
+
+func fileAttachmentChanged(a interface{}, filename string) bool {
+	return false
 }
 
 func registerHTMLWithAttachments(name string, docType htmlgen.ChildConstructor,
-	apiGet func(*task.Progress, *canvas.Canvas, int) ([]interface{}, error),
+	apiGet func(context.Context, *task.Progress, *canvas.Canvas, int) ([]interface{}, error),
 	getFilename func(interface{}) string,
 	getAttachments func(interface{}) []interface{},
 	getAttachmentFilename func(interface{}) string,
-	downloadAttachment func(interface{}, string, *canvas.Canvas),
+	downloadAttachment func(context.Context, interface{}, string, *canvas.Canvas) error,
 	attachmentChanged func(interface{}, string) bool,
 	isModified func(interface{}, *htmlgen.Document) bool,
-	createDoc func(interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int)) {
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int) error) {
 
-	register(name, func(t *task.Task, c *canvas.Canvas, db string, courseId int, finish func()) {
-		list, err := apiGet(t.CreateProgress(1), c, courseId)
-		if err != nil {
-			if e, ok := err.(canvas.InvalidStatusCodeError); ok && (e.Code == 401 || e.Code == 404) {
-				finish()
-				return
+	register(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int) error {
+		return syncHTMLList(ctx, t, c, db, courseId, func(p *task.Progress) ([]interface{}, error) {
+			return apiGet(ctx, p, c, courseId)
+		}, nil, docType, getFilename, getAttachments, getAttachmentFilename, downloadAttachment, attachmentChanged, isModified, createDoc)
+	})
+}
+
+func syncHTMLList(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int,
+	apiGet func(*task.Progress) ([]interface{}, error),
+	getDetails func(context.Context, *task.Progress, *canvas.Canvas, interface{}) (interface{}, error),
+	docType htmlgen.ChildConstructor,
+	getFilename func(interface{}) string,
+	getAttachments func(interface{}) []interface{},
+	getAttachmentFilename func(interface{}) string,
+	downloadAttachment func(context.Context, interface{}, string, *canvas.Canvas) error,
+	attachmentChanged func(interface{}, string) bool,
+	isModified func(interface{}, *htmlgen.Document) bool,
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int) error) error {
+	list, err := apiGet(t.CreateProgress(1))
+	if err != nil {
+		if e, ok := err.(canvas.InvalidStatusCodeError); ok && (e.Code == 401 || e.Code == 404) {
+			return nil
+		}
+		return err
+	}
+	fileWrites := t.CreateProgress(1)
+	fileWrites.SetWork(len(list))
+	errs := multiError{}
+	for _, obj := range list {
+		if getDetails != nil {
+			if upToDate, err := isHTMLUpToDate(db, obj, docType, getFilename, isModified); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", getFilename(obj), err))
+				fileWrites.Finish(1)
+				continue
+			} else if upToDate {
+				fileWrites.Finish(1)
+				continue
 			}
-			panic(err)
+			details, err := getDetails(ctx, t.CreateProgress(0.01), c, obj)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", getFilename(obj), err))
+				fileWrites.Finish(1)
+				continue
+			}
+			obj = details
 		}
-		fileWrites := t.CreateProgress(1)
-		fileWrites.SetWork(len(list))
-		for _, obj := range list {
-			fileBaseName := path.Join(db, InvalidPathRunes.ReplaceAllLiteralString(getFilename(obj), ""))
-			standaloneFile := fmt.Sprintf("%s.html", fileBaseName)
-			attachedHTMLFile := path.Join(fileBaseName, "index.html")
-			var outFile string
-			var attachments []interface{}
-			if attachments = getAttachments(obj); len(attachments) > 0 {
-				if _, err := os.Stat(standaloneFile); err == nil {
-					if err := os.Remove(standaloneFile); err != nil {
-						panic(err)
-					}
-				}
-				if err := os.Mkdir(fileBaseName, 0755); err != nil && !os.IsExist(err) {
-					panic(err)
-				}
-				outFile = attachedHTMLFile
-			} else {
-				if _, err := os.Stat(fileBaseName); err == nil {
-					if err := os.RemoveAll(fileBaseName); err != nil {
-						panic(err)
-					}
-				}
-				outFile = standaloneFile
+		if err := syncHTML(ctx, t, c, db, courseId, obj, docType, getFilename, getAttachments, getAttachmentFilename, downloadAttachment, attachmentChanged, isModified, createDoc); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", getFilename(obj), err))
+		}
+		fileWrites.Finish(1)
+	}
+	return errs.orNil()
+}
+
+// isHTMLUpToDate checks if the document for an object has already been written and is not modified, whether or not it has
+// attachments
+func isHTMLUpToDate(db string, obj interface{}, docType htmlgen.ChildConstructor,
+	getFilename func(interface{}) string,
+	isModified func(interface{}, *htmlgen.Document) bool) (bool, error) {
+	fileBaseName := path.Join(db, InvalidPathRunes.ReplaceAllLiteralString(getFilename(obj), ""))
+	for _, file := range []string{fmt.Sprintf("%s.html", fileBaseName), path.Join(fileBaseName, "index.html")} {
+		content, err := ioutil.ReadFile(file)
+		if err == nil {
+			if doc := htmlgen.ParseDocument(string(content), []htmlgen.ChildConstructor{docType}); doc != nil {
+				return !isModified(obj, doc), nil
 			}
-			content, err := ioutil.ReadFile(outFile)
-			if err == nil {
-				doc := htmlgen.ParseDocument(string(content), []htmlgen.ChildConstructor{docType})
-				if doc != nil {
-					if !isModified(obj, doc) {
-						fileWrites.Finish(1)
-						continue
-					}
-				}
-			} else if !os.IsNotExist(err) {
-				panic(err)
+		} else if !os.IsNotExist(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func syncHTML(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int, obj interface{}, docType htmlgen.ChildConstructor,
+	getFilename func(interface{}) string,
+	getAttachments func(interface{}) []interface{},
+	getAttachmentFilename func(interface{}) string,
+	downloadAttachment func(context.Context, interface{}, string, *canvas.Canvas) error,
+	attachmentChanged func(interface{}, string) bool,
+	isModified func(interface{}, *htmlgen.Document) bool,
+	createDoc func(context.Context, interface{}, *htmlgen.Document, *canvas.Canvas, *task.Task, int) error) error {
+	fileBaseName := path.Join(db, InvalidPathRunes.ReplaceAllLiteralString(getFilename(obj), ""))
+	standaloneFile := fmt.Sprintf("%s.html", fileBaseName)
+	attachedHTMLFile := path.Join(fileBaseName, "index.html")
+	var outFile string
+	var attachments []interface{}
+	if attachments = getAttachments(obj); len(attachments) > 0 {
+		if _, err := os.Stat(standaloneFile); err == nil {
+			if err := os.Remove(standaloneFile); err != nil {
+				return err
 			}
-			doc := htmlgen.CreateDocument()
-			createDoc(obj, doc, c, t, courseId)
-			if err := ioutil.WriteFile(outFile, []byte(doc.String()), 0644); err != nil {
-				panic(err)
+		}
+		if err := os.Mkdir(fileBaseName, 0755); err != nil && !os.IsExist(err) {
+			return err
+		}
+		outFile = attachedHTMLFile
+	} else {
+		if _, err := os.Stat(fileBaseName); err == nil {
+			if err := os.RemoveAll(fileBaseName); err != nil {
+				return err
 			}
-			if len(attachments) > 0 {
-				filenames := make([]string, len(attachments))
-				for i, a := range attachments {
-					filenames[i] = getAttachmentFilename(a)
-				}
-				dir, err := ioutil.ReadDir(fileBaseName)
-				if err != nil {
-					panic(err)
-				}
-				for _, f := range dir {
-					if f.Name() != "index.html" {
-						found := false
-						for i, af := range filenames {
-							if f.Name() == af {
-								found = true
-								fname := path.Join(fileBaseName, af)
-								if attachmentChanged(attachments[i], fname) {
-									downloadAttachment(attachments[i], fname, c)
-								}
-							}
-						}
-						if !found {
-							if err := os.RemoveAll(path.Join(fileBaseName, f.Name())); err != nil {
-								panic(err)
+		}
+		outFile = standaloneFile
+	}
+	content, err := ioutil.ReadFile(outFile)
+	if err == nil {
+		doc := htmlgen.ParseDocument(string(content), []htmlgen.ChildConstructor{docType})
+		if doc != nil {
+			if !isModified(obj, doc) {
+				return nil
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	doc := htmlgen.CreateDocument()
+	if err := createDoc(ctx, obj, doc, c, t, courseId); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(outFile, []byte(doc.String()), 0644); err != nil {
+		return err
+	}
+	if len(attachments) > 0 {
+		filenames := make([]string, len(attachments))
+		for i, a := range attachments {
+			filenames[i] = getAttachmentFilename(a)
+		}
+		dir, err := ioutil.ReadDir(fileBaseName)
+		if err != nil {
+			return err
+		}
+		for _, f := range dir {
+			if f.Name() != "index.html" {
+				found := false
+				for i, af := range filenames {
+					if f.Name() == af {
+						found = true
+						fname := path.Join(fileBaseName, af)
+						if attachmentChanged(attachments[i], fname) {
+							if err := downloadAttachment(ctx, attachments[i], fname, c); err != nil {
+								return err
 							}
 						}
 					}
 				}
-				for i, af := range filenames {
-					found := false
-					for _, f := range dir {
-						if f.Name() == af {
-							found = true
-						}
-					}
-					if !found {
-						downloadAttachment(attachments[i], path.Join(fileBaseName, af), c)
+				if !found {
+					if err := os.RemoveAll(path.Join(fileBaseName, f.Name())); err != nil {
+						return err
 					}
 				}
 			}
-			fileWrites.Finish(1)
 		}
-		finish()
-	})
+		for i, af := range filenames {
+			found := false
+			for _, f := range dir {
+				if f.Name() == af {
+					found = true
+				}
+			}
+			if !found {
+				if err := downloadAttachment(ctx, attachments[i], path.Join(fileBaseName, af), c); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
 }
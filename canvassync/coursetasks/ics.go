@@ -0,0 +1,31 @@
+package coursetasks
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/icsgen"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+func registerICS(name string, genICS func(context.Context, *task.Task, *canvas.Canvas, int, *icsgen.Calendar) error) {
+	register(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseId int) error {
+		cal := icsgen.CreateCalendar("")
+		if err := genICS(ctx, t, c, courseId, cal); err != nil {
+			return err
+		}
+		return cal.WriteFile(path.Join(db, fmt.Sprintf("%s.ics", name)))
+	})
+}
+
+func registerUserICS(name string, genICS func(context.Context, *task.Task, *canvas.Canvas, []int, *icsgen.Calendar) error) {
+	registerUser(name, func(ctx context.Context, t *task.Task, c *canvas.Canvas, db string, courseIDs []int) error {
+		cal := icsgen.CreateCalendar("")
+		if err := genICS(ctx, t, c, courseIDs, cal); err != nil {
+			return err
+		}
+		return cal.WriteFile(path.Join(db, fmt.Sprintf("%s.ics", name)))
+	})
+}
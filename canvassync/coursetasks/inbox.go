@@ -0,0 +1,114 @@
+package coursetasks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks/html"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+func init() {
+	registerUserHTMLWithFileAttachments("Inbox", html.ConversationChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseIDs []int) ([]interface{}, error) {
+		// apiGet
+		// The default scope is only the inbox, so archived conversations and ones with no replies have to be listed too
+		scopes := []*canvas.ConversationsListConversationsScope{nil, nil, nil}
+		archived := canvas.ConversationsListConversationsScopeArchived
+		sent := canvas.ConversationsListConversationsScopeSent
+		scopes[1], scopes[2] = &archived, &sent
+		p.AddWork(len(scopes))
+		np := task.CreateProgress()
+		seen := map[int]bool{}
+		o := []interface{}{}
+		for _, scope := range scopes {
+			l, err := c.ConversationsListConversations(ctx, np, scope, nil, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range l {
+				if !seen[v.ID] {
+					seen[v.ID] = true
+					o = append(o, v)
+				}
+			}
+			p.Finish(1)
+		}
+		return o, nil
+	}, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, o interface{}) (interface{}, error) {
+		// getDetails
+		conversation := o.(canvas.Conversation)
+		// Archiving the inbox should not change which conversations are unread
+		var autoMarkAsRead interface{} = false
+		res, err := c.ConversationsGetASingleConversation(ctx, p, nil, nil, nil, nil, &autoMarkAsRead, fmt.Sprint(conversation.ID))
+		if err != nil {
+			return nil, err
+		}
+		// Keep the time from the list so that isModified compares the same thing the next time
+		res.LastMessageAt = conversation.LastMessageAt
+		return *res, nil
+	}, func(o interface{}) string {
+		// getFilename
+		conversation := o.(canvas.Conversation)
+		subject := conversation.Subject
+		if len(subject) == 0 {
+			subject = "(No Subject)"
+		}
+		return fmt.Sprintf("%d - %s", conversation.ID, subject)
+	}, func(o interface{}) []canvas.FileAttachment {
+		// getAttachments
+		a := []canvas.FileAttachment{}
+		for _, message := range o.(canvas.Conversation).Messages {
+			a = append(a, message.Attachments...)
+		}
+		return a
+	}, func(o interface{}, doc *htmlgen.Document) bool {
+		// isModified
+		conversation := o.(canvas.Conversation)
+		children := doc.Children()
+		if len(children) > 0 {
+			if c, ok := children[0].(*html.Conversation); ok {
+				if c.Data.LastMessageAt == conversation.LastMessageAt {
+					return false
+				}
+			}
+		}
+		return true
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task) error {
+		// createDoc
+		conversation := o.(canvas.Conversation)
+		doc.Title = conversation.Subject
+		cv := html.CreateConversation()
+		cv.Data = conversation
+		names := make([]string, len(conversation.Participants))
+		for i, u := range conversation.Participants {
+			names[i] = u.Name
+		}
+		cv.Participants = strings.Join(names, ", ")
+		messages := append([]canvas.ConversationMessage{}, conversation.Messages...)
+		sort.SliceStable(messages, func(i, j int) bool {
+			return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+		})
+		for _, message := range messages {
+			m := html.CreateConversationMessage()
+			m.Data = message
+			for _, u := range conversation.Participants {
+				if u.ID == message.AuthorID {
+					m.Author = u.Name
+					break
+				}
+			}
+			for _, attachment := range message.Attachments {
+				at := html.CreateConversationAttachment()
+				at.Data = attachment
+				m.AppendChild(at)
+			}
+			cv.AppendChild(m)
+		}
+		doc.AppendChild(cv)
+		return nil
+	})
+}
@@ -1,21 +1,26 @@
 package coursetasks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/url"
 	"path"
 	"regexp"
 	"time"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks/html"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
 type moduleHandler struct {
 	Types            []string
 	FileExtension    string
-	DetermineModTime func(*task.Task, *canvas.Canvas, canvas.ModuleItem) (*time.Time, interface{}, error)
-	Download         func(*task.Task, *canvas.Canvas, canvas.ModuleItem, interface{}) ([]byte, error)
+	DetermineModTime func(context.Context, *task.Task, *canvas.Canvas, canvas.ModuleItem) (*time.Time, interface{}, error)
+	Download         func(context.Context, *task.Task, *canvas.Canvas, canvas.ModuleItem, interface{}, string) error
 }
 
 type moduleEntry struct {
@@ -32,11 +37,15 @@ var (
 		{
 			Types:         []string{},
 			FileExtension: ".json",
-			DetermineModTime: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
+			DetermineModTime: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
 				return nil, nil, nil
 			},
-			Download: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}) ([]byte, error) {
-				return json.Marshal(item)
+			Download: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}, filename string) error {
+				body, err := json.Marshal(item)
+				if err != nil {
+					return err
+				}
+				return ioutil.WriteFile(filename, body, 0644)
 			},
 		},
 		{
@@ -44,16 +53,34 @@ var (
 				"Page",
 				"Discussion",
 				"Assignment",
-				"Quiz",
 				"SubHeader",
 				"ExternalTool",
 			},
 			FileExtension: ".txt",
-			DetermineModTime: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
+			DetermineModTime: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
+				return nil, nil, nil
+			},
+			Download: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}, filename string) error {
+				return ioutil.WriteFile(filename, []byte(fmt.Sprintf("%s %d\n%s\n", *item.Type, item.ContentID, item.URL)), 0644)
+			},
+		},
+		{
+			Types: []string{
+				"Quiz",
+			},
+			FileExtension: ".html",
+			DetermineModTime: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
 				return nil, nil, nil
 			},
-			Download: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}) ([]byte, error) {
-				return []byte(fmt.Sprintf("%s %d\n%s\n", *item.Type, item.ContentID, item.URL)), nil
+			Download: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}, filename string) error {
+				// The quiz is archived by the Quizzes task, two folders up from the module
+				doc := htmlgen.CreateDocument()
+				doc.Title = item.Title
+				q := html.CreateModuleQuiz()
+				q.Href = fmt.Sprintf("../../Quizzes/%s.html", url.PathEscape(InvalidPathRunes.ReplaceAllLiteralString(quizFilename(item.ContentID, item.Title), "")))
+				q.Title = item.Title
+				doc.AppendChild(q)
+				return ioutil.WriteFile(filename, []byte(doc.String()), 0644)
 			},
 		},
 		{
@@ -61,12 +88,12 @@ var (
 				"File",
 			},
 			FileExtension: "",
-			DetermineModTime: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
+			DetermineModTime: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
 				res := fileIDRegex.FindStringSubmatch(item.URL)
 				if res == nil || len(res) != 2 {
 					return nil, nil, fmt.Errorf("Invalid file URL '%s'", item.URL)
 				}
-				file, err := c.FilesGetFile(t.CreateProgress(0), nil, res[1])
+				file, err := c.FilesGetFile(ctx, t.CreateProgress(0), nil, res[1])
 				if err != nil {
 					return nil, nil, err
 				}
@@ -82,13 +109,13 @@ var (
 				}
 				return nil, nil, nil
 			},
-			Download: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}) ([]byte, error) {
+			Download: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}, filename string) error {
 				if data == nil {
-					return nil, errFileLocked
+					return errFileLocked
 				}
 				d := data.([]string)
-				res, _, err := c.RequestRaw(d[0], d[1], 10)
-				return res, err
+				_, err := c.Download(ctx, d[0], d[1], filename, nil)
+				return err
 			},
 		},
 		{
@@ -96,20 +123,20 @@ var (
 				"ExternalUrl",
 			},
 			FileExtension: ".url",
-			DetermineModTime: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
+			DetermineModTime: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem) (*time.Time, interface{}, error) {
 				return nil, nil, nil
 			},
-			Download: func(t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}) ([]byte, error) {
-				return []byte(fmt.Sprintf("%s\n", item.ExternalURL)), nil
+			Download: func(ctx context.Context, t *task.Task, c *canvas.Canvas, item canvas.ModuleItem, data interface{}, filename string) error {
+				return ioutil.WriteFile(filename, []byte(fmt.Sprintf("%s\n", item.ExternalURL)), 0644)
 			},
 		},
 	}
 )
 
 func init() {
-	registerFileStructure("Modules", func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerFileStructure("Modules", func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
-		modules, err := c.ModulesListModules(p, []canvas.ModulesListModulesInclude{
+		modules, err := c.ModulesListModules(ctx, p, []canvas.ModulesListModulesInclude{
 			canvas.ModulesListModulesIncludeItems,
 		}, nil, nil, fmt.Sprint(courseId))
 		if err != nil {
@@ -150,15 +177,15 @@ func init() {
 	}, func(f interface{}) string {
 		// getFilename
 		return f.(*moduleEntry).Filename
-	}, func(t *task.Task, c *canvas.Canvas, f interface{}) (*time.Time, error) {
+	}, func(ctx context.Context, t *task.Task, c *canvas.Canvas, f interface{}) (*time.Time, error) {
 		// determineLastModTime
 		entry := f.(*moduleEntry)
-		tm, data, err := entry.Handler.DetermineModTime(t, c, entry.Item)
+		tm, data, err := entry.Handler.DetermineModTime(ctx, t, c, entry.Item)
 		entry.Data = data
 		return tm, err
-	}, func(t *task.Task, c *canvas.Canvas, f interface{}) ([]byte, error) {
+	}, func(ctx context.Context, t *task.Task, c *canvas.Canvas, f interface{}, filename string) error {
 		// downloadFile
 		entry := f.(*moduleEntry)
-		return entry.Handler.Download(t, c, entry.Item, entry.Data)
+		return entry.Handler.Download(ctx, t, c, entry.Item, entry.Data, filename)
 	})
 }
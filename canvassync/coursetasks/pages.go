@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
@@ -10,9 +11,9 @@ import (
 )
 
 func init() {
-	registerHTML("Pages", html.PageChildCtor, func(p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+	registerHTML("Pages", html.PageChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
 		// apiGet
-		pages, err := c.PagesListPages(p, nil, nil, nil, nil, fmt.Sprint(courseId))
+		pages, err := c.PagesListPages(ctx, p, nil, nil, nil, nil, fmt.Sprint(courseId))
 		var o []interface{} = nil
 		if pages != nil {
 			o = make([]interface{}, len(pages))
@@ -36,11 +37,11 @@ func init() {
 			}
 		}
 		return true
-	}, func(o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) {
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) error {
 		// createDoc
-		page, err := c.PagesShowPage(t.CreateProgress(1), fmt.Sprint(courseId), o.(canvas.Page).URL)
+		page, err := c.PagesShowPage(ctx, t.CreateProgress(1), fmt.Sprint(courseId), o.(canvas.Page).URL)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		doc.Title = page.Title
 		p := html.CreatePage()
@@ -51,5 +52,6 @@ func init() {
 			p.Editor = page.LastEditedBy.DisplayName
 		}
 		doc.AppendChild(p)
+		return nil
 	})
 }
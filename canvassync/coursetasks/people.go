@@ -1,6 +1,7 @@
 package coursetasks
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,15 +11,15 @@ import (
 )
 
 func init() {
-	registerCSV("People", func(t *task.Task, c *canvas.Canvas, courseId int, csv csvgen.CSV) {
-		users, err := c.CoursesListUsersInCourse(t.CreateProgress(1), nil, nil, nil, nil, nil, []canvas.CoursesListUsersInCourseInclude{
+	registerCSV("People", func(ctx context.Context, t *task.Task, c *canvas.Canvas, courseId int, csv csvgen.CSV) error {
+		users, err := c.CoursesListUsersInCourse(ctx, t.CreateProgress(1), nil, nil, nil, nil, nil, []canvas.CoursesListUsersInCourseInclude{
 			canvas.CoursesListUsersInCourseIncludeEnrollments,
 		}, nil, nil, nil, fmt.Sprint(courseId))
 		if err != nil {
 			if e, ok := err.(canvas.InvalidStatusCodeError); ok && e.Code == 401 {
-				return
+				return nil
 			}
-			panic(err)
+			return err
 		}
 		for _, user := range users {
 			es := make([]string, len(user.Enrollments))
@@ -37,5 +38,6 @@ func init() {
 				csv.AddRow(user.ID, user.Name, "", "", role)
 			}
 		}
+		return nil
 	}, "ID", "%d", "First Name", "%s", "Middle Name", "%s", "Last Name", "%s", "Role", "%s")
 }
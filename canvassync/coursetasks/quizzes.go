@@ -0,0 +1,113 @@
+package coursetasks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks/html"
+	"github.com/zachdeibert/canvas-sync/htmlgen"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+type quizData struct {
+	Quiz        canvas.Quiz
+	Submissions []canvas.QuizSubmission
+	KeptScore   float64
+	LastUpdate  time.Time
+}
+
+// quizFilename gets the name of the document a quiz is archived in, which module items link to
+func quizFilename(id int, title string) string {
+	return fmt.Sprintf("%d - %s", id, title)
+}
+
+func init() {
+	registerHTML("Quizzes", html.QuizChildCtor, func(ctx context.Context, p *task.Progress, c *canvas.Canvas, courseId int) ([]interface{}, error) {
+		// apiGet
+		l, err := c.QuizzesListQuizzesInACourse(ctx, p, nil, fmt.Sprint(courseId))
+		var o []interface{} = nil
+		if l != nil {
+			o = make([]interface{}, len(l))
+			p.AddWork(len(l))
+			// Each quiz has its own submissions, so they are fetched in parallel on the connections shared with the
+			// other tasks
+			results := c.RunParallel(len(l), func(i int) error {
+				defer p.Finish(1)
+				q := quizData{
+					Quiz: l[i],
+				}
+				res, err := c.QuizSubmissionsGetAllQuizSubmissions(ctx, p, nil, fmt.Sprint(courseId), fmt.Sprint(l[i].ID))
+				// Quizzes that are locked or cannot be taken have no submissions to see
+				if err = ignoreForbidden(err); err != nil {
+					return err
+				}
+				if res != nil {
+					q.Submissions = res.QuizSubmissions
+				}
+				sort.Slice(q.Submissions, func(i, j int) bool {
+					return q.Submissions[i].Attempt < q.Submissions[j].Attempt
+				})
+				for _, s := range q.Submissions {
+					q.KeptScore = s.KeptScore
+					if s.StartedAt.After(q.LastUpdate) {
+						q.LastUpdate = s.StartedAt
+					}
+					if s.FinishedAt.After(q.LastUpdate) {
+						q.LastUpdate = s.FinishedAt
+					}
+				}
+				o[i] = q
+				return nil
+			})
+			for _, err := range results {
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		return o, err
+	}, func(o interface{}) string {
+		// getFilename
+		q := o.(quizData)
+		return quizFilename(q.Quiz.ID, q.Quiz.Title)
+	}, func(o interface{}, doc *htmlgen.Document) bool {
+		// isModified
+		quiz := o.(quizData)
+		children := doc.Children()
+		if len(children) > 0 {
+			if q, ok := children[0].(*html.Quiz); ok {
+				if q.Data.VersionNumber == quiz.Quiz.VersionNumber && q.LastUpdate == quiz.LastUpdate && q.KeptScore == quiz.KeptScore {
+					return false
+				}
+			}
+		}
+		return true
+	}, func(ctx context.Context, o interface{}, doc *htmlgen.Document, c *canvas.Canvas, t *task.Task, courseId int) error {
+		// createDoc
+		quiz := o.(quizData)
+		doc.Title = quiz.Quiz.Title
+		q := html.CreateQuiz()
+		q.Data = quiz.Quiz
+		if quiz.Quiz.QuizType != nil {
+			q.QuizType = string(*quiz.Quiz.QuizType)
+		}
+		if quiz.Quiz.ScoringPolicy != nil {
+			q.ScoringPolicy = string(*quiz.Quiz.ScoringPolicy)
+		}
+		q.KeptScore = quiz.KeptScore
+		q.LastUpdate = quiz.LastUpdate
+		for _, submission := range quiz.Submissions {
+			s := html.CreateQuizSubmission()
+			s.Data = submission
+			if submission.WorkflowState != nil {
+				s.WorkflowState = string(*submission.WorkflowState)
+			}
+			q.AppendChild(s)
+		}
+		doc.AppendChild(q)
+		return nil
+	})
+}
@@ -0,0 +1,50 @@
+package coursetasks
+
+import (
+	"context"
+	"sync"
+)
+
+// sharedKey is the context key of the results shared by the tasks of an account
+type sharedKey struct{}
+
+// sharedResults holds results that more than one task needs, like the calendar of a course that is written for the
+// course and again in the calendar of every course, so that they are only fetched once
+type sharedResults struct {
+	mutex   sync.Mutex
+	results map[string]*sharedResult
+}
+
+type sharedResult struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// WithSharedResults creates a context that lets the course and user tasks created with it share results with each
+// other.  Each account needs its own, since the results come from its Canvas.
+func WithSharedResults(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sharedKey{}, &sharedResults{
+		results: map[string]*sharedResult{},
+	})
+}
+
+// getShared gets a result shared by the tasks of an account.  The first task to ask for it calls get, and the others
+// wait for it to finish instead of fetching the same thing again.  Without shared results, get is always called.
+func getShared(ctx context.Context, key string, get func() (interface{}, error)) (interface{}, error) {
+	s, ok := ctx.Value(sharedKey{}).(*sharedResults)
+	if !ok {
+		return get()
+	}
+	s.mutex.Lock()
+	r, ok := s.results[key]
+	if !ok {
+		r = &sharedResult{}
+		s.results[key] = r
+	}
+	s.mutex.Unlock()
+	r.once.Do(func() {
+		r.value, r.err = get()
+	})
+	return r.value, r.err
+}
@@ -1,6 +1,38 @@
 package coursetasks
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+)
 
 // InvalidPathRunes matches runes that are invalid in a path
 var InvalidPathRunes = regexp.MustCompile("[^-_a-zA-Z0-9 ().]+")
+
+// multiError collects the errors from syncing several objects so that one failure does not stop the rest
+type multiError []error
+
+func (e multiError) Error() string {
+	strs := make([]string, len(e))
+	for i, err := range e {
+		strs[i] = err.Error()
+	}
+	return strings.Join(strs, "; ")
+}
+
+// orNil returns nil if no errors were collected, so the result can be returned as an error directly
+func (e multiError) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ignoreForbidden treats the errors for objects the user is not allowed to see as if there were no objects
+func ignoreForbidden(err error) error {
+	if e, ok := err.(canvas.InvalidStatusCodeError); ok && (e.Code == 401 || e.Code == 403 || e.Code == 404) {
+		return nil
+	}
+	return err
+}
@@ -1,6 +1,8 @@
 package canvassync
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -9,34 +11,54 @@ import (
 
 	git "github.com/libgit2/git2go/v30"
 	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
-func databaseCheckTask(c *canvas.Canvas, name chan<- string, dbCh chan<- string) func(*task.Task, func()) {
-	return func(t *task.Task, finish func()) {
+func databaseCheckTask(ctx context.Context, c *canvas.Canvas, opts Options, name chan<- string, dbCh chan<- string) func(*task.Task, func()) {
+	return task.FailOnError(func(t *task.Task) error {
 		p := t.CreateProgress(1)
 		p.SetWork(5)
-		user, err := c.UsersShowUserDetails(t.CreateProgress(1), nil)
+		if opts.Offline {
+			// The user details needed to find the database are in the database's raw cache
+			dbs, err := FindDatabases(opts.Database, c.GetHost())
+			if err != nil {
+				return err
+			}
+			if len(dbs) != 1 {
+				return fmt.Errorf("Offline mode needs exactly one database for %s, but found %d", c.GetHost(), len(dbs))
+			}
+			c.RawSaveFolder = path.Join(dbs[0], ".raw")
+		}
+		user, err := c.UsersShowUserDetails(ctx, t.CreateProgress(1), nil)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		name <- user.Name
-		db := path.Join("db", c.GetSubdomain(), fmt.Sprintf("%d - %s", user.ID, user.Name))
+		host := coursetasks.InvalidPathRunes.ReplaceAllLiteralString(c.GetHost(), "_")
+		db := path.Join(opts.Database, host, fmt.Sprintf("%d - %s", user.ID, user.Name))
 		dbCh <- db
 		p.Finish(1)
+		if opts.DryRun {
+			p.Finish(4)
+			return nil
+		}
 		// Ensure database folder exists
 		if err := os.MkdirAll(db, 0755); err != nil {
-			panic(err)
+			return err
 		}
 		p.Finish(1)
 		// Ensure Git exists
 		g, err := git.OpenRepository(db)
 		if err != nil {
 			if g, err = git.InitRepository(db, false); err != nil {
-				panic(err)
+				return err
 			}
 		}
 		defer g.Free()
+		if err := ensureExcluded(g); err != nil {
+			return err
+		}
 		p.Finish(1)
 		// Check for working directory changes
 		status, err := g.StatusList(&git.StatusOptions{
@@ -44,35 +66,35 @@ func databaseCheckTask(c *canvas.Canvas, name chan<- string, dbCh chan<- string)
 			Flags: git.StatusOptIncludeUntracked,
 		})
 		if err != nil {
-			panic(err)
+			return err
 		}
 		defer status.Free()
 		count, err := status.EntryCount()
 		if err != nil {
-			panic(err)
+			return err
 		}
 		if count != 0 {
-			str := &strings.Builder{}
-			str.WriteString("Database is not clean; Previous run of program did not exit cleanly.\n")
-			for i := 0; i < count; i++ {
-				entry, err := status.ByIndex(i)
-				if err == nil {
-					path := entry.IndexToWorkdir.NewFile.Path
-					if len(path) == 0 {
-						path = entry.IndexToWorkdir.OldFile.Path
+			if !opts.Recover {
+				str := &strings.Builder{}
+				str.WriteString("Database is not clean; Previous run of program did not exit cleanly.\n")
+				for i := 0; i < count; i++ {
+					entry, err := status.ByIndex(i)
+					if err == nil {
+						fmt.Fprintf(str, "  %s\n", getStatusPath(entry))
 					}
-					if len(path) == 0 {
-						path = entry.HeadToIndex.NewFile.Path
-					}
-					if len(path) == 0 {
-						path = entry.HeadToIndex.OldFile.Path
-					}
-					fmt.Fprintf(str, "  %s\n", path)
 				}
+				str.WriteString("Run the recover command or sync with --recover to save these changes to a recovery branch and continue.\n")
+				return errors.New(str.String())
+			}
+			if _, err := recoverRepository(g); err != nil {
+				return err
 			}
-			panic(str.String())
 		}
 		p.Finish(1)
+		if opts.Offline {
+			p.Finish(1)
+			return nil
+		}
 		// Copy old raw request cache over to new folder
 		oldDir := c.RawSaveFolder
 		c.RawSaveFolder = path.Join(db, ".raw")
@@ -86,19 +108,19 @@ func databaseCheckTask(c *canvas.Canvas, name chan<- string, dbCh chan<- string)
 			}
 			return nil
 		}); err != nil {
-			panic(err)
+			return err
 		}
 		for _, file := range files {
 			dest := path.Join(c.RawSaveFolder, file)
 			if err = os.MkdirAll(path.Dir(dest), 0755); err != nil {
-				panic(err)
+				return err
 			}
 			if err = os.Rename(path.Join(oldDir, file), dest); err != nil {
-				panic(err)
+				return err
 			}
 		}
 		p.Finish(1)
 		// Done!
-		finish()
-	}
+		return nil
+	})
 }
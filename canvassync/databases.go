@@ -0,0 +1,30 @@
+package canvassync
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+)
+
+// FindDatabases finds all of the user databases that have been synced from a Canvas instance
+func FindDatabases(root string, host string) ([]string, error) {
+	hostDir := path.Join(root, coursetasks.InvalidPathRunes.ReplaceAllLiteralString(host, "_"))
+	files, err := ioutil.ReadDir(hostDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	dbs := []string{}
+	for _, file := range files {
+		if file.IsDir() {
+			if _, err := os.Stat(path.Join(hostDir, file.Name(), ".git")); err == nil {
+				dbs = append(dbs, path.Join(hostDir, file.Name()))
+			}
+		}
+	}
+	return dbs, nil
+}
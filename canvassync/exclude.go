@@ -0,0 +1,58 @@
+package canvassync
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	git "github.com/libgit2/git2go/v30"
+	"github.com/zachdeibert/canvas-sync/canvas"
+)
+
+// excludePatterns are the files that are kept in the database folder but never committed
+var excludePatterns = []string{
+	fmt.Sprintf("*%s", canvas.PartExtension),
+	fmt.Sprintf("*%s%s", canvas.PartExtension, canvas.PartMetaExtension),
+}
+
+// ensureExcluded adds the exclude patterns to the repository's exclude file, so partial downloads do not make the
+// database unclean
+func ensureExcluded(g *git.Repository) error {
+	filename := path.Join(g.Path(), "info", "exclude")
+	data, err := ioutil.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	lines := strings.Split(string(data), "\n")
+	missing := []string{}
+	for _, pattern := range excludePatterns {
+		found := false
+		for _, line := range lines {
+			if strings.TrimSpace(line) == pattern {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, pattern)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if err = os.MkdirAll(path.Dir(filename), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if len(data) > 0 && !strings.HasSuffix(string(data), "\n") {
+		missing[0] = fmt.Sprintf("\n%s", missing[0])
+	}
+	_, err = fmt.Fprintf(f, "%s\n", strings.Join(missing, "\n"))
+	return err
+}
@@ -0,0 +1,61 @@
+package canvassync
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+
+	git "github.com/libgit2/git2go/v30"
+)
+
+// Export writes the files of a database as they were at a revision (such as "HEAD") into a folder
+func Export(dbPath string, revision string, outDir string) error {
+	db, err := git.OpenRepository(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Free()
+	obj, err := db.RevparseSingle(revision)
+	if err != nil {
+		return err
+	}
+	defer obj.Free()
+	treeObj, err := obj.Peel(git.ObjectTree)
+	if err != nil {
+		return err
+	}
+	defer treeObj.Free()
+	tree, err := treeObj.AsTree()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+	var walkErr error
+	if err = tree.Walk(func(dir string, entry *git.TreeEntry) int {
+		filename := path.Join(outDir, dir, entry.Name)
+		switch entry.Type {
+		case git.ObjectTree:
+			if walkErr = os.MkdirAll(filename, 0755); walkErr != nil {
+				return -1
+			}
+			break
+		case git.ObjectBlob:
+			blob, err := db.LookupBlob(entry.Id)
+			if err != nil {
+				walkErr = err
+				return -1
+			}
+			defer blob.Free()
+			if walkErr = ioutil.WriteFile(filename, blob.Contents(), 0644); walkErr != nil {
+				return -1
+			}
+			break
+		}
+		return 0
+	}); err != nil && walkErr == nil {
+		return err
+	}
+	return walkErr
+}
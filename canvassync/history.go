@@ -0,0 +1,39 @@
+package canvassync
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	git "github.com/libgit2/git2go/v30"
+)
+
+// Log writes the history of syncs in a database, newest first (limit <= 0 means no limit)
+func Log(w io.Writer, dbPath string, limit int) error {
+	db, err := git.OpenRepository(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Free()
+	if empty, err := db.IsHeadUnborn(); err != nil {
+		return err
+	} else if empty {
+		return nil
+	}
+	walk, err := db.Walk()
+	if err != nil {
+		return err
+	}
+	defer walk.Free()
+	walk.Sorting(git.SortTime)
+	if err = walk.PushHead(); err != nil {
+		return err
+	}
+	count := 0
+	return walk.Iterate(func(commit *git.Commit) bool {
+		defer commit.Free()
+		fmt.Fprintf(w, "%s %s\n", commit.Id().String()[0:7], strings.TrimSpace(commit.Summary()))
+		count++
+		return limit <= 0 || count < limit
+	})
+}
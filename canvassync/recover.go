@@ -0,0 +1,143 @@
+package canvassync
+
+import (
+	"fmt"
+	"time"
+
+	git "github.com/libgit2/git2go/v30"
+)
+
+const recoveryBranchPrefix = "recovery/"
+
+func syncSignature() *git.Signature {
+	return &git.Signature{
+		Name:  "Canvas Sync Utility",
+		Email: "zachdeibert@gmail.com",
+		When:  time.Now(),
+	}
+}
+
+// Recover saves any uncommitted changes in a database to a recovery branch and resets the working tree to the last
+// sync, returning the name of the branch or an empty string if the database was already clean
+func Recover(dbPath string) (string, error) {
+	db, err := git.OpenRepository(dbPath)
+	if err != nil {
+		return "", err
+	}
+	defer db.Free()
+	status, err := db.StatusList(&git.StatusOptions{
+		Show:  git.StatusShowIndexAndWorkdir,
+		Flags: git.StatusOptIncludeUntracked,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer status.Free()
+	count, err := status.EntryCount()
+	if err != nil {
+		return "", err
+	}
+	if count == 0 {
+		return "", nil
+	}
+	return recoverRepository(db)
+}
+
+func recoverRepository(db *git.Repository) (string, error) {
+	// Commit everything in the working tree to a new branch
+	index, err := db.Index()
+	if err != nil {
+		return "", err
+	}
+	defer index.Free()
+	if err = index.AddAll([]string{}, git.IndexAddDefault, nil); err != nil {
+		return "", err
+	}
+	if err = index.UpdateAll([]string{}, nil); err != nil {
+		return "", err
+	}
+	if err = index.Write(); err != nil {
+		return "", err
+	}
+	treeID, err := index.WriteTree()
+	if err != nil {
+		return "", err
+	}
+	tree, err := db.LookupTree(treeID)
+	if err != nil {
+		return "", err
+	}
+	defer tree.Free()
+	unborn, err := db.IsHeadUnborn()
+	if err != nil {
+		return "", err
+	}
+	var head *git.Commit
+	parents := []*git.Commit{}
+	if !unborn {
+		ref, err := db.Head()
+		if err != nil {
+			return "", err
+		}
+		defer ref.Free()
+		if head, err = db.LookupCommit(ref.Target()); err != nil {
+			return "", err
+		}
+		defer head.Free()
+		parents = []*git.Commit{
+			head,
+		}
+	}
+	sig := syncSignature()
+	branch, err := recoveryBranchName(db, sig.When)
+	if err != nil {
+		return "", err
+	}
+	if _, err = db.CreateCommit("refs/heads/"+branch, sig, sig, fmt.Sprintf("Recovered unclean database at %s", sig.When.Format("Mon Jan 2 2006 15:04:05 MST")), tree, parents...); err != nil {
+		return "", err
+	}
+	// Reset the working tree back to the last sync
+	var target *git.Tree
+	if head != nil {
+		if target, err = head.Tree(); err != nil {
+			return "", err
+		}
+	} else {
+		builder, err := db.TreeBuilder()
+		if err != nil {
+			return "", err
+		}
+		defer builder.Free()
+		emptyID, err := builder.Write()
+		if err != nil {
+			return "", err
+		}
+		if target, err = db.LookupTree(emptyID); err != nil {
+			return "", err
+		}
+	}
+	defer target.Free()
+	if err = db.CheckoutTree(target, &git.CheckoutOpts{
+		Strategy: git.CheckoutForce | git.CheckoutRemoveUntracked,
+	}); err != nil {
+		return "", err
+	}
+	return branch, nil
+}
+
+// recoveryBranchName gets the name of a new recovery branch from the time of the recovery, with a counter added if
+// another recovery was made in the same second
+func recoveryBranchName(db *git.Repository, when time.Time) (string, error) {
+	base := recoveryBranchPrefix + when.Format("2006-01-02-150405")
+	branch := base
+	for i := 2; ; i++ {
+		ref, err := db.References.Lookup("refs/heads/" + branch)
+		if git.IsErrorCode(err, git.ErrNotFound) {
+			return branch, nil
+		} else if err != nil {
+			return "", err
+		}
+		ref.Free()
+		branch = fmt.Sprintf("%s-%d", base, i)
+	}
+}
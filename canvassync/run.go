@@ -1,92 +1,201 @@
 package canvassync
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
-type taskPanic struct {
+type taskFailure struct {
 	t   *task.Task
-	err interface{}
+	err error
 }
 
-// Run the Canvas Sync program
-func Run(c *canvas.Canvas) {
+// Account is a Canvas account to sync and what to sync from it
+type Account struct {
+	// Canvas is the API of the account
+	Canvas *canvas.Canvas
+	// Courses limits the sync to the courses matching these IDs or names (all courses if empty)
+	Courses []string
+	// ExcludeCourses prevents the courses matching these IDs or names from being synced
+	ExcludeCourses []string
+	// Tasks limits the sync to the course and user tasks with these names (all tasks if empty)
+	Tasks []string
+	// CourseTasks overrides Tasks for the courses matching an ID or name, using the first one that matches
+	CourseTasks []CourseTasks
+}
+
+// CourseTasks sets the tasks to run for the courses matching an ID or name
+type CourseTasks struct {
+	Course string
+	Tasks  []string
+}
+
+// Options controls what a sync does
+type Options struct {
+	// Database is the folder that all of the databases are stored in
+	Database string
+	// Jobs is the number of course tasks to run at the same time for each account
+	Jobs int
+	// DryRun only discovers what would be synced without downloading or committing anything
+	DryRun bool
+	// Recover saves the changes left in an unclean database to a recovery branch and continues instead of failing
+	Recover bool
+	// Timeout is how long the whole sync may run before it is stopped (no limit if zero)
+	Timeout time.Duration
+	// Offline reads every response from the raw cache in the database instead of making requests
+	Offline bool
+}
+
+// Run the Canvas Sync program, returning an error summarizing any tasks that failed.  The first interrupt signal stops
+// any new requests from being made and commits what was already downloaded, and the second stops immediately.
+func Run(accounts []Account, opts Options) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if opts.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, opts.Timeout)
+		defer cancelTimeout()
+	}
 	dummyRoot := task.CreateRootTask()
-	dummyExit := make(chan interface{})
 	root := dummyRoot.CreateSubtask("Dummy", func(t *task.Task, finish func()) {
-		<-dummyExit
 		finish()
 	})
-	panicCh := make(chan taskPanic)
-	root.AddPanicListener(func(src *task.Task, err interface{}) {
-		select {
-		case panicCh <- taskPanic{
+	failures := []taskFailure{}
+	failuresMutex := sync.Mutex{}
+	root.AddFailListener(func(src *task.Task, err error) {
+		failuresMutex.Lock()
+		defer failuresMutex.Unlock()
+		failures = append(failures, taskFailure{
 			t:   src,
 			err: err,
-		}:
-			break
-		default:
-			fmt.Fprintln(os.Stderr, "Unable to send panic to pipe")
-			panic(err)
-		}
+		})
 	})
-	name := make(chan string)
-	dbCh := make(chan string)
-	root.CreateSubtask("Database Check", databaseCheckTask(c, name, dbCh))
-	coursesCh := make(chan []courseDiscoveryResult)
-	root.CreateSubtask("Course Discovery", courseDiscoveryTask(c, coursesCh))
+	taskLimit := 3
+	if opts.Jobs > 0 {
+		taskLimit = opts.Jobs
+	} else if os.Getenv("DEBUG") == "true" {
+		taskLimit = 1
+	}
+	names := make(chan accountName)
+	plans := make(chan accountPlan)
+	for i, a := range accounts {
+		root.CreateSubtask(fmt.Sprintf("Sync %s", a.Canvas.GetHost()), accountTask(ctx, a, opts, taskLimit, i, names, plans))
+	}
 	mon := task.CreateMonitor(root)
 	defer mon.Close()
 	header := mon.GetHeader()
-	header.SetSize(3)
+	header.SetSize(2 + len(accounts))
 	header.SetText(0, task.AlignCenter, "Canvas Sync Utility")
-	header.SetText(1, task.AlignCenter, c.GetBaseURL())
-	taskLimit := 3
-	if os.Getenv("DEBUG") == "true" {
-		taskLimit = 1
+	for i, a := range accounts {
+		header.SetText(1+i, task.AlignCenter, a.Canvas.GetBaseURL())
 	}
-	manager := task.CreateManager(dummyRoot, []int{0, 1, 1, taskLimit}, 0)
-	ch := make(chan os.Signal)
+	manager := task.CreateManager(dummyRoot, []int{0, 1, len(accounts)}, 0)
+	done := make(chan interface{}, 1)
 	manager.AddListener(func() {
-		ch <- nil
+		select {
+		case done <- nil:
+			break
+		default:
+			break
+		}
 	})
-	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
 	rlTimer := time.NewTicker(time.Second / 10)
 	if !mon.IsInteractive() {
 		rlTimer.Stop()
 	}
-	var db string
+	donePlans := []accountPlan{}
 	for {
 		select {
-		case <-ch:
+		case <-done:
 			rlTimer.Stop()
-			return
-		case n := <-name:
-			header.SetText(1, task.AlignLeft, n)
-			break
-		case db = <-dbCh:
-			break
-		case courses := <-coursesCh:
-			for _, course := range courses {
-				root.CreateSubtask(fmt.Sprintf("Sync '%s'", course.name), courseTaskGroup(c, db, course)).Start()
+			mon.Close()
+			if opts.DryRun {
+				for _, plan := range donePlans {
+					printPlan(os.Stdout, plan)
+				}
+			}
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("Sync stopped before finishing (%v); everything downloaded so far was saved", err)
+			}
+			failuresMutex.Lock()
+			defer failuresMutex.Unlock()
+			return summarizeFailures(root, failures)
+		case <-signals:
+			if ctx.Err() == nil {
+				cancel()
+				header.SetText(0, task.AlignCenter, "Canvas Sync Utility (stopping; interrupt again to quit now)")
+				break
 			}
-			root.CreateSubtask("Write Database to Disk", writeDatabaseTask(db))
-			dummyExit <- nil
+			rlTimer.Stop()
+			mon.Close()
+			return errors.New("Sync interrupted; run the recover command to clean up the database")
+		case n := <-names:
+			header.SetText(1+n.index, task.AlignLeft, n.name)
+			break
+		case plan := <-plans:
+			donePlans = append(donePlans, plan)
 			break
 		case <-rlTimer.C:
-			header.SetText(1, task.AlignRight, fmt.Sprintf("Quota: %.3f", c.GetQuotaAvailable()))
+			for i, a := range accounts {
+				header.SetText(1+i, task.AlignRight, a.Canvas.GetRateLimitState().String())
+			}
 			break
-		case err := <-panicCh:
-			mon.Close()
-			fmt.Fprintf(os.Stderr, "Panic in task %s\n", err.t.GetName(root))
-			panic(err.err)
+		}
+	}
+}
+
+func summarizeFailures(root *task.Task, failures []taskFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	str := &strings.Builder{}
+	if len(failures) == 1 {
+		str.WriteString("1 task failed:")
+	} else {
+		fmt.Fprintf(str, "%d tasks failed:", len(failures))
+	}
+	for _, f := range failures {
+		fmt.Fprintf(str, "\n  %s: %v", f.t.GetName(root), f.err)
+	}
+	return errors.New(str.String())
+}
+
+func (a Account) tasksFor(course courseDiscoveryResult) []string {
+	for _, t := range a.CourseTasks {
+		if matchCourse([]string{t.Course}, course.id, course.name) {
+			return t.Tasks
+		}
+	}
+	return a.Tasks
+}
+
+func printPlan(w io.Writer, plan accountPlan) {
+	fmt.Fprintf(w, "Database: %s\n", plan.db)
+	for _, course := range plan.courses {
+		fmt.Fprintf(w, "Would sync course %d '%s':\n", course.id, course.name)
+		for _, name := range coursetasks.FilterTaskNames(plan.account.tasksFor(course)) {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+	}
+	if names := coursetasks.FilterUserTaskNames(plan.account.Tasks); len(names) > 0 {
+		fmt.Fprintln(w, "Would sync for the user:")
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s\n", name)
 		}
 	}
 }
@@ -0,0 +1,439 @@
+package canvassync
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	git "github.com/libgit2/git2go/v30"
+	"github.com/zachdeibert/canvas-sync/canvas/canvastest"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+)
+
+// testCourse is the folder the course in the default fixtures is synced into
+var testCourse = fmt.Sprintf("%d - Introduction to Testing", canvastest.DefaultCourseID)
+
+// syncTest is a temporary folder of databases that are synced from a fake Canvas server
+type syncTest struct {
+	t      *testing.T
+	server *canvastest.Server
+	dir    string
+}
+
+func createSyncTest(t *testing.T, fixtures *canvastest.Fixtures) *syncTest {
+	dir, err := ioutil.TempDir("", "canvas-sync-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &syncTest{
+		t:      t,
+		server: canvastest.CreateServer(fixtures),
+		dir:    dir,
+	}
+}
+
+func (s *syncTest) close() {
+	s.server.Close()
+	os.RemoveAll(s.dir)
+}
+
+// sync runs the tasks (or all tasks if there are none) with a new Canvas object, like each run of the program does
+func (s *syncTest) sync(tasks ...string) {
+	raw, err := ioutil.TempDir(s.dir, "raw")
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	c, err := s.server.CreateCanvas(raw)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	s.syncAccount(Account{
+		Canvas: c,
+		Tasks:  tasks,
+	})
+}
+
+func (s *syncTest) syncAccount(a Account) {
+	s.syncWith(a, Options{})
+}
+
+// syncOffline runs the tasks from the raw cache in the database instead of the fake server
+func (s *syncTest) syncOffline(tasks ...string) {
+	c, err := s.server.CreateCanvas("")
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	c.Offline = true
+	s.syncWith(Account{
+		Canvas: c,
+		Tasks:  tasks,
+	}, Options{
+		Offline: true,
+	})
+}
+
+func (s *syncTest) syncWith(a Account, opts Options) {
+	opts.Database = path.Join(s.dir, "db")
+	if err := Run([]Account{a}, opts); err != nil {
+		s.t.Fatal(err)
+	}
+}
+
+// database gets the folder the user in the default fixtures is synced into
+func (s *syncTest) database() string {
+	host := coursetasks.InvalidPathRunes.ReplaceAllLiteralString(s.server.Listener.Addr().String(), "_")
+	return path.Join(s.dir, "db", host, fmt.Sprintf("%d - Test Student", canvastest.DefaultUserID))
+}
+
+// files lists the files in the database, except for the Git folder and the raw cache
+func (s *syncTest) files() []string {
+	db := s.database()
+	files := []string{}
+	if err := filepath.Walk(db, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && (info.Name() == ".git" || info.Name() == ".raw") {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() {
+			rel, err := filepath.Rel(db, p)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	}); err != nil {
+		s.t.Fatal(err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// removeFiles deletes files from the database and commits it, like a sync where they were deleted from Canvas
+func (s *syncTest) removeFiles(names ...string) {
+	db, err := git.OpenRepository(s.database())
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer db.Free()
+	index, err := db.Index()
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer index.Free()
+	for _, name := range names {
+		if err = os.Remove(path.Join(s.database(), name)); err != nil {
+			s.t.Fatal(err)
+		}
+		if err = index.RemoveByPath(name); err != nil {
+			s.t.Fatal(err)
+		}
+	}
+	treeID, err := index.WriteTree()
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	if err = index.Write(); err != nil {
+		s.t.Fatal(err)
+	}
+	tree, err := db.LookupTree(treeID)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer tree.Free()
+	head, err := db.Head()
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer head.Free()
+	parent, err := db.LookupCommit(head.Target())
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer parent.Free()
+	sig := syncSignature()
+	if _, err = db.CreateCommit("HEAD", sig, sig, "Canvas Sync at removal", tree, parent); err != nil {
+		s.t.Fatal(err)
+	}
+}
+
+// checkFiles checks that the database has exactly the expected files, that each one contains its text and that all of
+// them were committed
+func (s *syncTest) checkFiles(expected map[string]string) {
+	names := []string{}
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if files := s.files(); !reflect.DeepEqual(files, names) {
+		s.t.Fatalf("Expected files:\n  %s\nbut got:\n  %s", strings.Join(names, "\n  "), strings.Join(files, "\n  "))
+	}
+	db, err := git.OpenRepository(s.database())
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	defer db.Free()
+	for _, name := range names {
+		data, err := ioutil.ReadFile(path.Join(s.database(), name))
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		if !strings.Contains(string(data), expected[name]) {
+			s.t.Errorf("Expected %s to contain %q, but it is:\n%s", name, expected[name], data)
+		}
+		obj, err := db.RevparseSingle(fmt.Sprintf("HEAD:%s", name))
+		if err != nil {
+			s.t.Errorf("%s was not committed: %v", name, err)
+			continue
+		}
+		obj.Free()
+	}
+}
+
+// checkCommits checks the number of syncs in the history of the database and that nothing was left uncommitted
+func (s *syncTest) checkCommits(count int) {
+	log := &strings.Builder{}
+	if err := Log(log, s.database(), 0); err != nil {
+		s.t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	if len(lines) != count {
+		s.t.Errorf("Expected %d commits, but got:\n%s", count, log)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, " Canvas Sync at ") {
+			s.t.Errorf("Unexpected commit %q", line)
+		}
+	}
+	status := &strings.Builder{}
+	if err := Status(status, s.database()); err != nil {
+		s.t.Fatal(err)
+	}
+	if !strings.Contains(status.String(), "Working tree is clean") {
+		s.t.Errorf("Database has uncommitted changes:\n%s", status)
+	}
+}
+
+func TestSyncTasks(t *testing.T) {
+	for _, test := range []struct {
+		task  string
+		files map[string]string
+	}{
+		{"Files", map[string]string{
+			testCourse + "/Files/syllabus.txt":               "Read the syllabus.",
+			testCourse + "/Files/.syncmeta/syllabus.txt.txt": "2020-08-01T12:00:00Z",
+		}},
+		{"Grades", map[string]string{
+			testCourse + "/Grades/Grades.csv": ",First Assignment,9/1/20 11:59:00 PM,9,10,90.00%",
+		}},
+		{"Assignments", map[string]string{
+			testCourse + "/Assignments/201 - First Assignment/index.html": "<p>Write a test.</p>",
+			testCourse + "/Assignments/201 - First Assignment/Rubric.csv": "Coverage,Tests cover the code",
+		}},
+		{"Announcements", map[string]string{
+			testCourse + "/Announcements/802 - First Day.html": "<p>Class starts today.</p>",
+		}},
+		{"Discussions", map[string]string{
+			testCourse + "/Discussions/801 - Introductions/index.html":   "<p>Introduce yourself.</p>",
+			testCourse + "/Discussions/801 - Introductions/syllabus.txt": "Read the syllabus.",
+		}},
+		{"Pages", map[string]string{
+			testCourse + "/Pages/welcome.html": "<p>Welcome to the course!</p>",
+		}},
+		{"Modules", map[string]string{
+			testCourse + "/Modules/501 - Week 1/1 - Syllabus":               "Read the syllabus.",
+			testCourse + "/Modules/.syncmeta/501 - Week 1/1 - Syllabus.txt": "2020-08-01T12:00:00Z",
+			testCourse + "/Modules/501 - Week 1/2 - Welcome.txt":            "/api/v1/courses/101/pages/welcome",
+			testCourse + "/Modules/501 - Week 1/3 - Canvas Guides.url":      "https://guides.instructure.com/",
+			testCourse + "/Modules/501 - Week 1/4 - First Quiz.html":        `<a href="../../Quizzes/601%20-%20First%20Quiz.html">First Quiz</a>`,
+		}},
+		{"People", map[string]string{
+			testCourse + "/People/People.csv": "2,Test,,Teacher,Teacher",
+		}},
+		{"Quizzes", map[string]string{
+			testCourse + "/Quizzes/601 - First Quiz.html": "Score: 4.00",
+		}},
+		{"Calendar", map[string]string{
+			testCourse + "/Calendar/Calendar.ics": "SUMMARY:First Assignment due",
+			"Calendar/Calendar.ics":               "SUMMARY:Study for the quiz",
+		}},
+		{"Inbox", map[string]string{
+			"Inbox/701 - Question about the assignment/index.html":   "When is the first assignment due?",
+			"Inbox/701 - Question about the assignment/syllabus.txt": "Read the syllabus.",
+		}},
+	} {
+		t.Run(test.task, func(t *testing.T) {
+			s := createSyncTest(t, canvastest.DefaultFixtures())
+			defer s.close()
+			s.sync(test.task)
+			s.checkFiles(test.files)
+			s.checkCommits(1)
+		})
+	}
+}
+
+func TestSyncUpdate(t *testing.T) {
+	s := createSyncTest(t, canvastest.DefaultFixtures())
+	defer s.close()
+	s.sync("Pages")
+	s.checkCommits(1)
+	page := `{
+		"url": "welcome",
+		"title": "Welcome",
+		"created_at": "2020-08-01T12:00:00Z",
+		"updated_at": "2020-08-08T12:00:00Z",
+		"published": true,
+		"front_page": true%s
+	}`
+	course := fmt.Sprintf("/api/v1/courses/%d", canvastest.DefaultCourseID)
+	s.server.Fixtures.AddPages(course+"/pages", fmt.Sprintf("[%s]", fmt.Sprintf(page, "")))
+	s.server.Fixtures.AddJSON(course+"/pages/welcome", fmt.Sprintf(page, `,
+		"body": "<p>The first week is cancelled.</p>"`))
+	s.sync("Pages")
+	s.checkFiles(map[string]string{
+		testCourse + "/Pages/welcome.html": "<p>The first week is cancelled.</p>",
+	})
+	s.checkCommits(2)
+}
+
+func TestSyncRecordedFixtures(t *testing.T) {
+	s := createSyncTest(t, canvastest.DefaultFixtures())
+	defer s.close()
+	raw, err := ioutil.TempDir(s.dir, "raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.server.CreateCanvas(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures := path.Join(s.dir, "fixtures")
+	recorder, err := canvastest.CreateRecorder(fixtures, s.server.GetBaseURL(), s.server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetTransport(recorder)
+	s.syncAccount(Account{
+		Canvas: c,
+	})
+	expected := s.files()
+	s.server.Close()
+
+	// Sync again from the recorded fixtures, which should give the same files
+	f, err := canvastest.LoadFixtures(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.server = canvastest.CreateServer(f)
+	s.sync()
+	if files := s.files(); !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected files:\n  %s\nbut got:\n  %s", strings.Join(expected, "\n  "), strings.Join(files, "\n  "))
+	}
+	s.checkCommits(1)
+}
+
+func TestSyncOfflineUncachedFile(t *testing.T) {
+	s := createSyncTest(t, canvastest.DefaultFixtures())
+	defer s.close()
+	s.sync("Files", "Pages")
+	// The file is binary, so it was never saved in the raw cache
+	s.removeFiles(testCourse+"/Files/syllabus.txt", testCourse+"/Files/.syncmeta/syllabus.txt.txt")
+	s.syncOffline("Files", "Pages")
+	s.checkFiles(map[string]string{
+		testCourse + "/Pages/welcome.html": "<p>Welcome to the course!</p>",
+	})
+	s.checkCommits(2)
+}
+
+// validationTransport records the If-None-Match header sent for each path and the status code of the response
+type validationTransport struct {
+	http.RoundTripper
+	etags    map[string]string
+	statuses map[string]int
+	mutex    sync.Mutex
+}
+
+func (v *validationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := v.RoundTripper.RoundTrip(req)
+	if err == nil {
+		v.mutex.Lock()
+		v.etags[req.URL.Path] = req.Header.Get("If-None-Match")
+		v.statuses[req.URL.Path] = res.StatusCode
+		v.mutex.Unlock()
+	}
+	return res, err
+}
+
+func TestSyncNotModified(t *testing.T) {
+	s := createSyncTest(t, canvastest.DefaultFixtures())
+	defer s.close()
+	s.sync("Pages")
+
+	// The second sync revalidates the responses in the raw cache of the database
+	raw, err := ioutil.TempDir(s.dir, "raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.server.CreateCanvas(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := &validationTransport{
+		RoundTripper: s.server.Client().Transport,
+		etags:        map[string]string{},
+		statuses:     map[string]int{},
+	}
+	c.SetTransport(transport)
+	s.syncAccount(Account{
+		Canvas: c,
+		Tasks:  []string{"Pages"},
+	})
+	pages := fmt.Sprintf("/api/v1/courses/%d/pages", canvastest.DefaultCourseID)
+	if etag := s.server.Fixtures.Responses[pages].Header.Get("ETag"); transport.etags[pages] != etag {
+		t.Errorf("Expected If-None-Match: %s, but got %q", etag, transport.etags[pages])
+	}
+	if transport.statuses[pages] != http.StatusNotModified {
+		t.Errorf("Expected %d, but got %d", http.StatusNotModified, transport.statuses[pages])
+	}
+	s.checkFiles(map[string]string{
+		testCourse + "/Pages/welcome.html": "<p>Welcome to the course!</p>",
+	})
+	s.checkCommits(1)
+}
+
+func TestSyncCalendarFetchesCoursesOnce(t *testing.T) {
+	s := createSyncTest(t, canvastest.DefaultFixtures())
+	defer s.close()
+	s.sync("Calendar")
+	// The calendar of every course is built from the calendars of the courses
+	counts := map[string]int{}
+	for _, r := range s.server.Requests() {
+		for _, endpoint := range []string{"/api/v1/calendar_events", fmt.Sprintf("/api/v1/courses/%d/assignments", canvastest.DefaultCourseID)} {
+			if strings.HasPrefix(r, endpoint) && strings.Contains(r, fmt.Sprint(canvastest.DefaultCourseID)) {
+				counts[endpoint]++
+			}
+		}
+	}
+	for endpoint, count := range counts {
+		if count != 1 {
+			t.Errorf("Expected %s to be requested for the course once, but it was requested %d times", endpoint, count)
+		}
+	}
+	if len(counts) != 2 {
+		t.Errorf("Expected the course calendar to be requested, but got %v", counts)
+	}
+	s.checkFiles(map[string]string{
+		testCourse + "/Calendar/Calendar.ics": "SUMMARY:First Assignment due",
+		"Calendar/Calendar.ics":               "SUMMARY:First Assignment due",
+	})
+}
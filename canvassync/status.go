@@ -0,0 +1,68 @@
+package canvassync
+
+import (
+	"fmt"
+	"io"
+
+	git "github.com/libgit2/git2go/v30"
+)
+
+func getStatusPath(entry git.StatusEntry) string {
+	path := entry.IndexToWorkdir.NewFile.Path
+	if len(path) == 0 {
+		path = entry.IndexToWorkdir.OldFile.Path
+	}
+	if len(path) == 0 {
+		path = entry.HeadToIndex.NewFile.Path
+	}
+	if len(path) == 0 {
+		path = entry.HeadToIndex.OldFile.Path
+	}
+	return path
+}
+
+// Status writes a summary of the state of a database
+func Status(w io.Writer, dbPath string) error {
+	db, err := git.OpenRepository(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Free()
+	fmt.Fprintf(w, "Database: %s\n", dbPath)
+	head, _, err := db.RevparseExt("HEAD")
+	if err != nil || head == nil {
+		fmt.Fprintln(w, "  Never synced")
+	} else {
+		defer head.Free()
+		commit, err := db.LookupCommit(head.Id())
+		if err != nil {
+			return err
+		}
+		defer commit.Free()
+		fmt.Fprintf(w, "  Last sync: %s (%s)\n", commit.Committer().When.Format("Mon Jan 2 2006 15:04:05 MST"), commit.Id().String()[0:7])
+	}
+	status, err := db.StatusList(&git.StatusOptions{
+		Show:  git.StatusShowIndexAndWorkdir,
+		Flags: git.StatusOptIncludeUntracked,
+	})
+	if err != nil {
+		return err
+	}
+	defer status.Free()
+	count, err := status.EntryCount()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		fmt.Fprintln(w, "  Working tree is clean")
+		return nil
+	}
+	fmt.Fprintf(w, "  %d uncommitted changes:\n", count)
+	for i := 0; i < count; i++ {
+		entry, err := status.ByIndex(i)
+		if err == nil {
+			fmt.Fprintf(w, "    %s\n", getStatusPath(entry))
+		}
+	}
+	return nil
+}
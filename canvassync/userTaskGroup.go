@@ -0,0 +1,33 @@
+package canvassync
+
+import (
+	"context"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+func userTaskGroup(ctx context.Context, c *canvas.Canvas, db string, courses []courseDiscoveryResult, tasks []string) func(*task.Task, func()) {
+	return func(t *task.Task, finish func()) {
+		t.InheritProgress()
+		var done int = 0
+		courseIDs := make([]int, len(courses))
+		for i, course := range courses {
+			courseIDs[i] = course.id
+		}
+		children := coursetasks.CreateUserTasks(ctx, t, c, db, courseIDs, tasks)
+		if len(children) == 0 {
+			finish()
+			return
+		}
+		listener := func(_ *task.Task) {
+			if done++; done == len(children) {
+				finish()
+			}
+		}
+		for _, child := range children {
+			child.AddFinishListener(listener)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package canvassync
+
+import (
+	"fmt"
+	"io"
+
+	git "github.com/libgit2/git2go/v30"
+)
+
+// Verify checks that a database is clean and that every object in its history can be read, writing any problems
+// found and returning the number of problems
+func Verify(w io.Writer, dbPath string) (int, error) {
+	db, err := git.OpenRepository(dbPath)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Free()
+	problems := 0
+	status, err := db.StatusList(&git.StatusOptions{
+		Show:  git.StatusShowIndexAndWorkdir,
+		Flags: git.StatusOptIncludeUntracked,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer status.Free()
+	count, err := status.EntryCount()
+	if err != nil {
+		return 0, err
+	}
+	if count != 0 {
+		fmt.Fprintf(w, "%s: %d uncommitted changes; previous run of program did not exit cleanly\n", dbPath, count)
+		problems++
+	}
+	if empty, err := db.IsHeadUnborn(); err != nil {
+		return 0, err
+	} else if empty {
+		return problems, nil
+	}
+	walk, err := db.Walk()
+	if err != nil {
+		return 0, err
+	}
+	defer walk.Free()
+	if err = walk.PushHead(); err != nil {
+		fmt.Fprintf(w, "%s: unable to read HEAD: %v\n", dbPath, err)
+		return problems + 1, nil
+	}
+	odb, err := db.Odb()
+	if err != nil {
+		return 0, err
+	}
+	defer odb.Free()
+	checked := map[string]bool{}
+	if err = walk.Iterate(func(commit *git.Commit) bool {
+		defer commit.Free()
+		tree, err := commit.Tree()
+		if err != nil {
+			fmt.Fprintf(w, "%s: commit %s: unable to read tree: %v\n", dbPath, commit.Id(), err)
+			problems++
+			return true
+		}
+		defer tree.Free()
+		tree.Walk(func(dir string, entry *git.TreeEntry) int {
+			if entry.Type != git.ObjectBlob || checked[entry.Id.String()] {
+				return 0
+			}
+			checked[entry.Id.String()] = true
+			if obj, err := odb.Read(entry.Id); err != nil {
+				fmt.Fprintf(w, "%s: commit %s: unable to read '%s%s': %v\n", dbPath, commit.Id(), dir, entry.Name, err)
+				problems++
+			} else {
+				obj.Free()
+			}
+			return 0
+		})
+		return true
+	}); err != nil {
+		fmt.Fprintf(w, "%s: unable to walk history: %v\n", dbPath, err)
+		problems++
+	}
+	return problems, nil
+}
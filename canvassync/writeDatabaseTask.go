@@ -2,25 +2,24 @@ package canvassync
 
 import (
 	"fmt"
-	"time"
 
 	git "github.com/libgit2/git2go/v30"
 	"github.com/zachdeibert/canvas-sync/task"
 )
 
 func writeDatabaseTask(dbPath string) func(*task.Task, func()) {
-	return func(t *task.Task, finish func()) {
+	return task.FailOnError(func(t *task.Task) error {
 		p := t.CreateProgress(1)
 		p.SetWork(2)
 		// Open database
 		db, err := git.OpenRepository(dbPath)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		defer db.Free()
 		index, err := db.Index()
 		if err != nil {
-			panic(err)
+			return err
 		}
 		defer index.Free()
 		p.Finish(1)
@@ -30,18 +29,18 @@ func writeDatabaseTask(dbPath string) func(*task.Task, func()) {
 			Flags: git.StatusOptIncludeUntracked | git.StatusOptRecurseUntrackedDirs,
 		})
 		if err != nil {
-			panic(err)
+			return err
 		}
 		defer status.Free()
 		count, err := status.EntryCount()
 		if err != nil {
-			panic(err)
+			return err
 		}
 		p.AddWork(count)
 		for i := 0; i < count; i++ {
 			entry, err := status.ByIndex(i)
 			if err != nil {
-				panic(err)
+				return err
 			}
 			changes := []string{}
 			if len(entry.HeadToIndex.OldFile.Path) != 0 {
@@ -67,43 +66,44 @@ func writeDatabaseTask(dbPath string) func(*task.Task, func()) {
 		if count > 0 {
 			treeID, err := index.WriteTree()
 			if err != nil {
-				panic(err)
+				return err
 			}
 			if err = index.Write(); err != nil {
-				panic(err)
+				return err
 			}
 			tree, err := db.LookupTree(treeID)
 			if err != nil {
-				panic(err)
+				return err
 			}
 			defer tree.Free()
-			parent, _, err := db.RevparseExt("HEAD")
+			// The first sync into a new database has nothing to use as the parent
+			unborn, err := db.IsHeadUnborn()
 			if err != nil {
-				panic(err)
+				return err
 			}
 			parents := []*git.Commit{}
-			if parent != nil {
+			if !unborn {
+				parent, _, err := db.RevparseExt("HEAD")
+				if err != nil {
+					return err
+				}
 				defer parent.Free()
 				commit, err := db.LookupCommit(parent.Id())
 				if err != nil {
-					panic(err)
+					return err
 				}
 				defer commit.Free()
 				parents = []*git.Commit{
 					commit,
 				}
 			}
-			sig := &git.Signature{
-				Name:  "Canvas Sync Utility",
-				Email: "zachdeibert@gmail.com",
-				When:  time.Now(),
-			}
+			sig := syncSignature()
 			if _, err = db.CreateCommit("HEAD", sig, sig, fmt.Sprintf("Canvas Sync at %s", sig.When.Format("Mon Jan 2 2006 15:04:05 MST")), tree, parents...); err != nil {
-				panic(err)
+				return err
 			}
 		}
 		p.Finish(1)
 		// Done!
-		finish()
-	}
+		return nil
+	})
 }
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zachdeibert/canvas-sync/canvas"
+	"github.com/zachdeibert/canvas-sync/canvassync"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+	"github.com/zachdeibert/canvas-sync/config"
+	"github.com/zachdeibert/canvas-sync/credentials"
+	"github.com/zachdeibert/canvas-sync/task"
+)
+
+func syncCommand(o *options) error {
+	known := coursetasks.TaskNames()
+	accounts := make([]canvassync.Account, len(o.accounts))
+	for i, a := range o.accounts {
+		tasks := a.Tasks
+		courseTasks := []canvassync.CourseTasks{}
+		if len(o.only) > 0 {
+			tasks = o.only
+		} else {
+			for _, t := range a.CourseTasks {
+				courseTasks = append(courseTasks, canvassync.CourseTasks{
+					Course: t.Pattern,
+					Tasks:  t.Tasks,
+				})
+			}
+		}
+		selected := append([]string{}, tasks...)
+		for _, t := range courseTasks {
+			selected = append(selected, t.Tasks...)
+		}
+		for _, name := range selected {
+			found := false
+			for _, k := range known {
+				if strings.EqualFold(k, name) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("unknown task '%s'", name)
+			}
+		}
+		// Only a sync clears the temporary folder, so other commands never remove anything a sync is using
+		if err := os.RemoveAll(o.tempFolder(i)); err != nil {
+			return err
+		}
+		c, err := o.createCanvas(i)
+		if err != nil {
+			return err
+		}
+		accounts[i] = canvassync.Account{
+			Canvas:         c,
+			Courses:        a.Courses.Include,
+			ExcludeCourses: a.Courses.Exclude,
+			Tasks:          tasks,
+			CourseTasks:    courseTasks,
+		}
+	}
+	return canvassync.Run(accounts, canvassync.Options{
+		Database: o.database,
+		Jobs:     o.jobs,
+		DryRun:   o.dryRun,
+		Recover:  o.recover,
+		Timeout:  o.timeout,
+		Offline:  o.offline,
+	})
+}
+
+func findDatabases(o *options) ([]string, error) {
+	dbs := []string{}
+	for _, a := range o.accounts {
+		host, err := accountHost(a)
+		if err != nil {
+			return nil, err
+		}
+		found, err := canvassync.FindDatabases(o.database, host)
+		if err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, found...)
+	}
+	if len(dbs) == 0 {
+		return nil, fmt.Errorf("no databases found in '%s'", o.database)
+	}
+	return dbs, nil
+}
+
+func statusCommand(o *options) error {
+	dbs, err := findDatabases(o)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		if err = canvassync.Status(os.Stdout, db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func logCommand(o *options) error {
+	dbs, err := findDatabases(o)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		if len(dbs) > 1 {
+			fmt.Printf("Database: %s\n", db)
+		}
+		if err = canvassync.Log(os.Stdout, db, o.limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func coursesCommand(o *options) error {
+	for i := range o.accounts {
+		c, err := o.createCanvas(i)
+		if err != nil {
+			return err
+		}
+		courses, err := c.CoursesListYourCourses(context.Background(), task.CreateProgress(), nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(o.accounts) > 1 {
+			fmt.Printf("%s:\n", c.GetBaseURL())
+		}
+		for _, course := range courses {
+			if course.Name != "" {
+				fmt.Printf("%d\t%s\n", course.ID, course.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func verifyCommand(o *options) error {
+	dbs, err := findDatabases(o)
+	if err != nil {
+		return err
+	}
+	problems := 0
+	for _, db := range dbs {
+		n, err := canvassync.Verify(os.Stdout, db)
+		if err != nil {
+			return err
+		}
+		problems += n
+	}
+	if problems > 0 {
+		return fmt.Errorf("found %d problems", problems)
+	}
+	fmt.Println("No problems found")
+	return nil
+}
+
+func recoverCommand(o *options) error {
+	dbs, err := findDatabases(o)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		branch, err := canvassync.Recover(db)
+		if err != nil {
+			return err
+		}
+		if len(branch) == 0 {
+			fmt.Printf("%s: already clean\n", db)
+		} else {
+			fmt.Printf("%s: saved changes to branch '%s'\n", db, branch)
+		}
+	}
+	return nil
+}
+
+func exportCommand(o *options) error {
+	if len(o.output) == 0 {
+		return errors.New("no output folder specified")
+	}
+	dbs, err := findDatabases(o)
+	if err != nil {
+		return err
+	}
+	if len(dbs) == 1 {
+		return canvassync.Export(dbs[0], o.revision, o.output)
+	}
+	for _, db := range dbs {
+		if err = canvassync.Export(db, o.revision, path.Join(o.output, path.Base(db))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func uploadCommand(o *options) error {
+	if len(o.files) == 0 {
+		return errors.New("no files to upload specified")
+	}
+	if len(o.accounts) > 1 {
+		return errors.New("files can only be uploaded to one account at a time")
+	}
+	if len(o.assignment) > 0 && len(o.course) == 0 {
+		return errors.New("--assignment needs the --course it is in")
+	}
+	if len(o.comment) > 0 && len(o.assignment) == 0 {
+		return errors.New("--comment can only be used when submitting to an --assignment")
+	}
+	c, err := o.createCanvas(0)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	progress := task.CreateProgress()
+	lastPercent := -1
+	printMutex := sync.Mutex{}
+	progress.AddListener(func(p *task.Progress, val float32) {
+		printMutex.Lock()
+		defer printMutex.Unlock()
+		if percent := int(val * 100); percent != lastPercent {
+			lastPercent = percent
+			fmt.Printf("\rUploading: %3d%%", percent)
+		}
+	})
+	opts := canvas.UploadOptions{
+		ParentFolderPath: o.folder,
+	}
+	files := make([]*canvas.File, len(o.files))
+	errs := c.RunParallel(len(o.files), func(i int) error {
+		var err error
+		switch {
+		case len(o.assignment) > 0:
+			files[i], err = c.UploadSubmissionFile(ctx, o.course, o.assignment, "self", o.files[i], opts, progress)
+		case len(o.course) > 0:
+			files[i], err = c.UploadCourseFile(ctx, o.course, o.files[i], opts, progress)
+		default:
+			files[i], err = c.UploadUserFile(ctx, "self", o.files[i], opts, progress)
+		}
+		return err
+	})
+	fmt.Println()
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("could not upload '%s': %v", o.files[i], err)
+		}
+		fmt.Printf("%d\t%s\n", files[i].ID, files[i].DisplayName)
+	}
+	if len(o.assignment) == 0 {
+		return nil
+	}
+	ids := make([]int, len(files))
+	for i, f := range files {
+		ids[i] = f.ID
+	}
+	submission, err := c.SubmitFiles(ctx, o.course, o.assignment, ids, o.comment, task.CreateProgress())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Submitted attempt %d to assignment %s\n", submission.Attempt, o.assignment)
+	return nil
+}
+
+// loginTimeout is how long the login command waits for the user to log in with their browser
+const loginTimeout = 10 * time.Minute
+
+func loginCommand(o *options) error {
+	if o.useToken && len(o.accounts) > 1 {
+		return errors.New("--token can only be used when logging in to one account")
+	}
+	for _, a := range o.accounts {
+		baseURL, name := accountBaseURL(a)
+		if o.useToken {
+			token, err := readSecret(fmt.Sprintf("Authentication token for %s: ", baseURL))
+			if err != nil {
+				return err
+			}
+			if len(token) == 0 {
+				return errors.New("no authentication token given")
+			}
+			if err = o.store.Set(name, credentials.Token, string(token)); err != nil {
+				return err
+			}
+			// Saved OAuth2 credentials would be used instead of the token
+			if err = o.store.Delete(name, credentials.OAuth); err != nil {
+				return err
+			}
+			fmt.Printf("Saved the authentication token for '%s'\n", name)
+			if o.credentials != config.PlainCredentials {
+				legacy := (credentials.Plain{Dir: "."}).Filename(name, credentials.Token)
+				if _, err = os.Stat(legacy); err == nil {
+					fmt.Printf("The unencrypted token in '%s' is no longer used and can be deleted\n", legacy)
+				}
+			}
+			continue
+		}
+		cfg := o.oauthConfig(a)
+		if len(cfg.ClientID) == 0 || len(cfg.ClientSecret) == 0 {
+			return errors.New("no OAuth2 developer key given (use --client-id and --client-secret, set them in the configuration file, or use --token)")
+		}
+		c, err := canvas.CreateCanvas(baseURL, "", "")
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), loginTimeout)
+		token, err := c.AuthorizeOAuth(ctx, cfg, func(authURL string) {
+			fmt.Printf("Open this URL in your browser to log in to %s:\n\n%s\n\n", c.GetBaseURL(), authURL)
+			openBrowser(authURL)
+		})
+		cancel()
+		if err == context.DeadlineExceeded {
+			return fmt.Errorf("gave up waiting to log in to %s after %v", c.GetBaseURL(), loginTimeout)
+		} else if err != nil {
+			return err
+		}
+		if err = o.saveOAuth(name, oauthCredentials{
+			Config: cfg,
+			Token:  token,
+		}); err != nil {
+			return err
+		}
+		fmt.Printf("Logged in; saved the OAuth2 credentials for '%s'\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FileName is the name of the configuration file inside of a configuration folder
+	FileName = "canvas-sync/config.json"
+)
+
+// CourseSelection determines which courses are synced
+type CourseSelection struct {
+	// Include lists the IDs or name patterns of the courses to sync (all courses if empty)
+	Include []string `json:"include"`
+	// Exclude lists the IDs or name patterns of the courses not to sync
+	Exclude []string `json:"exclude"`
+}
+
+// OAuth configures authentication with OAuth2 instead of a token file
+type OAuth struct {
+	// ClientID of the developer key
+	ClientID string `json:"client_id"`
+	// ClientSecret of the developer key
+	ClientSecret string `json:"client_secret"`
+	// RedirectURL registered with the developer key, which must be a loopback address
+	RedirectURL string `json:"redirect_url"`
+}
+
+// Account is a Canvas account to sync
+type Account struct {
+	// Name of the account (used to select it on the command line)
+	Name string `json:"name"`
+	// URL of the Canvas instance, or the subdomain of instructure.com
+	URL string `json:"url"`
+	// TokenFile contains the authentication token for the account
+	TokenFile string `json:"token_file"`
+	// OAuth configures authentication with OAuth2 (used instead of the token file once logged in)
+	OAuth OAuth `json:"oauth"`
+	// Courses to sync
+	Courses CourseSelection `json:"courses"`
+	// Tasks to run for every course (all tasks if empty)
+	Tasks []string `json:"tasks"`
+	// CourseTasks overrides the tasks to run for the courses matching an ID or name pattern (the first pattern that
+	// matches a course is used)
+	CourseTasks CourseTaskOverrides `json:"course_tasks"`
+}
+
+// CourseTaskOverride sets the tasks to run for the courses matching an ID or name pattern
+type CourseTaskOverride struct {
+	Pattern string
+	Tasks   []string
+}
+
+// CourseTaskOverrides are written as an object from patterns to tasks, but keep the order the patterns are written in
+// so that the first one matching a course is always the one used
+type CourseTaskOverrides []CourseTaskOverride
+
+// UnmarshalJSON reads the overrides from an object, in the order of its keys
+func (o *CourseTaskOverrides) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		*o = nil
+		return nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return errors.New("Course tasks must be an object from course patterns to lists of tasks")
+	}
+	res := CourseTaskOverrides{}
+	for dec.More() {
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		override := CourseTaskOverride{
+			Pattern: tok.(string),
+		}
+		if err = dec.Decode(&override.Tasks); err != nil {
+			return err
+		}
+		res = append(res, override)
+	}
+	*o = res
+	return nil
+}
+
+// Retry controls how requests to Canvas that fail for transient reasons are retried
+type Retry struct {
+	// MaxAttempts is the total number of times a request is sent before giving up
+	MaxAttempts int `json:"max_attempts"`
+	// InitialBackoff is how long to wait before the first retry (for example, "1s")
+	InitialBackoff string `json:"initial_backoff"`
+	// MaxBackoff is the longest time to wait between retries (for example, "1m")
+	MaxBackoff string `json:"max_backoff"`
+}
+
+// Config is the contents of a configuration file
+type Config struct {
+	// Accounts to sync
+	Accounts []Account `json:"accounts"`
+	// Database is the folder that the databases are stored in
+	Database string `json:"database"`
+	// Jobs is the number of course tasks to run at the same time for each account
+	Jobs int `json:"jobs"`
+	// Connections is the number of requests to make to each Canvas instance at the same time
+	Connections int `json:"connections"`
+	// Retry policy for requests to Canvas
+	Retry Retry `json:"retry"`
+	// Timeout is how long a whole sync may run (for example, "2h")
+	Timeout string `json:"timeout"`
+	// RequestTimeout is how long a single request to Canvas may take (for example, "5m")
+	RequestTimeout string `json:"request_timeout"`
+	// Credentials is where authentication tokens are kept, which is one of CredentialStores
+	Credentials string `json:"credentials"`
+	// CredentialsFile is the file the tokens are kept in when they are encrypted
+	CredentialsFile string `json:"credentials_file"`
+}
+
+const (
+	// PlainCredentials keeps each token unencrypted in a file in the current folder, like "canvas.pri".  It is only
+	// used when it is chosen.
+	PlainCredentials = "files"
+	// EncryptedCredentials keeps the tokens in one file encrypted with a passphrase
+	EncryptedCredentials = "encrypted"
+	// KeyringCredentials keeps the tokens in the Secret Service keyring
+	KeyringCredentials = "keyring"
+)
+
+// CredentialStores are the places authentication tokens can be kept.  The default is the keyring if there is one, or
+// else the encrypted file.
+var CredentialStores = []string{EncryptedCredentials, KeyringCredentials, PlainCredentials}
+
+// DefaultCredentialsFile gets the file encrypted tokens are kept in by default, which is next to the configuration
+// file of the user
+func DefaultCredentialsFile() string {
+	return path.Join(path.Dir(SearchPaths()[0]), "credentials")
+}
+
+// SearchPaths gets the locations a configuration file is searched for, in order of preference
+func SearchPaths() []string {
+	dirs := []string{}
+	if home := os.Getenv("XDG_CONFIG_HOME"); len(home) > 0 {
+		dirs = append(dirs, home)
+	} else if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, path.Join(home, ".config"))
+	}
+	if sys := os.Getenv("XDG_CONFIG_DIRS"); len(sys) > 0 {
+		for _, dir := range strings.Split(sys, ":") {
+			if len(dir) > 0 {
+				dirs = append(dirs, dir)
+			}
+		}
+	} else {
+		dirs = append(dirs, "/etc/xdg")
+	}
+	paths := make([]string, len(dirs))
+	for i, dir := range dirs {
+		paths[i] = path.Join(dir, FileName)
+	}
+	return paths
+}
+
+// Load reads a configuration file
+func Load(filename string) (*Config, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	for _, a := range c.Accounts {
+		if len(a.URL) == 0 {
+			return nil, errors.New("Account in configuration file is missing its URL")
+		}
+	}
+	if err = CheckCredentialStore(c.Credentials); err != nil {
+		return nil, err
+	}
+	for _, d := range []string{c.Retry.InitialBackoff, c.Retry.MaxBackoff, c.Timeout, c.RequestTimeout} {
+		if len(d) > 0 {
+			if _, err := time.ParseDuration(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// Find loads the first configuration file that exists in the search paths, or returns an empty configuration if
+// there are none
+func Find() (*Config, error) {
+	for _, p := range SearchPaths() {
+		c, err := Load(p)
+		if err == nil {
+			return c, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return &Config{}, nil
+}
+
+// FindAccount finds the account with a name or URL
+func (c *Config) FindAccount(name string) *Account {
+	for i, a := range c.Accounts {
+		if a.Name == name || a.URL == name {
+			return &c.Accounts[i]
+		}
+	}
+	return nil
+}
+
+// CheckCredentialStore checks that a place to keep authentication tokens is one of CredentialStores (or empty for the
+// default)
+func CheckCredentialStore(store string) error {
+	if len(store) == 0 {
+		return nil
+	}
+	for _, s := range CredentialStores {
+		if s == store {
+			return nil
+		}
+	}
+	return fmt.Errorf("Unknown credential store '%s' (must be one of %s)", store, strings.Join(CredentialStores, ", "))
+}
@@ -0,0 +1,178 @@
+package credentials
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The file starts with a magic number and the salt the key was derived with, followed by the nonce and the secrets
+// sealed with secretbox.  The key is derived from the passphrase with the scrypt parameters recommended for
+// interactive logins.
+const (
+	encryptedSaltSize  = 32
+	encryptedNonceSize = 24
+	encryptedKeySize   = 32
+	scryptN            = 1 << 15
+	scryptR            = 8
+	scryptP            = 1
+)
+
+var encryptedMagic = []byte("canvas-sync credentials 1\n")
+
+// ErrWrongPassphrase is the error when the credentials file cannot be decrypted with the passphrase
+var ErrWrongPassphrase = errors.New("Wrong passphrase for the credentials file")
+
+// Encrypted is a store that keeps every secret in one file, encrypted with a key derived from a passphrase.  The
+// passphrase is only asked for the first time the file is used.
+type Encrypted struct {
+	// Filename of the file the secrets are kept in
+	Filename string
+	// Passphrase gets the passphrase for the file.  create is set if the file does not exist yet, so the passphrase
+	// can be confirmed.
+	Passphrase func(create bool) ([]byte, error)
+	mutex      sync.Mutex
+	secrets    map[string]string
+	salt       []byte
+	key        *[encryptedKeySize]byte
+}
+
+// CreateEncrypted creates a store for an encrypted file
+func CreateEncrypted(filename string, passphrase func(create bool) ([]byte, error)) *Encrypted {
+	return &Encrypted{
+		Filename:   filename,
+		Passphrase: passphrase,
+	}
+}
+
+func encryptedKey(account string, kind string) string {
+	return fmt.Sprintf("%s:%s", kind, account)
+}
+
+// deriveKey derives the key for the file from the passphrase and salt
+func deriveKey(passphrase []byte, salt []byte) (*[encryptedKeySize]byte, error) {
+	k, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, encryptedKeySize)
+	if err != nil {
+		return nil, err
+	}
+	key := &[encryptedKeySize]byte{}
+	copy(key[:], k)
+	return key, nil
+}
+
+// unlock reads and decrypts the file if it has not been yet.  It must be called with the mutex held.
+func (e *Encrypted) unlock() error {
+	if e.key != nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(e.Filename)
+	create := os.IsNotExist(err)
+	if err != nil && !create {
+		return err
+	}
+	passphrase, err := e.Passphrase(create)
+	if err != nil {
+		return err
+	}
+	if create {
+		salt := make([]byte, encryptedSaltSize)
+		if _, err = rand.Read(salt); err != nil {
+			return err
+		}
+		if e.key, err = deriveKey(passphrase, salt); err != nil {
+			return err
+		}
+		e.salt = salt
+		e.secrets = map[string]string{}
+		return nil
+	}
+	if !bytes.HasPrefix(data, encryptedMagic) || len(data) < len(encryptedMagic)+encryptedSaltSize+encryptedNonceSize {
+		return fmt.Errorf("%s is not a credentials file", e.Filename)
+	}
+	data = data[len(encryptedMagic):]
+	salt, data := data[:encryptedSaltSize], data[encryptedSaltSize:]
+	nonce := &[encryptedNonceSize]byte{}
+	copy(nonce[:], data[:encryptedNonceSize])
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	plain, ok := secretbox.Open(nil, data[encryptedNonceSize:], nonce, key)
+	if !ok {
+		return ErrWrongPassphrase
+	}
+	secrets := map[string]string{}
+	if err = json.Unmarshal(plain, &secrets); err != nil {
+		return err
+	}
+	e.key, e.salt, e.secrets = key, salt, secrets
+	return nil
+}
+
+// save encrypts the secrets with a new nonce and writes the file.  It must be called with the mutex held.
+func (e *Encrypted) save() error {
+	plain, err := json.Marshal(e.secrets)
+	if err != nil {
+		return err
+	}
+	nonce := &[encryptedNonceSize]byte{}
+	if _, err = rand.Read(nonce[:]); err != nil {
+		return err
+	}
+	data := append(append(append([]byte{}, encryptedMagic...), e.salt...), nonce[:]...)
+	if err = os.MkdirAll(path.Dir(e.Filename), 0700); err != nil {
+		return err
+	}
+	return writeFilePrivate(e.Filename, secretbox.Seal(data, plain, nonce, e.key))
+}
+
+// Get gets a secret from the file
+func (e *Encrypted) Get(account string, kind string) (string, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if _, err := os.Stat(e.Filename); os.IsNotExist(err) && e.key == nil {
+		// Do not ask for a passphrase to create a file just to find that it is empty
+		return "", ErrNotFound
+	}
+	if err := e.unlock(); err != nil {
+		return "", err
+	}
+	if secret, ok := e.secrets[encryptedKey(account, kind)]; ok {
+		return secret, nil
+	}
+	return "", ErrNotFound
+}
+
+// Set saves a secret in the file, creating it if it does not exist
+func (e *Encrypted) Set(account string, kind string, secret string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if err := e.unlock(); err != nil {
+		return err
+	}
+	e.secrets[encryptedKey(account, kind)] = secret
+	return e.save()
+}
+
+// Delete removes a secret from the file
+func (e *Encrypted) Delete(account string, kind string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if _, err := os.Stat(e.Filename); os.IsNotExist(err) && e.key == nil {
+		return nil
+	}
+	if err := e.unlock(); err != nil {
+		return err
+	}
+	delete(e.secrets, encryptedKey(account, kind))
+	return e.save()
+}
@@ -0,0 +1,152 @@
+package credentials
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+// encryptedTest is a folder for an encrypted credentials file, along with a count of the times a passphrase was asked
+// for
+type encryptedTest struct {
+	t        *testing.T
+	dir      string
+	filename string
+	asked    int
+}
+
+func createEncryptedTest(t *testing.T) *encryptedTest {
+	dir, err := ioutil.TempDir("", "canvas-credentials-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &encryptedTest{
+		t:        t,
+		dir:      dir,
+		filename: path.Join(dir, "config", "credentials"),
+	}
+}
+
+func (e *encryptedTest) close() {
+	os.RemoveAll(e.dir)
+}
+
+// open opens the file with a new store, like each run of the program does
+func (e *encryptedTest) open(passphrase string) *Encrypted {
+	return CreateEncrypted(e.filename, func(create bool) ([]byte, error) {
+		e.asked++
+		if _, err := os.Stat(e.filename); create != os.IsNotExist(err) {
+			e.t.Errorf("Asked for a passphrase with create %v, but the file exists is %v", create, err == nil)
+		}
+		return []byte(passphrase), nil
+	})
+}
+
+func TestEncryptedRoundTrip(t *testing.T) {
+	e := createEncryptedTest(t)
+	defer e.close()
+	s := e.open("passphrase")
+	checkSecret(t, s, "canvas", Token, "")
+	if e.asked != 0 {
+		t.Errorf("Expected no passphrase to be asked for before the file exists, but it was asked for %d times", e.asked)
+	}
+	if err := s.Set("canvas", Token, "token"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("canvas", OAuth, "oauth"); err != nil {
+		t.Fatal(err)
+	}
+	checkSecret(t, s, "canvas", Token, "token")
+	if e.asked != 1 {
+		t.Errorf("Expected the passphrase to be asked for once, but it was asked for %d times", e.asked)
+	}
+	info, err := os.Stat(e.filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the file to only be readable by the user, but its mode is %v", info.Mode())
+	}
+	data, err := ioutil.ReadFile(e.filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("token")) || bytes.Contains(data, []byte("oauth")) {
+		t.Error("The secrets were saved unencrypted")
+	}
+
+	s = e.open("passphrase")
+	checkSecret(t, s, "canvas", Token, "token")
+	checkSecret(t, s, "canvas", OAuth, "oauth")
+	checkSecret(t, s, "other", Token, "")
+	if err = s.Delete("canvas", Token); err != nil {
+		t.Fatal(err)
+	}
+	s = e.open("passphrase")
+	checkSecret(t, s, "canvas", Token, "")
+	checkSecret(t, s, "canvas", OAuth, "oauth")
+}
+
+func TestEncryptedWrongPassphrase(t *testing.T) {
+	e := createEncryptedTest(t)
+	defer e.close()
+	if err := e.open("passphrase").Set("canvas", Token, "token"); err != nil {
+		t.Fatal(err)
+	}
+	s := e.open("wrong")
+	if _, err := s.Get("canvas", Token); err != ErrWrongPassphrase {
+		t.Errorf("Expected %v, but got %v", ErrWrongPassphrase, err)
+	}
+	if err := s.Set("canvas", Token, "other token"); err != ErrWrongPassphrase {
+		t.Errorf("Expected %v, but got %v", ErrWrongPassphrase, err)
+	}
+	checkSecret(t, e.open("passphrase"), "canvas", Token, "token")
+}
+
+func TestEncryptedPassphraseError(t *testing.T) {
+	e := createEncryptedTest(t)
+	defer e.close()
+	errCancelled := errors.New("cancelled")
+	s := CreateEncrypted(e.filename, func(create bool) ([]byte, error) {
+		return nil, errCancelled
+	})
+	if err := s.Set("canvas", Token, "token"); err != errCancelled {
+		t.Errorf("Expected %v, but got %v", errCancelled, err)
+	}
+	if _, err := os.Stat(e.filename); !os.IsNotExist(err) {
+		t.Error("The file was created without a passphrase")
+	}
+}
+
+func TestEncryptedCorrupted(t *testing.T) {
+	e := createEncryptedTest(t)
+	defer e.close()
+	if err := e.open("passphrase").Set("canvas", Token, "token"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(e.filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{"Flipped", append(append([]byte{}, data[:len(data)-1]...), data[len(data)-1]^1)},
+		{"Truncated", data[:len(encryptedMagic)+encryptedSaltSize]},
+		{"NotCredentials", []byte("canvas token\n")},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ioutil.WriteFile(e.filename, test.data, 0600); err != nil {
+				t.Fatal(err)
+			}
+			secret, err := e.open("passphrase").Get("canvas", Token)
+			if err == nil || err == ErrNotFound {
+				t.Errorf("Expected an error, but got %q (%v)", secret, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package credentials
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// EnvPrefix is the start of the names of the environment variables that secrets are read from
+const EnvPrefix = "CANVAS_SYNC_"
+
+var (
+	envInvalidRe = regexp.MustCompile("[^A-Z0-9]+")
+)
+
+// Env is a read only store that gets secrets from environment variables, so that they never have to be written to
+// disk or passed on the command line.  The name of each variable is given by EnvName.
+type Env struct{}
+
+// EnvName gets the name of the environment variable a secret is read from, like CANVAS_SYNC_TOKEN_CANVAS for the token
+// of the account "canvas"
+func EnvName(account string, kind string) string {
+	return fmt.Sprintf("%s%s_%s", EnvPrefix, strings.ToUpper(kind), strings.Trim(envInvalidRe.ReplaceAllLiteralString(strings.ToUpper(account), "_"), "_"))
+}
+
+// Get gets a secret from its environment variable
+func (Env) Get(account string, kind string) (string, error) {
+	if secret := strings.TrimSpace(os.Getenv(EnvName(account, kind))); len(secret) > 0 {
+		return secret, nil
+	}
+	return "", ErrNotFound
+}
+
+// Set cannot save secrets in the environment
+func (Env) Set(account string, kind string, secret string) error {
+	return ErrReadOnly
+}
+
+// Delete cannot remove secrets from the environment
+func (Env) Delete(account string, kind string) error {
+	return ErrReadOnly
+}
@@ -0,0 +1,185 @@
+package credentials
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// KeyringService is the service name secrets are saved under in the keyring
+const KeyringService = "canvas-sync"
+
+// Keyring is a system keyring that looks up secrets by a set of attributes
+type Keyring interface {
+	// Lookup gets the secret with the attributes, returning ErrNotFound if there is none
+	Lookup(attributes map[string]string) (string, error)
+	// Store saves a secret with the attributes and a label to show to the user
+	Store(label string, attributes map[string]string, secret string) error
+	// Clear removes the secrets with the attributes
+	Clear(attributes map[string]string) error
+}
+
+// KeyringStore is a store that keeps secrets in a system keyring
+type KeyringStore struct {
+	Keyring Keyring
+}
+
+// CreateKeyringStore creates a store for the Secret Service keyring of the desktop session
+func CreateKeyringStore() *KeyringStore {
+	return &KeyringStore{
+		Keyring: SecretTool{
+			Command: "secret-tool",
+		},
+	}
+}
+
+func keyringAttributes(account string, kind string) map[string]string {
+	return map[string]string{
+		"service": KeyringService,
+		"account": account,
+		"kind":    kind,
+	}
+}
+
+// Get gets a secret from the keyring
+func (k *KeyringStore) Get(account string, kind string) (string, error) {
+	return k.Keyring.Lookup(keyringAttributes(account, kind))
+}
+
+// Set saves a secret in the keyring
+func (k *KeyringStore) Set(account string, kind string, secret string) error {
+	return k.Keyring.Store(fmt.Sprintf("canvas-sync %s for %s", kind, account), keyringAttributes(account, kind), secret)
+}
+
+// Delete removes a secret from the keyring
+func (k *KeyringStore) Delete(account string, kind string) error {
+	return k.Keyring.Clear(keyringAttributes(account, kind))
+}
+
+// SecretTool uses the Secret Service keyring (like GNOME Keyring or KWallet) through the secret-tool command from
+// libsecret
+type SecretTool struct {
+	Command string
+}
+
+// attributeArgs gets the attributes as command line arguments, in a stable order
+func attributeArgs(attributes map[string]string) []string {
+	args := []string{}
+	for _, k := range []string{"service", "account", "kind"} {
+		if v, ok := attributes[k]; ok {
+			args = append(args, k, v)
+		}
+	}
+	rest := []string{}
+	for k := range attributes {
+		if k != "service" && k != "account" && k != "kind" {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	for _, k := range rest {
+		args = append(args, k, attributes[k])
+	}
+	return args
+}
+
+// Available checks that secret-tool is installed and that there is a desktop session it can reach the keyring through
+func (s SecretTool) Available() bool {
+	if _, err := exec.LookPath(s.Command); err != nil {
+		return false
+	}
+	return len(os.Getenv("DBUS_SESSION_BUS_ADDRESS")) > 0
+}
+
+func (s SecretTool) run(stdin string, args ...string) (string, error) {
+	cmd := exec.Command(s.Command, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) > 0 {
+			return "", fmt.Errorf("%s: %s", s.Command, msg)
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return "", err
+		}
+		// secret-tool exits with an error and no message when a lookup finds nothing
+		return "", ErrNotFound
+	}
+	return stdout.String(), nil
+}
+
+// Lookup gets a secret with secret-tool lookup
+func (s SecretTool) Lookup(attributes map[string]string) (string, error) {
+	secret, err := s.run("", append([]string{"lookup"}, attributeArgs(attributes)...)...)
+	if err != nil {
+		return "", err
+	}
+	if secret = strings.TrimSpace(secret); len(secret) == 0 {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+// Store saves a secret with secret-tool store, passing it on standard input so it never shows up in the process list
+func (s SecretTool) Store(label string, attributes map[string]string, secret string) error {
+	_, err := s.run(secret, append([]string{"store", fmt.Sprintf("--label=%s", label)}, attributeArgs(attributes)...)...)
+	return err
+}
+
+// Clear removes secrets with secret-tool clear
+func (s SecretTool) Clear(attributes map[string]string) error {
+	_, err := s.run("", append([]string{"clear"}, attributeArgs(attributes)...)...)
+	if err == ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// MemoryKeyring is a keyring that only keeps secrets in memory, which stands in for the system keyring where there is
+// none, like in tests
+type MemoryKeyring struct {
+	mutex   sync.Mutex
+	secrets map[string]string
+}
+
+// CreateMemoryKeyring creates an empty keyring in memory
+func CreateMemoryKeyring() *MemoryKeyring {
+	return &MemoryKeyring{
+		secrets: map[string]string{},
+	}
+}
+
+func memoryKey(attributes map[string]string) string {
+	return strings.Join(attributeArgs(attributes), "\x00")
+}
+
+// Lookup gets a secret from memory
+func (m *MemoryKeyring) Lookup(attributes map[string]string) (string, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if secret, ok := m.secrets[memoryKey(attributes)]; ok {
+		return secret, nil
+	}
+	return "", ErrNotFound
+}
+
+// Store saves a secret in memory
+func (m *MemoryKeyring) Store(label string, attributes map[string]string, secret string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.secrets[memoryKey(attributes)] = secret
+	return nil
+}
+
+// Clear removes a secret from memory
+func (m *MemoryKeyring) Clear(attributes map[string]string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.secrets, memoryKey(attributes))
+	return nil
+}
@@ -0,0 +1,64 @@
+package credentials
+
+import (
+	"testing"
+)
+
+func TestKeyringStore(t *testing.T) {
+	keyring := CreateMemoryKeyring()
+	k := &KeyringStore{
+		Keyring: keyring,
+	}
+	checkSecret(t, k, "canvas", Token, "")
+	if err := k.Set("canvas", Token, "token"); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Set("canvas", OAuth, "oauth"); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Set("other", Token, "other token"); err != nil {
+		t.Fatal(err)
+	}
+	checkSecret(t, k, "canvas", Token, "token")
+	checkSecret(t, k, "canvas", OAuth, "oauth")
+	checkSecret(t, k, "other", Token, "other token")
+	if secret, err := keyring.Lookup(map[string]string{
+		"service": KeyringService,
+		"account": "canvas",
+		"kind":    Token,
+	}); err != nil || secret != "token" {
+		t.Errorf("Expected the token to be saved under the service, account and kind, but got %q (%v)", secret, err)
+	}
+
+	if err := k.Set("canvas", Token, "new token"); err != nil {
+		t.Fatal(err)
+	}
+	checkSecret(t, k, "canvas", Token, "new token")
+	if err := k.Delete("canvas", Token); err != nil {
+		t.Fatal(err)
+	}
+	checkSecret(t, k, "canvas", Token, "")
+	checkSecret(t, k, "canvas", OAuth, "oauth")
+	if err := k.Delete("canvas", Token); err != nil {
+		t.Errorf("Expected deleting a missing secret to succeed, but got %v", err)
+	}
+}
+
+func TestAttributeArgs(t *testing.T) {
+	args := attributeArgs(map[string]string{
+		"zone":    "z",
+		"kind":    Token,
+		"extra":   "e",
+		"account": "canvas",
+		"service": KeyringService,
+	})
+	expected := []string{"service", KeyringService, "account", "canvas", "kind", Token, "extra", "e", "zone", "z"}
+	if len(args) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, args)
+	}
+	for i := range args {
+		if args[i] != expected[i] {
+			t.Fatalf("Expected %v, but got %v", expected, args)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package credentials
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+)
+
+// plainExtensions are the extensions of the files each kind of secret is saved in by a Plain store
+var plainExtensions = map[string]string{
+	Token: ".pri",
+	OAuth: ".oauth",
+}
+
+// Plain is a store that keeps each secret unencrypted in its own file in a folder, like "canvas.pri" for the token of
+// the account "canvas".  It is how secrets were stored before there were other stores.
+type Plain struct {
+	Dir string
+}
+
+// Filename gets the file a secret is kept in
+func (p Plain) Filename(account string, kind string) string {
+	ext, ok := plainExtensions[kind]
+	if !ok {
+		ext = fmt.Sprintf(".%s", kind)
+	}
+	return path.Join(p.Dir, account+ext)
+}
+
+// Get reads a secret from its file
+func (p Plain) Get(account string, kind string) (string, error) {
+	data, err := ioutil.ReadFile(p.Filename(account, kind))
+	if os.IsNotExist(err) {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Set writes a secret to its file so that only the user can read it
+func (p Plain) Set(account string, kind string, secret string) error {
+	return writeFilePrivate(p.Filename(account, kind), []byte(secret))
+}
+
+// Delete removes the file of a secret
+func (p Plain) Delete(account string, kind string) error {
+	if err := os.Remove(p.Filename(account, kind)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// writeFilePrivate replaces a file atomically with one only the user can read, so that a write that is interrupted
+// never loses the secrets that were in it
+func writeFilePrivate(filename string, data []byte) error {
+	// TempFile creates the file with mode 0600
+	tmp, err := ioutil.TempFile(path.Dir(filename), fmt.Sprintf(".%s.*.tmp", path.Base(filename)))
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if e := tmp.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filename)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
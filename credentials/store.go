@@ -0,0 +1,84 @@
+package credentials
+
+import (
+	"errors"
+)
+
+const (
+	// Token is the kind of credential that is a personal access token
+	Token = "token"
+	// OAuth is the kind of credential that is an OAuth2 client and token, encoded as JSON
+	OAuth = "oauth"
+)
+
+var (
+	// ErrNotFound is the error when a store has no credential for an account
+	ErrNotFound = errors.New("No credentials found")
+	// ErrReadOnly is the error when a store cannot save credentials
+	ErrReadOnly = errors.New("Credentials cannot be saved in this store")
+)
+
+// Store keeps the secrets used to authenticate with Canvas.  Each account (the subdomain or host of the Canvas
+// instance) may have one secret of each kind.
+type Store interface {
+	// Get gets a secret, returning ErrNotFound if there is none
+	Get(account string, kind string) (string, error)
+	// Set saves a secret, replacing any that was saved before
+	Set(account string, kind string, secret string) error
+	// Delete removes a secret, if there is one
+	Delete(account string, kind string) error
+}
+
+// Chain looks for secrets in several stores, in order, and saves them in the first store that is not read only
+type Chain []Store
+
+// Get gets a secret from the first store that has it.  A store that fails, like a keyring that cannot be reached, is
+// passed over so the secret can still be found in the stores after it, and its error is only returned if none of them
+// has the secret.
+func (c Chain) Get(account string, kind string) (string, error) {
+	var failed error
+	for _, s := range c {
+		secret, err := s.Get(account, kind)
+		if err == nil {
+			return secret, nil
+		}
+		if err != ErrNotFound && failed == nil {
+			failed = err
+		}
+	}
+	if failed != nil {
+		return "", failed
+	}
+	return "", ErrNotFound
+}
+
+// Set saves a secret in the first store that is not read only
+func (c Chain) Set(account string, kind string, secret string) error {
+	for _, s := range c {
+		if err := s.Set(account, kind, secret); err != ErrReadOnly {
+			return err
+		}
+	}
+	return ErrReadOnly
+}
+
+// Delete removes a secret from every store that is not read only
+func (c Chain) Delete(account string, kind string) error {
+	for _, s := range c {
+		if err := s.Delete(account, kind); err != nil && err != ErrReadOnly {
+			return err
+		}
+	}
+	return nil
+}
+
+// Legacy is a store that secrets used to be saved in.  Secrets in it are still found and can be removed, but new ones
+// are saved in the next store of a Chain instead.
+type Legacy struct {
+	Store
+}
+
+// Set never saves a secret in the legacy store
+func (Legacy) Set(account string, kind string, secret string) error {
+	return ErrReadOnly
+}
@@ -0,0 +1,111 @@
+package credentials
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+// brokenStore fails to get any secret, like a keyring that cannot be reached
+type brokenStore struct{}
+
+var errBroken = errors.New("Store is broken")
+
+func (brokenStore) Get(account string, kind string) (string, error) {
+	return "", errBroken
+}
+
+func (brokenStore) Set(account string, kind string, secret string) error {
+	return errBroken
+}
+
+func (brokenStore) Delete(account string, kind string) error {
+	return errBroken
+}
+
+func createMemoryStore() *KeyringStore {
+	return &KeyringStore{
+		Keyring: CreateMemoryKeyring(),
+	}
+}
+
+// checkSecret checks that a store has a secret, or has none if secret is empty
+func checkSecret(t *testing.T, s Store, account string, kind string, secret string) {
+	t.Helper()
+	actual, err := s.Get(account, kind)
+	if len(secret) == 0 {
+		if err != ErrNotFound {
+			t.Errorf("Expected no %s for %s, but got %q (%v)", kind, account, actual, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("Expected %s for %s to be %q, but got %v", kind, account, secret, err)
+	} else if actual != secret {
+		t.Errorf("Expected %s for %s to be %q, but got %q", kind, account, secret, actual)
+	}
+}
+
+func TestChainGet(t *testing.T) {
+	first, second := createMemoryStore(), createMemoryStore()
+	c := Chain{Env{}, first, second}
+	checkSecret(t, c, "canvas", Token, "")
+	second.Set("canvas", Token, "second")
+	checkSecret(t, c, "canvas", Token, "second")
+	first.Set("canvas", Token, "first")
+	checkSecret(t, c, "canvas", Token, "first")
+	os.Setenv(EnvName("canvas", Token), "env")
+	defer os.Unsetenv(EnvName("canvas", Token))
+	checkSecret(t, c, "canvas", Token, "env")
+	checkSecret(t, c, "canvas", OAuth, "")
+}
+
+func TestChainGetError(t *testing.T) {
+	s := createMemoryStore()
+	s.Set("canvas", Token, "secret")
+	// The stores after one that fails are still searched
+	checkSecret(t, Chain{brokenStore{}, s}, "canvas", Token, "secret")
+	if _, err := (Chain{brokenStore{}, s}).Get("canvas", OAuth); err != errBroken {
+		t.Errorf("Expected the error from the first store, but got %v", err)
+	}
+
+	// A keyring without secret-tool still finds secrets in the legacy store
+	legacy := createMemoryStore()
+	legacy.Set("canvas", Token, "legacy")
+	keyring := &KeyringStore{
+		Keyring: SecretTool{
+			Command: "canvas-sync-test-missing-secret-tool",
+		},
+	}
+	checkSecret(t, Chain{Env{}, keyring, Legacy{Store: legacy}}, "canvas", Token, "legacy")
+}
+
+func TestChainSet(t *testing.T) {
+	legacy, first, second := createMemoryStore(), createMemoryStore(), createMemoryStore()
+	c := Chain{Env{}, Legacy{Store: legacy}, first, second}
+	if err := c.Set("canvas", Token, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	checkSecret(t, legacy, "canvas", Token, "")
+	checkSecret(t, first, "canvas", Token, "secret")
+	checkSecret(t, second, "canvas", Token, "")
+	if err := (Chain{Env{}, Legacy{Store: legacy}}).Set("canvas", Token, "secret"); err != ErrReadOnly {
+		t.Errorf("Expected a chain of read only stores to be read only, but got %v", err)
+	}
+}
+
+func TestChainDelete(t *testing.T) {
+	legacy, first, second := createMemoryStore(), createMemoryStore(), createMemoryStore()
+	for _, s := range []Store{legacy, first, second} {
+		s.Set("canvas", Token, "secret")
+		s.Set("canvas", OAuth, "oauth")
+	}
+	c := Chain{Env{}, Legacy{Store: legacy}, first, second}
+	if err := c.Delete("canvas", Token); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []Store{legacy, first, second, c} {
+		checkSecret(t, s, "canvas", Token, "")
+		checkSecret(t, s, "canvas", OAuth, "oauth")
+	}
+}
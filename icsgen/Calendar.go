@@ -0,0 +1,70 @@
+package icsgen
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ProductID identifies the program that created a calendar
+const ProductID = "-//zachdeibert//canvas-sync//EN"
+
+// Calendar represents an iCalendar file
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// CreateCalendar creates a new Calendar
+func CreateCalendar(name string) *Calendar {
+	return &Calendar{
+		Name:   name,
+		Events: []Event{},
+	}
+}
+
+// AddEvent adds an event to the calendar, replacing any event that has the same UID
+func (c *Calendar) AddEvent(e Event) {
+	for i, old := range c.Events {
+		if old.UID == e.UID {
+			c.Events[i] = e
+			return
+		}
+	}
+	c.Events = append(c.Events, e)
+}
+
+// Lines converts the calendar into content lines.  The events are sorted by UID so that the file only changes when the
+// events do.
+func (c Calendar) Lines() []string {
+	events := append([]Event{}, c.Events...)
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].UID < events[j].UID
+	})
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + ProductID,
+		"CALSCALE:GREGORIAN",
+	}
+	if len(c.Name) > 0 {
+		lines = append(lines, "X-WR-CALNAME:"+Escape(c.Name))
+	}
+	for _, e := range events {
+		lines = append(lines, e.Lines()...)
+	}
+	return append(lines, "END:VCALENDAR")
+}
+
+func (c Calendar) String() string {
+	lines := c.Lines()
+	for i, line := range lines {
+		lines[i] = Fold(line)
+	}
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+// WriteFile writes the calendar to a file
+func (c Calendar) WriteFile(filename string) error {
+	return ioutil.WriteFile(filename, []byte(c.String()), 0644)
+}
@@ -0,0 +1,78 @@
+package icsgen
+
+import (
+	"time"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+)
+
+// Event represents one event in a calendar
+type Event struct {
+	// UID must stay the same every time the event is written so that calendar programs (and diffs) see it as the same
+	// event
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	// AllDay events only use the date of Start and End
+	AllDay       bool
+	LastModified time.Time
+}
+
+// CreateEvent creates a new Event that starts and ends at the same time
+func CreateEvent(uid, summary string, start time.Time) Event {
+	return Event{
+		UID:     uid,
+		Summary: summary,
+		Start:   start,
+		End:     start,
+	}
+}
+
+// Lines converts the event into content lines
+func (e Event) Lines() []string {
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + Escape(e.UID),
+	}
+	// DTSTAMP is required, but using the current time would change the file every time it is written
+	stamp := e.LastModified
+	if stamp.IsZero() {
+		stamp = e.Start
+	}
+	lines = append(lines, "DTSTAMP:"+stamp.UTC().Format(dateTimeFormat))
+	if e.AllDay {
+		end := e.End
+		if !end.After(e.Start) {
+			end = e.Start
+		}
+		lines = append(lines,
+			"DTSTART;VALUE=DATE:"+e.Start.Format(dateFormat),
+			"DTEND;VALUE=DATE:"+end.AddDate(0, 0, 1).Format(dateFormat))
+	} else {
+		lines = append(lines, "DTSTART:"+e.Start.UTC().Format(dateTimeFormat))
+		if e.End.After(e.Start) {
+			lines = append(lines, "DTEND:"+e.End.UTC().Format(dateTimeFormat))
+		}
+	}
+	lines = append(lines, "SUMMARY:"+Escape(e.Summary))
+	if len(e.Description) > 0 {
+		lines = append(lines, "DESCRIPTION:"+Escape(e.Description))
+	}
+	if len(e.Location) > 0 {
+		lines = append(lines, "LOCATION:"+Escape(e.Location))
+	}
+	if len(e.URL) > 0 {
+		lines = append(lines, "URL:"+e.URL)
+	}
+	if !e.LastModified.IsZero() {
+		lines = append(lines, "LAST-MODIFIED:"+e.LastModified.UTC().Format(dateTimeFormat))
+	}
+	return append(lines, "END:VEVENT")
+}
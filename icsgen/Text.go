@@ -0,0 +1,42 @@
+package icsgen
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineLength is the most octets a content line may have before it has to be folded
+const maxLineLength = 75
+
+var textEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	";", "\\;",
+	",", "\\,",
+	"\r\n", "\\n",
+	"\n", "\\n",
+	"\r", "",
+)
+
+// Escape escapes a string to be used as a text value
+func Escape(str string) string {
+	return textEscaper.Replace(str)
+}
+
+// Fold splits a content line into lines that are short enough, without splitting any UTF-8 characters
+func Fold(line string) string {
+	b := &strings.Builder{}
+	limit := maxLineLength
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// The space at the start of the continuation counts towards its length
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -1,50 +1,366 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
+	"net/url"
 	"os"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/zachdeibert/canvas-sync/canvas"
-	"github.com/zachdeibert/canvas-sync/canvassync"
+	"github.com/zachdeibert/canvas-sync/canvassync/coursetasks"
+	"github.com/zachdeibert/canvas-sync/config"
+	"github.com/zachdeibert/canvas-sync/credentials"
 )
 
+type command struct {
+	name        string
+	description string
+	flags       func(*flag.FlagSet, *options)
+	run         func(*options) error
+}
+
+type options struct {
+	accounts       []config.Account
+	config         string
+	database       string
+	tokenFile      string
+	token          string
+	courses        listFlag
+	only           listFlag
+	jobs           int
+	connections    int
+	dryRun         bool
+	recover        bool
+	offline        bool
+	retry          config.Retry
+	timeout        time.Duration
+	requestTimeout time.Duration
+	output         string
+	revision       string
+	limit          int
+	files          listFlag
+	folder         string
+	course         string
+	assignment     string
+	comment        string
+	clientID       string
+	clientSecret   string
+	redirectURL    string
+	useToken       bool
+	credentials    string
+	store          credentials.Store
+}
+
+var commands = []command{
+	{
+		name:        "sync",
+		description: "Download everything from Canvas into the database",
+		flags: func(f *flag.FlagSet, o *options) {
+			f.Var(&o.courses, "course", "Only sync the course with this ID or name (may be a pattern or repeated)")
+			f.Var(&o.only, "only", fmt.Sprintf("Only run these tasks (may be repeated); one of %s", strings.Join(coursetasks.TaskNames(), ", ")))
+			f.IntVar(&o.jobs, "jobs", 0, "Number of course tasks to run at the same time for each account")
+			f.IntVar(&o.connections, "connections", 0, fmt.Sprintf("Number of requests to make at the same time for each account (default %d)", canvas.DefaultMaxConnections))
+			f.BoolVar(&o.dryRun, "dry-run", false, "Show what would be synced without downloading anything")
+			f.IntVar(&o.retry.MaxAttempts, "retries", 0, "Number of times to send a request that fails for a transient reason (default 5)")
+			f.DurationVar(&o.timeout, "timeout", 0, "Stop the sync after this long, saving what was already downloaded")
+			f.DurationVar(&o.requestTimeout, "request-timeout", 0, "Give up on a single request after this long (default 5m)")
+			f.BoolVar(&o.offline, "offline", false, "Regenerate the database from its raw request cache without using the network")
+			f.BoolVar(&o.recover, "recover", false, "Save changes left by a run that did not exit cleanly to a recovery branch and continue")
+		},
+		run: syncCommand,
+	},
+	{
+		name:        "status",
+		description: "Show the state of the database",
+		run:         statusCommand,
+	},
+	{
+		name:        "log",
+		description: "Show the history of syncs in the database",
+		flags: func(f *flag.FlagSet, o *options) {
+			f.IntVar(&o.limit, "limit", 0, "Maximum number of syncs to show")
+		},
+		run: logCommand,
+	},
+	{
+		name:        "courses",
+		description: "List the courses available to sync",
+		run:         coursesCommand,
+	},
+	{
+		name:        "verify",
+		description: "Check the database for uncommitted changes and corruption",
+		run:         verifyCommand,
+	},
+	{
+		name:        "recover",
+		description: "Save changes left by a run that did not exit cleanly to a recovery branch and reset the database",
+		run:         recoverCommand,
+	},
+	{
+		name:        "export",
+		description: "Copy the files in the database at a revision into a folder",
+		flags: func(f *flag.FlagSet, o *options) {
+			f.StringVar(&o.output, "output", "", "Folder to export the files into (required)")
+			f.StringVar(&o.revision, "rev", "HEAD", "Revision of the database to export")
+		},
+		run: exportCommand,
+	},
+	{
+		name:        "upload",
+		description: "Upload files to Canvas or submit them to an assignment",
+		flags: func(f *flag.FlagSet, o *options) {
+			f.Var(&o.files, "file", "File to upload (required; may be repeated)")
+			f.StringVar(&o.folder, "folder", "", "Folder to upload the files into, which is created if it does not exist")
+			f.StringVar(&o.course, "course", "", "ID of the course to upload the files to instead of your personal files")
+			f.StringVar(&o.assignment, "assignment", "", "ID of an assignment in the course to submit the files to")
+			f.StringVar(&o.comment, "comment", "", "Comment to add to the submission")
+			f.IntVar(&o.connections, "connections", 0, fmt.Sprintf("Number of files to upload at the same time (default %d)", canvas.DefaultMaxConnections))
+		},
+		run: uploadCommand,
+	},
+	{
+		name:        "login",
+		description: "Log in to Canvas and save the credentials in the credential store",
+		flags: func(f *flag.FlagSet, o *options) {
+			f.StringVar(&o.clientID, "client-id", "", "Client ID of the developer key (default from the configuration file)")
+			f.StringVar(&o.clientSecret, "client-secret", "", "Client secret of the developer key (default from the configuration file)")
+			f.StringVar(&o.redirectURL, "redirect-url", "", fmt.Sprintf("Loopback redirect URL registered with the developer key (default '%s')", canvas.DefaultOAuthRedirectURL))
+			f.BoolVar(&o.useToken, "token", false, "Save a personal access token read from standard input instead of logging in with OAuth2")
+		},
+		run: loginCommand,
+	},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [options] [canvas subdomain, URL or account]\n"+
+		"\n"+
+		"canvas subdomain, URL or account: This is either the subdomain of instructure.com to use, the full\n"+
+		"                                  base URL of a self-hosted Canvas instance, or the name of an account\n"+
+		"                                  in the configuration file.\n"+
+		"                                  For example, this would be 'canvas' for the domain\n"+
+		"                                  'canvas.instructure.com', or 'https://canvas.example.edu/' for a\n"+
+		"                                  self-hosted instance. If it is left out, every account in the\n"+
+		"                                  configuration file is used.\n"+
+		"\n"+
+		"The configuration file is read from the first of these locations that exists:\n", os.Args[0])
+	for _, p := range config.SearchPaths() {
+		fmt.Fprintf(os.Stderr, "  %s\n", p)
+	}
+	fmt.Fprint(os.Stderr, "\n"+
+		"Commands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun '%s <command> --help' for the options of a command.\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "\nThe old form '%s <canvas subdomain> <authentication token>' still syncs, but is deprecated.\n", os.Args[0])
+}
+
+func findCommand(name string) *command {
+	for i, cmd := range commands {
+		if cmd.name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
 func main() {
-	subdomain := ""
-	if len(os.Args) >= 2 {
-		subdomain = os.Args[1]
+	args := os.Args[1:]
+	if len(args) == 0 {
+		usage()
+		os.Exit(1)
 	}
+	cmd := findCommand(args[0])
 	token := ""
-	if len(os.Args) == 2 {
-		var err error
-		var b []byte
-		if b, err = ioutil.ReadFile(fmt.Sprintf("%s.pri", subdomain)); err != nil {
-			if os.IsNotExist(err) {
-				fmt.Fprintf(os.Stderr, "Error: no authentication token specified but file '%s.pri' does not exist.\n", subdomain)
-			}
+	if cmd == nil {
+		if strings.HasPrefix(args[0], "-") || len(args) > 2 {
+			usage()
+			os.Exit(1)
+		}
+		// Compatibility with '<canvas subdomain> [authentication token]' from before there were commands
+		cmd = findCommand("sync")
+		if len(args) == 2 {
+			_, legacyName := accountBaseURL(config.Account{URL: args[0]})
+			fmt.Fprintf(os.Stderr, "Warning: giving the authentication token on the command line is deprecated, since other users can see it. "+
+				"Run '%s login --token' or set %s instead.\n", os.Args[0], credentials.EnvName(legacyName, credentials.Token))
+			token = args[1]
+			args = args[:1]
 		}
-		token = string(b)
-	} else if len(os.Args) >= 3 {
-		token = os.Args[2]
-	}
-	if len(subdomain) == 0 || len(token) == 0 || len(os.Args) > 3 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <canvas subdomain> [authenication token]\n"+
-			"\n"+
-			"canvas subdomain:    This is the subdomain of instructure.com to use.\n"+
-			"                     For example, this would be 'canvas' for the domain 'canvas.instructure.com'.\n"+
-			"\n"+
-			"authenication token: This is the authentication token to use for connecting to Canvas.\n"+
-			"                     If this argument is not given, a file named <canvas subdomain>.pri must be\n"+
-			"                     present in the current directory that contains the token.\n", os.Args[0])
+	} else {
+		args = args[1:]
+	}
+	o := &options{
+		token: strings.TrimSpace(token),
+	}
+	f := flag.NewFlagSet(cmd.name, flag.ExitOnError)
+	f.StringVar(&o.config, "config", "", "Configuration file to use instead of searching for one")
+	f.StringVar(&o.database, "db", "", "Folder to store the databases in (default 'db')")
+	f.StringVar(&o.tokenFile, "token-file", "", "File containing the authentication token, instead of the credential store")
+	f.StringVar(&o.credentials, "credentials", "", fmt.Sprintf("Where authentication tokens are kept; one of %s (default '%s' if there is a keyring, or else '%s')", strings.Join(config.CredentialStores, ", "), config.KeyringCredentials, config.EncryptedCredentials))
+	if cmd.flags != nil {
+		cmd.flags(f, o)
+	}
+	f.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s [options] [canvas subdomain, URL or account]\n\n%s.\n\nOptions:\n", os.Args[0], cmd.name, cmd.description)
+		f.PrintDefaults()
+	}
+	f.Parse(args)
+	if f.NArg() > 1 {
+		f.Usage()
+		os.Exit(1)
+	}
+	if err := o.applyConfig(strings.TrimSpace(f.Arg(0))); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := cmd.run(o); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
-	if err := os.RemoveAll("db/tmp"); err != nil {
-		panic(err)
+}
+
+// applyConfig loads the configuration file and fills in any options that were not given on the command line
+func (o *options) applyConfig(instance string) error {
+	var cfg *config.Config
+	var err error
+	if len(o.config) > 0 {
+		cfg, err = config.Load(o.config)
+	} else {
+		cfg, err = config.Find()
+	}
+	if err != nil {
+		return err
+	}
+	if len(instance) > 0 {
+		if a := cfg.FindAccount(instance); a != nil {
+			o.accounts = []config.Account{*a}
+		} else {
+			o.accounts = []config.Account{
+				{
+					URL: instance,
+				},
+			}
+		}
+	} else if len(cfg.Accounts) == 0 {
+		return errors.New("no Canvas subdomain or URL given and no accounts are configured")
+	} else {
+		o.accounts = cfg.Accounts
+	}
+	if len(o.tokenFile) > 0 {
+		if len(o.accounts) > 1 {
+			return errors.New("--token-file can only be used when syncing one account")
+		}
+		o.accounts[0].TokenFile = o.tokenFile
+	}
+	if len(o.courses) > 0 {
+		for i := range o.accounts {
+			o.accounts[i].Courses.Include = o.courses
+		}
+	}
+	if len(o.database) == 0 {
+		o.database = cfg.Database
+	}
+	if len(o.database) == 0 {
+		o.database = "db"
+	}
+	if o.jobs == 0 {
+		o.jobs = cfg.Jobs
+	}
+	if len(o.credentials) == 0 {
+		o.credentials = cfg.Credentials
+	} else if err = config.CheckCredentialStore(o.credentials); err != nil {
+		return err
+	}
+	credentialsFile := cfg.CredentialsFile
+	if len(credentialsFile) == 0 {
+		credentialsFile = config.DefaultCredentialsFile()
+	}
+	o.store = createStore(o.credentials, credentialsFile)
+	if o.connections == 0 {
+		o.connections = cfg.Connections
 	}
-	c, err := canvas.CreateCanvas(strings.TrimSpace(subdomain), strings.TrimSpace(token), "db/tmp")
+	if o.retry.MaxAttempts == 0 {
+		o.retry.MaxAttempts = cfg.Retry.MaxAttempts
+	}
+	o.retry.InitialBackoff = cfg.Retry.InitialBackoff
+	o.retry.MaxBackoff = cfg.Retry.MaxBackoff
+	// The durations were checked when the configuration file was loaded
+	if d, err := time.ParseDuration(cfg.Timeout); err == nil && o.timeout == 0 {
+		o.timeout = d
+	}
+	if d, err := time.ParseDuration(cfg.RequestTimeout); err == nil && o.requestTimeout == 0 {
+		o.requestTimeout = d
+	}
+	return nil
+}
+
+// accountBaseURL gets the base URL of the Canvas instance and the name to use for files relating to it
+func accountBaseURL(a config.Account) (string, string) {
+	instance := strings.TrimSpace(a.URL)
+	if strings.Contains(instance, "://") {
+		if u, err := url.Parse(instance); err == nil {
+			return instance, coursetasks.InvalidPathRunes.ReplaceAllLiteralString(u.Host, "_")
+		}
+		return instance, instance
+	}
+	return fmt.Sprintf("https://%s.instructure.com/", instance), instance
+}
+
+func accountHost(a config.Account) (string, error) {
+	baseURL, _ := accountBaseURL(a)
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	return u.Host, nil
+}
+
+// tempFolder gets the folder the responses for an account are saved in until its database is found
+func (o *options) tempFolder(index int) string {
+	return path.Join(o.database, "tmp", fmt.Sprint(index))
+}
+
+func (o *options) createCanvas(index int) (*canvas.Canvas, error) {
+	a := o.accounts[index]
+	baseURL, name := accountBaseURL(a)
+	token, creds, err := o.loadCredentials(a, name)
+	if err != nil {
+		return nil, err
+	}
+	c, err := canvas.CreateCanvas(baseURL, token, o.tempFolder(index))
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	c.Offline = o.offline
+	if creds != nil {
+		if cfg := o.oauthConfig(a); len(cfg.ClientID) > 0 {
+			creds.Config = cfg
+		}
+		c.SetOAuth(creds.Config, creds.Token, func(token canvas.OAuthToken) error {
+			creds.Token = token
+			return o.saveOAuth(name, *creds)
+		})
+	}
+	if o.retry.MaxAttempts > 0 {
+		c.RetryPolicy.MaxAttempts = o.retry.MaxAttempts
+	}
+	if o.requestTimeout > 0 {
+		c.RequestTimeout = o.requestTimeout
+	}
+	if o.connections > 0 {
+		c.SetMaxConnections(o.connections)
+	}
+	// The durations were checked when the configuration file was loaded
+	if d, err := time.ParseDuration(o.retry.InitialBackoff); err == nil {
+		c.RetryPolicy.InitialBackoff = d
+	}
+	if d, err := time.ParseDuration(o.retry.MaxBackoff); err == nil {
+		c.RetryPolicy.MaxBackoff = d
 	}
-	canvassync.Run(c)
+	return c, nil
 }
@@ -365,6 +365,9 @@ func (m *interactiveMonitor) renderAll() {
 			}
 		}
 		name := mon.task.name
+		if mon.task.err != nil {
+			name = fmt.Sprintf("FAILED %s", name)
+		}
 		max := m.layout.nameWidth - 3*len(mon.indent)
 		if len(name) > max {
 			name = name[0:max]
@@ -402,7 +405,7 @@ func (m *interactiveMonitor) layoutTask(task *Task, layout []monitoredTask, used
 	i := usedTasks
 	for _, child := range task.children {
 		used := m.layoutTask(child, layout, i+1)
-		if (used > 0 || child.state != taskStateFinished) && i < len(layout) {
+		if (used > 0 || child.state != taskStateFinished || child.err != nil) && i < len(layout) {
 			layout[i] = monitoredTask{
 				task:         child,
 				lastProgress: 0,
@@ -21,7 +21,11 @@ func createNoninteractiveMonitor(root *Task) *noninteractiveMonitor {
 			names = append([]string{fmt.Sprintf("'%s'", task.name)}, names...)
 		}
 		if len(names) > 0 {
-			fmt.Printf("Task %s done\n", strings.Join(names, " > "))
+			if t.err != nil {
+				fmt.Printf("Task %s failed: %v\n", strings.Join(names, " > "), t.err)
+			} else {
+				fmt.Printf("Task %s done\n", strings.Join(names, " > "))
+			}
 		}
 	}
 	var childrenListener func(*Task, *Task, []*Task)
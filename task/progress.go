@@ -1,11 +1,14 @@
 package task
 
-// Progress represents the progress of a task
+import "sync"
+
+// Progress represents the progress of a task.  Work may be added and finished from several goroutines at once.
 type Progress struct {
 	total        int
 	done         int
 	listeners    []func(*Progress, float32)
 	lastDispatch float32
+	mutex        sync.Mutex
 }
 
 // CreateProgress creates a new Progress object
@@ -20,18 +23,21 @@ func CreateProgress() *Progress {
 
 // SetWork sets the total number of units of work to do
 func (p *Progress) SetWork(totalUnits int) {
+	p.mutex.Lock()
 	p.total = totalUnits
 	p.dispatch()
 }
 
 // AddWork adds more to the total number of units of work to do
 func (p *Progress) AddWork(moreUnits int) {
+	p.mutex.Lock()
 	p.total += moreUnits
 	p.dispatch()
 }
 
 // Finish a quantity of work units
 func (p *Progress) Finish(units int) {
+	p.mutex.Lock()
 	if n := p.done + units; n > p.total {
 		p.done = p.total
 	} else {
@@ -42,6 +48,12 @@ func (p *Progress) Finish(units int) {
 
 // GetStatus returns how much progress has been made
 func (p *Progress) GetStatus() float32 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.getStatus()
+}
+
+func (p *Progress) getStatus() float32 {
 	if p.total == 0 {
 		return 0
 	}
@@ -51,17 +63,25 @@ func (p *Progress) GetStatus() float32 {
 	return float32(p.done) / float32(p.total)
 }
 
+// dispatch fires the listeners if the progress changed.  It must be called with the mutex held, and unlocks it before
+// firing the listeners.
 func (p *Progress) dispatch() {
-	val := p.GetStatus()
-	if val != p.lastDispatch {
-		p.lastDispatch = val
-		for _, l := range p.listeners {
-			l(p, val)
-		}
+	val := p.getStatus()
+	if val == p.lastDispatch {
+		p.mutex.Unlock()
+		return
+	}
+	p.lastDispatch = val
+	listeners := p.listeners
+	p.mutex.Unlock()
+	for _, l := range listeners {
+		l(p, val)
 	}
 }
 
 // AddListener adds a new listener that's fired every time the progress changes
 func (p *Progress) AddListener(listener func(*Progress, float32)) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 	p.listeners = append(p.listeners, listener)
 }
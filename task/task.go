@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 )
 
 type taskState int
@@ -29,6 +30,7 @@ type Task struct {
 	parent               *Task
 	children             []*Task
 	progress             []taskProgress
+	progressMutex        sync.Mutex
 	state                taskState
 	startFunc            func(*Task, func())
 	inheritingProgress   bool
@@ -36,7 +38,17 @@ type Task struct {
 	lastProgressDispatch float32
 	childrenListeners    []func(*Task, *Task, []*Task)
 	finishListeners      []func(*Task)
-	panicListeners       []func(*Task, interface{})
+	failListeners        []func(*Task, error)
+	err                  error
+}
+
+// PanicError is the error a task fails with when it panics
+type PanicError struct {
+	Value interface{}
+}
+
+func (err PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", err.Value)
 }
 
 // CreateRootTask creates a root task
@@ -52,7 +64,7 @@ func CreateRootTask() *Task {
 		progressListeners:    []func(*Task, float32){},
 		lastProgressDispatch: -1,
 		childrenListeners:    []func(*Task, *Task, []*Task){},
-		panicListeners:       []func(*Task, interface{}){},
+		failListeners:        []func(*Task, error){},
 	}
 }
 
@@ -64,7 +76,9 @@ func (t *Task) Start() {
 			if !taskDebug {
 				defer func() {
 					if err := recover(); err != nil {
-						t.dispatchPanic(t, err)
+						t.Fail(PanicError{
+							Value: err,
+						})
 					}
 				}()
 			}
@@ -76,13 +90,42 @@ func (t *Task) Start() {
 	}
 }
 
+// Fail finishes the task because of an error
+func (t *Task) Fail(err error) {
+	if t.state == taskStateFinished {
+		return
+	}
+	t.err = err
+	t.state = taskStateFinished
+	t.dispatchFail(t, err)
+	t.dispatchFinish()
+}
+
+// GetError gets the error the task failed with, or nil if it has not failed
+func (t *Task) GetError() error {
+	return t.err
+}
+
+// FailOnError creates a start function that fails the task if f returns an error, and finishes it otherwise
+func FailOnError(f func(*Task) error) func(*Task, func()) {
+	return func(t *Task, finish func()) {
+		if err := f(t); err != nil {
+			t.Fail(err)
+		} else {
+			finish()
+		}
+	}
+}
+
 // CreateProgress creates a new progress tracker for the task
 func (t *Task) CreateProgress(scale float32) *Progress {
 	progress := CreateProgress()
+	t.progressMutex.Lock()
 	t.progress = append(t.progress, taskProgress{
 		scale:    scale,
 		progress: progress,
 	})
+	t.progressMutex.Unlock()
 	progress.AddListener(func(_ *Progress, val float32) {
 		t.dispatchProgress()
 	})
@@ -92,10 +135,12 @@ func (t *Task) CreateProgress(scale float32) *Progress {
 func (t *Task) getProgress() (float32, float32) {
 	var sum float32 = 0
 	var total float32 = 0
+	t.progressMutex.Lock()
 	for _, p := range t.progress {
 		sum += p.scale * p.progress.GetStatus()
 		total += p.scale
 	}
+	t.progressMutex.Unlock()
 	if t.inheritingProgress {
 		for _, c := range t.children {
 			ds, dt := c.getProgress()
@@ -130,7 +175,7 @@ func (t *Task) CreateSubtask(name string, start func(*Task, func())) *Task {
 	}
 	t.children = append(t.children, task)
 	t.dispatchChildren(task)
-	task.AddPanicListener(t.dispatchPanic)
+	task.AddFailListener(t.dispatchFail)
 	if t.inheritingProgress {
 		t.addInheritProgressListener(task)
 		t.dispatchProgress()
@@ -171,11 +216,16 @@ func (t *Task) InheritProgress() {
 
 func (t *Task) dispatchProgress() {
 	val := t.GetProgress()
-	if val != t.lastProgressDispatch {
-		t.lastProgressDispatch = val
-		for _, l := range t.progressListeners {
-			l(t, val)
-		}
+	t.progressMutex.Lock()
+	if val == t.lastProgressDispatch {
+		t.progressMutex.Unlock()
+		return
+	}
+	t.lastProgressDispatch = val
+	listeners := t.progressListeners
+	t.progressMutex.Unlock()
+	for _, l := range listeners {
+		l(t, val)
 	}
 }
 
@@ -206,13 +256,13 @@ func (t *Task) AddFinishListener(listener func(*Task)) {
 	t.finishListeners = append(t.finishListeners, listener)
 }
 
-func (t *Task) dispatchPanic(src *Task, err interface{}) {
-	for _, l := range t.panicListeners {
+func (t *Task) dispatchFail(src *Task, err error) {
+	for _, l := range t.failListeners {
 		l(src, err)
 	}
 }
 
-// AddPanicListener adds a new listener that's fired when the task causes a panic
-func (t *Task) AddPanicListener(listener func(*Task, interface{})) {
-	t.panicListeners = append(t.panicListeners, listener)
+// AddFailListener adds a new listener that's fired when the task or one of its descendants fails
+func (t *Task) AddFailListener(listener func(*Task, error)) {
+	t.failListeners = append(t.failListeners, listener)
 }
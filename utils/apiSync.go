@@ -34,6 +34,29 @@ import (
 `
 )
 
+// listControllers finds the controller files in a directory and its subdirectories (like quizzes/), in the order they
+// are listed in
+func listControllers(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	res := []string{}
+	for _, file := range files {
+		name := path.Join(dir, file.Name())
+		if file.IsDir() {
+			sub, err := listControllers(name)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, sub...)
+		} else if strings.HasSuffix(file.Name(), ".rb") {
+			res = append(res, name)
+		}
+	}
+	return res, nil
+}
+
 func main() {
 	if len(os.Args) != 2 {
 		fmt.Fprintf(os.Stderr, "Usage: %s <Canvas source dir>\n", os.Args[0])
@@ -68,38 +91,35 @@ func main() {
 		}
 	}
 	os.Rename(outFile, backup)
-	files, err := ioutil.ReadDir(dir)
+	files, err := listControllers(dir)
 	if err != nil {
 		panic(err)
 	}
 	models := []*apisync.Model{}
 	methods := []apisync.MethodAPIPair{}
-	for _, file := range files {
-		if !file.IsDir() && strings.HasSuffix(file.Name(), ".rb") {
-			inputFile := path.Join(dir, file.Name())
-			api, err := apisync.ParseFile(inputFile)
-			if err != nil {
-				panic(err)
-			}
-			if api != nil {
-				fmt.Printf("Parsing file %s...\n", inputFile)
-				for _, s := range api.ModelComments {
-					m, err := apisync.ParseModel(s)
-					if err != nil {
-						panic(err)
-					}
-					models = append(models, m)
+	for _, inputFile := range files {
+		api, err := apisync.ParseFile(inputFile)
+		if err != nil {
+			panic(err)
+		}
+		if api != nil {
+			fmt.Printf("Parsing file %s...\n", inputFile)
+			for _, s := range api.ModelComments {
+				m, err := apisync.ParseModel(s)
+				if err != nil {
+					panic(err)
 				}
-				for _, s := range api.MethodComments {
-					m, err := apisync.ParseMethod(s)
-					if err != nil {
-						panic(err)
-					}
-					methods = append(methods, apisync.MethodAPIPair{
-						Method:  m,
-						APIName: api.Topic,
-					})
+				models = append(models, m)
+			}
+			for _, s := range api.MethodComments {
+				m, err := apisync.ParseMethod(s)
+				if err != nil {
+					panic(err)
 				}
+				methods = append(methods, apisync.MethodAPIPair{
+					Method:  m,
+					APIName: api.Topic,
+				})
 			}
 		}
 	}
@@ -107,6 +127,9 @@ func main() {
 	apisync.DedupMethods(&methods)
 	apisync.FixMissingTypes(models, methods)
 	overrides.ApplyOverrides(&models, &methods)
+	if err := apisync.CheckEndPoints(methods); err != nil {
+		panic(err)
+	}
 	imports := []string{}
 	parts := apisync.DefineEnums(models, methods)
 	for _, m := range models {
@@ -17,6 +17,7 @@ type APIFile struct {
 
 var (
 	apiFileTopRegex     = regexp.MustCompile("(?ms:^# @API ([^\n]+)\n((?:#[^\n]*\n)*)(.*))")
+	apiFileModuleRegex  = regexp.MustCompile("(?ms:^[ \t]+# @API ([^\n]+)\n((?:[ \t]*#[^\n]*\n)*)([ \t]*class\\s.*))")
 	apiFileMethodRegex  = regexp.MustCompile("(?m:^(\\s+# @API (?:[^\n]+)\n(?:\\s+#[^\n]*\n)+)\n)")
 	apiFileCommentStart = regexp.MustCompile("(?m:^\\s*#)")
 	apiFileModelStart   = regexp.MustCompile("(?m:^\\s*@model\\s+[^\\s]+\\s*?$)")
@@ -32,6 +33,10 @@ func ParseFile(filename string) (*APIFile, error) {
 	}
 	content := string(bytes)
 	topMatches := apiFileTopRegex.FindAllStringSubmatch(content, -1)
+	if len(topMatches) == 0 {
+		// Controllers in a module (like Quizzes::QuizzesApiController) indent the documentation with the class
+		topMatches = apiFileModuleRegex.FindAllStringSubmatch(content, 1)
+	}
 	if len(topMatches) != 1 {
 		if len(topMatches) == 0 {
 			return nil, nil
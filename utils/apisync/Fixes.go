@@ -1,6 +1,7 @@
 package apisync
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -38,3 +39,18 @@ func FixMissingTypes(models []*Model, methods []MethodAPIPair) {
 		fixCheckType(models, &methods[i].Method.ReturnType)
 	}
 }
+
+// CheckEndPoints makes sure every API call that changes something has an end point, since those cannot be fixed by
+// hand after the code is generated
+func CheckEndPoints(methods []MethodAPIPair) error {
+	missing := []string{}
+	for _, method := range methods {
+		if method.Method.Verb != "GET" && len(method.Method.EndPoint) == 0 {
+			missing = append(missing, ToGoIdentifier(fmt.Sprintf("%s_%s", method.APIName, method.Method.Name), true))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("No end point for %d API calls that are not GET requests (add overrides for them):\n  %s", len(missing), strings.Join(missing, "\n  "))
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ type Method struct {
 	Arguments   []MethodArgument
 	ReturnType  string
 	EndPoint    string
+	Verb        string
 }
 
 var (
@@ -31,6 +32,8 @@ var (
 	methodArgumentRegex        = regexp.MustCompile("^([^ ]+)(?: \\[([^\\]]+)\\]$)?")
 	methodEndPointRegex        = regexp.MustCompile("https://<canvas>/api/v1/([^\\s?\"]+)")
 	methodEndPointInvalidChars = regexp.MustCompile("['\"]")
+	methodVerbRegex            = regexp.MustCompile("(?:-X|--request)\\s+['\"]?([A-Za-z]+)")
+	methodDataRegex            = regexp.MustCompile("\\s(?:-F|-d|--form|--data[^\\s]*)\\s")
 )
 
 // ParseMethod parses a method from a string
@@ -42,6 +45,7 @@ func ParseMethod(str string) (*Method, error) {
 		Arguments:   []MethodArgument{},
 		ReturnType:  "interface{}",
 		EndPoint:    "",
+		Verb:        "",
 	}
 	for _, match := range matches {
 		switch match[1] {
@@ -147,6 +151,12 @@ func ParseMethod(str string) (*Method, error) {
 			if endpoint != nil {
 				m.EndPoint = methodEndPointInvalidChars.ReplaceAllLiteralString(endpoint[1], "")
 			}
+			if verb := methodVerbRegex.FindStringSubmatch(match[3]); verb != nil {
+				m.Verb = strings.ToUpper(verb[1])
+			} else if methodDataRegex.MatchString(match[3]) {
+				// curl sends a POST when it is given data without a method
+				m.Verb = "POST"
+			}
 			break
 		case "example_response", "response_field", "subtopic":
 			break
@@ -171,5 +181,9 @@ func ParseMethod(str string) (*Method, error) {
 			break
 		}
 	}
+	if len(m.Verb) == 0 {
+		// curl sends a GET when it is not given a method or data, so anything else has to be overridden
+		m.Verb = "GET"
+	}
 	return m, nil
 }
@@ -8,7 +8,7 @@ import (
 
 const (
 	scalarMethodFormat = `%s
-func (c *Canvas) %s(progress *task.Progress%s) (*%s, error) {
+func (c *Canvas) %s(ctx context.Context, progress *task.Progress%s) (*%s, error) {
 	endpoint := fmt.Sprintf("%s"%s)
 	params := map[string]interface{}{}%s
 	responseCtor := %s
@@ -17,13 +17,13 @@ func (c *Canvas) %s(progress *task.Progress%s) (*%s, error) {
 		res = obj.(*%s)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "%s", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
 }`
 	vectorMethodFormat = `%s
-func (c *Canvas) %s(progress *task.Progress%s) ([]%s, error) {
+func (c *Canvas) %s(ctx context.Context, progress *task.Progress%s) ([]%s, error) {
 	endpoint := fmt.Sprintf("%s"%s)
 	params := map[string]interface{}{}%s
 	responseCtor := %s
@@ -33,7 +33,7 @@ func (c *Canvas) %s(progress *task.Progress%s) ([]%s, error) {
 		res = append(res, arr...)
 		return nil
 	}
-	if err := c.Request(endpoint, params, progress, responseCtor, callback); err != nil {
+	if err := c.Request(ctx, "%s", endpoint, params, progress, responseCtor, callback); err != nil {
 		return nil, err
 	}
 	return res, nil
@@ -63,6 +63,7 @@ var (
 )
 
 func (m *Method) Write(apiName string, imports *[]string) (string, error) {
+	addImport(imports, "context")
 	addImport(imports, "fmt")
 	addImport(imports, "github.com/zachdeibert/canvas-sync/task")
 	name := ToGoIdentifier(fmt.Sprintf("%s_%s", apiName, m.Name), true)
@@ -80,16 +81,24 @@ func (m *Method) Write(apiName string, imports *[]string) (string, error) {
 		responseCtor = primitiveResponseCtor
 	}
 	endpointMatches := endpointArgRegex.FindAllStringSubmatchIndex(m.EndPoint, -1)
-	paramsCodes := make([]string, len(m.Arguments))
-	paramsArgs := make([]string, len(paramsCodes)+len(endpointMatches)+1)
-	for i, arg := range m.Arguments {
+	endpointArgs := map[string]bool{}
+	for _, match := range endpointMatches {
+		endpointArgs[ToGoIdentifier(m.EndPoint[match[2]:match[3]], false)] = true
+	}
+	paramsCodes := []string{}
+	paramsArgs := []string{""}
+	for _, arg := range m.Arguments {
 		name := ToGoIdentifier(arg.Name, false)
+		if endpointArgs[name] {
+			// The docs list some of the parts of the end point as arguments too, but they are only sent in the path
+			continue
+		}
 		if strings.HasPrefix(arg.Type, "[]") || strings.HasPrefix(arg.Type, "map[") {
-			paramsArgs[i+1] = fmt.Sprintf("%s %s", name, arg.Type)
-			paramsCodes[i] = fmt.Sprintf(paramCodeVector, name, name, arg.Name, name)
+			paramsArgs = append(paramsArgs, fmt.Sprintf("%s %s", name, arg.Type))
+			paramsCodes = append(paramsCodes, fmt.Sprintf(paramCodeVector, name, name, arg.Name, name))
 		} else {
-			paramsArgs[i+1] = fmt.Sprintf("%s *%s", name, arg.Type)
-			paramsCodes[i] = fmt.Sprintf(paramCodeScalar, name, arg.Name, name)
+			paramsArgs = append(paramsArgs, fmt.Sprintf("%s *%s", name, arg.Type))
+			paramsCodes = append(paramsCodes, fmt.Sprintf(paramCodeScalar, name, arg.Name, name))
 		}
 	}
 	start := 0
@@ -99,7 +108,7 @@ func (m *Method) Write(apiName string, imports *[]string) (string, error) {
 		endpointConstants[i] = m.EndPoint[start:match[0]]
 		start = match[1]
 		name := ToGoIdentifier(m.EndPoint[match[2]:match[3]], false)
-		paramsArgs[len(m.Arguments)+1+i] = fmt.Sprintf("%s string", name)
+		paramsArgs = append(paramsArgs, fmt.Sprintf("%s string", name))
 		endpointFormatArgs[i+1] = name
 	}
 	endpointConstants[len(endpointMatches)] = m.EndPoint[start:]
@@ -107,5 +116,5 @@ func (m *Method) Write(apiName string, imports *[]string) (string, error) {
 	paramsCode := strings.Join(paramsCodes, "")
 	endpointFormat := strings.Join(endpointConstants, "%s")
 	endpointFormatArgsStr := strings.Join(endpointFormatArgs, ", ")
-	return fmt.Sprintf(format, comment, name, paramArgsStr, resType, endpointFormat, endpointFormatArgsStr, paramsCode, fmt.Sprintf(responseCtor, resType), resType, resType), nil
+	return fmt.Sprintf(format, comment, name, paramArgsStr, resType, endpointFormat, endpointFormatArgsStr, paramsCode, fmt.Sprintf(responseCtor, resType), resType, resType, m.Verb), nil
 }
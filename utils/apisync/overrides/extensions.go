@@ -13,10 +13,11 @@ func (o *overrideContext) method(search string) *overrideContextMethods {
 				parent: o,
 				s0:     &(*o.s1)[i].Method.Arguments,
 				p0:     &(*o.s1)[i].APIName,
-				p1:     &(*o.s1)[i].Method.EndPoint,
-				p2:     &(*o.s1)[i].Method.ReturnType,
-				p3:     &(*o.s1)[i].Method.Description,
-				p4:     &(*o.s1)[i].Method.Name,
+				p1:     &(*o.s1)[i].Method.Verb,
+				p2:     &(*o.s1)[i].Method.EndPoint,
+				p3:     &(*o.s1)[i].Method.ReturnType,
+				p4:     &(*o.s1)[i].Method.Description,
+				p5:     &(*o.s1)[i].Method.Name,
 			}
 		}
 	}
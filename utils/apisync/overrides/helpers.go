@@ -210,6 +210,7 @@ type overrideContextMethods struct {
 	p2     *string
 	p3     *string
 	p4     *string
+	p5     *string
 }
 
 func (o *overrideContextMethods) done() *overrideContext {
@@ -325,7 +326,7 @@ func (o *overrideContextMethods) setAPIName(old, new string) *overrideContextMet
 	return o
 }
 
-func (o *overrideContextMethods) setMethodEndPoint(old, new string) *overrideContextMethods {
+func (o *overrideContextMethods) setMethodVerb(old, new string) *overrideContextMethods {
 	if !(*o.p1 == old) {
 		panic(fmt.Errorf("Error applying override patch: expected '%v' but got '%v'", old, *o.p1))
 	}
@@ -333,7 +334,7 @@ func (o *overrideContextMethods) setMethodEndPoint(old, new string) *overrideCon
 	return o
 }
 
-func (o *overrideContextMethods) setMethodReturnType(old, new string) *overrideContextMethods {
+func (o *overrideContextMethods) setMethodEndPoint(old, new string) *overrideContextMethods {
 	if !(*o.p2 == old) {
 		panic(fmt.Errorf("Error applying override patch: expected '%v' but got '%v'", old, *o.p2))
 	}
@@ -341,7 +342,7 @@ func (o *overrideContextMethods) setMethodReturnType(old, new string) *overrideC
 	return o
 }
 
-func (o *overrideContextMethods) setMethodDescription(old, new string) *overrideContextMethods {
+func (o *overrideContextMethods) setMethodReturnType(old, new string) *overrideContextMethods {
 	if !(*o.p3 == old) {
 		panic(fmt.Errorf("Error applying override patch: expected '%v' but got '%v'", old, *o.p3))
 	}
@@ -349,10 +350,18 @@ func (o *overrideContextMethods) setMethodDescription(old, new string) *override
 	return o
 }
 
-func (o *overrideContextMethods) setMethodName(old, new string) *overrideContextMethods {
+func (o *overrideContextMethods) setMethodDescription(old, new string) *overrideContextMethods {
 	if !(*o.p4 == old) {
 		panic(fmt.Errorf("Error applying override patch: expected '%v' but got '%v'", old, *o.p4))
 	}
 	*o.p4 = new
 	return o
 }
+
+func (o *overrideContextMethods) setMethodName(old, new string) *overrideContextMethods {
+	if !(*o.p5 == old) {
+		panic(fmt.Errorf("Error applying override patch: expected '%v' but got '%v'", old, *o.p5))
+	}
+	*o.p5 = new
+	return o
+}
@@ -54,5 +54,158 @@ func ApplyOverrides(models *[]*apisync.Model, methods *[]apisync.MethodAPIPair)
 		EnumValues:  []string{},
 	}).property("author").setType("string", "User").done().done().
 		model("DiscussionTopic").property("group_topic_children").setType("[]map[interface{}]interface{}", "[]interface{}").done().done().
-		model("CompletionRequirement").property("min_score").setType("int", "float64").done().done()
+		model("CompletionRequirement").property("min_score").setType("int", "float64").done().done().
+		model("Quiz").property("points_possible").setType("int", "float64").done().done().
+		model("QuizSubmission").property("end_at").setType("string", "time.Time").done().
+		property("finished_at").setType("string", "time.Time").done().
+		property("fudge_points").setType("int", "float64").done().
+		property("kept_score").setType("int", "float64").done().
+		property("score").setType("int", "float64").done().
+		property("score_before_regrade").setType("int", "float64").done().
+		property("started_at").setType("string", "time.Time").done().done().
+		method("QuizSubmissionsGetAllQuizSubmissions").setMethodEndPoint("", "courses/<course_id>/quizzes/<quiz_id>/submissions").
+		setMethodReturnType("interface{}", "QuizSubmissionsResponse").
+		arg("include").setType("string", "[]string").done().done().
+		method("QuizSubmissionsGetTheQuizSubmission").setMethodEndPoint("", "courses/<course_id>/quizzes/<quiz_id>/submission").
+		setMethodReturnType("interface{}", "QuizSubmissionsResponse").
+		arg("include").setType("string", "[]string").done().done().
+		method("QuizSubmissionsGetASingleQuizSubmission").setMethodEndPoint("", "courses/<course_id>/quizzes/<quiz_id>/submissions/<id>").
+		setMethodReturnType("interface{}", "QuizSubmissionsResponse").
+		arg("include").setType("string", "[]string").done().done().
+		method("QuizSubmissionsCreateTheQuizSubmissionStartAQuizTakingSession").setMethodReturnType("interface{}", "QuizSubmissionsResponse").done().
+		method("QuizSubmissionsCompleteTheQuizSubmissionTurnItIn").setMethodReturnType("interface{}", "QuizSubmissionsResponse").done().
+		model("CalendarEvent").property("all_day_date").setType("time.Time", "string").done().done().
+		method("CalendarEventsListCalendarEvents").setMethodEndPoint("", "calendar_events").
+		arg("type").setType("interface{}", "string").done().
+		arg("context_codes").setType("string", "[]string").done().done().
+		method("PlannerListPlannerNotes").setMethodEndPoint("", "planner_notes").
+		arg("context_codes").setType("string", "[]string").done().done().
+		model("Conversation").addProperties(apisync.ModelProperty{
+		Name:        "last_message_at",
+		Description: "",
+		Example:     "",
+		Type:        "time.Time",
+		EnumValues:  []string{},
+	}).addProperties(apisync.ModelProperty{
+		Name:        "messages",
+		Description: "",
+		Example:     "",
+		Type:        "[]ConversationMessage",
+		EnumValues:  []string{},
+	}).done().
+		method("ConversationsListConversations").setMethodEndPoint("", "conversations").
+		arg("scope").addEnumValues("sent").done().done().
+		method("ConversationsGetASingleConversation").setMethodEndPoint("", "conversations/<id>").
+		setMethodReturnType("interface{}", "Conversation").done().
+		model("Submission").addProperties(apisync.ModelProperty{
+		Name:        "rubric_assessment",
+		Description: "",
+		Example:     "",
+		Type:        "map[string]SubmissionRubricAssessment",
+		EnumValues:  []string{},
+	}).done().
+		method("AdminsMakeAnAccountAdmin").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/admins").done().
+		method("AdminsRemoveAccountAdmin").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "accounts/<account_id>/admins/<user_id>").done().
+		method("AssignmentExtensionsSetExtensionsForStudentAssignmentSubmissions").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/extensions").done().
+		method("AssignmentGroupsCreateAnAssignmentGroup").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignment_groups").done().
+		method("AssignmentGroupsEditAnAssignmentGroup").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/assignment_groups/<assignment_group_id>").done().
+		method("AssignmentGroupsDestroyAnAssignmentGroup").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/assignment_groups/<assignment_group_id>").done().
+		method("AssignmentsCreateAnAssignment").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments").done().
+		method("AssignmentsEditAnAssignment").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/assignments/<id>").done().
+		method("CalendarEventsCreateOrUpdateEventsDirectlyForACourseTimetable").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/calendar_events/timetable_events").done().
+		method("ContentExportsExportContent").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/content_exports").done().
+		method("CoursesCopyCourseContent").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/course_copy").done().
+		method("ContentMigrationsUpdateAContentMigration").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/content_migrations/<id>").done().
+		method("ConversationsCreateAConversation").setMethodVerb("GET", "POST").setMethodEndPoint("", "conversations").done().
+		method("ConversationsEditAConversation").setMethodVerb("GET", "PUT").setMethodEndPoint("", "conversations/<id>").done().
+		method("ConversationsMarkAllAsRead").setMethodVerb("GET", "POST").setMethodEndPoint("", "conversations/mark_all_as_read").done().
+		method("ConversationsDeleteAConversation").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "conversations/<id>").done().
+		method("ConversationsAddRecipients").setMethodVerb("GET", "POST").setMethodEndPoint("", "conversations/<id>/add_recipients").done().
+		method("ConversationsAddAMessage").setMethodVerb("GET", "POST").setMethodEndPoint("", "conversations/<id>/add_message").done().
+		method("ConversationsDeleteAMessage").setMethodVerb("GET", "POST").setMethodEndPoint("", "conversations/<id>/remove_messages").done().
+		method("CoursesCreateANewCourse").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/courses").done().
+		method("CoursesUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/files").done().
+		method("CoursesDeleteConcludeACourse").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<id>").done().
+		method("CoursesResetACourse").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/reset_content").done().
+		method("ContentSecurityPolicySettingsEnableDisableOrClearExplicitCSPSetting").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/csp_settings").done().
+		method("ContentSecurityPolicySettingsLockOrUnlockCurrentCSPSettingsForSubAccountsAndCourses").setMethodVerb("GET", "PUT").setMethodEndPoint("", "accounts/<account_id>/csp_settings/lock").done().
+		method("ContentSecurityPolicySettingsAddADomainToAccountWhitelist").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/csp_settings/domains").done().
+		method("ContentSecurityPolicySettingsAddMultipleDomainsToAccountWhitelist").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/csp_settings/domains/batch_create").done().
+		method("ContentSecurityPolicySettingsRemoveADomainFromAccountWhitelist").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "accounts/<account_id>/csp_settings/domains").done().
+		method("CustomGradebookColumnsUpdateColumnData").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_columns/<id>/data/<user_id>").done().
+		method("CustomGradebookColumnsBulkUpdateColumnData").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_column_data").done().
+		method("CustomGradebookColumnsCreateACustomGradebookColumn").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_columns").done().
+		method("CustomGradebookColumnsUpdateACustomGradebookColumn").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_columns/<id>").done().
+		method("CustomGradebookColumnsDeleteACustomGradebookColumn").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_columns/<id>").done().
+		method("CustomGradebookColumnsReorderCustomColumns").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/custom_gradebook_columns/reorder").done().
+		method("DeveloperKeyAccountBindingsCreateADeveloperKeyAccountBinding").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/developer_keys/<developer_key_id>/developer_key_account_bindings").done().
+		method("SISIntegrationDisableAssignmentsCurrentlyEnabledForGradeExportToSIS").setMethodVerb("GET", "PUT").setMethodEndPoint("", "/api/sis/courses/<course_id>/disable_post_to_sis").done().
+		method("DiscussionTopicsReorderPinnedTopics").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/discussion_topics/reorder").done().
+		method("EPubExportsCreateEPubExport").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/epub_exports").done().
+		method("FeatureFlagsSetFeatureFlag").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/features/flags/<feature>").done().
+		method("FeatureFlagsRemoveFeatureFlag").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/features/flags/<feature>").done().
+		method("FilesUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "folders/<folder_id>/files").done().
+		method("GradingPeriodsUpdateASingleGradingPeriod").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/grading_periods/<id>").done().
+		method("GradingPeriodsDeleteAGradingPeriod").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/grading_periods/<id>").done().
+		method("GroupCategoriesCreateAGroupCategory").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/group_categories").done().
+		method("GroupsUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "groups/<group_id>/files").done().
+		method("ImageSearchConfirmImageSelection").setMethodVerb("GET", "POST").setMethodEndPoint("", "image_selection/<id>").done().
+		method("LatePolicyCreateALatePolicy").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<id>/late_policy").done().
+		method("LatePolicyPatchALatePolicy").setMethodVerb("GET", "PATCH").setMethodEndPoint("", "courses/<id>/late_policy").done().
+		method("MediaObjectsUpdateMediaObject").setMethodVerb("GET", "PUT").setMethodEndPoint("", "media_objects/<media_object_id>").done().
+		method("ModeratedGradingSelectStudentsForModeration").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/moderated_students").done().
+		method("NotificationPreferencesUpdateAPreference").setMethodVerb("GET", "PUT").setMethodEndPoint("", "users/self/communication_channels/<communication_channel_id>/notification_preferences/<notification>").done().
+		method("NotificationPreferencesUpdatePreferencesByCategory").setMethodVerb("GET", "PUT").setMethodEndPoint("", "users/self/communication_channels/<communication_channel_id>/notification_preference_categories/<category>").done().
+		method("NotificationPreferencesUpdateMultiplePreferences").setMethodVerb("GET", "PUT").setMethodEndPoint("", "users/self/communication_channels/<communication_channel_id>/notification_preferences").done().
+		method("OutcomeImportsImportOutcomes").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/outcome_imports").done().
+		method("PeerReviewsCreatePeerReview").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/submissions/<submission_id>/peer_reviews").done().
+		method("PeerReviewsDeletePeerReview").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/submissions/<submission_id>/peer_reviews").done().
+		method("PlannerUpdateAPlannerNote").setMethodVerb("GET", "PUT").setMethodEndPoint("", "planner_notes/<id>").done().
+		method("PlannerCreateAPlannerNote").setMethodVerb("GET", "POST").setMethodEndPoint("", "planner_notes").done().
+		method("PlannerDeleteAPlannerNote").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "planner_notes/<id>").done().
+		method("PlannerUpdateAPlannerOverride").setMethodVerb("GET", "PUT").setMethodEndPoint("", "planner/overrides/<id>").done().
+		method("PlannerCreateAPlannerOverride").setMethodVerb("GET", "POST").setMethodEndPoint("", "planner/overrides").done().
+		method("PlannerDeleteAPlannerOverride").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "planner/overrides/<id>").done().
+		method("ModeratedGradingBulkSelectProvisionalGrades").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/provisional_grades/bulk_select").done().
+		method("ModeratedGradingSelectProvisionalGrade").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/provisional_grades/<provisional_grade_id>/select").done().
+		method("LoginsEditAUserLogin").setMethodVerb("GET", "PUT").setMethodEndPoint("", "accounts/<account_id>/logins/<id>").done().
+		method("RolesDeactivateARole").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "accounts/<account_id>/roles/<id>").done().
+		method("RolesActivateARole").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/roles/<id>/activate").done().
+		method("RubricsCreateASingleRubricAssessment").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/rubric_associations/<rubric_association_id>/rubric_assessments").done().
+		method("RubricsUpdateASingleRubricAssessment").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/rubric_associations/<rubric_association_id>/rubric_assessments/<id>").done().
+		method("RubricsDeleteASingleRubricAssessment").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/rubric_associations/<rubric_association_id>/rubric_assessments/<id>").done().
+		method("RubricsCreateARubricAssociation").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/rubric_associations").done().
+		method("RubricsUpdateARubricAssociation").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/rubric_associations/<id>").done().
+		method("RubricsDeleteARubricAssociation").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/rubric_associations/<id>").done().
+		method("RubricsCreateASingleRubric").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/rubrics").done().
+		method("RubricsUpdateASingleRubric").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/rubrics/<id>").done().
+		method("RubricsDeleteASingleRubric").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/rubrics/<id>").done().
+		method("SectionsCreateCourseSection").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/sections").done().
+		method("SectionsEditASection").setMethodVerb("GET", "PUT").setMethodEndPoint("", "sections/<id>").done().
+		method("SectionsDeleteASection").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "sections/<id>").done().
+		method("ServicesStartKalturaSession").setMethodVerb("GET", "POST").setMethodEndPoint("", "services/kaltura_session").done().
+		method("SISImportsImportSISData").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/sis_imports").done().
+		method("AccountsCreateANewSubAccount").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/sub_accounts").done().
+		method("AccountsDeleteASubAccount").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "accounts/<account_id>/sub_accounts/<id>").done().
+		method("SubmissionCommentsUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/submissions/<user_id>/comments/files").done().
+		method("SubmissionsUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/submissions/<user_id>/files").done().
+		method("SubmissionsSubmitAnAssignment").setMethodVerb("GET", "POST").setMethodEndPoint("", "courses/<course_id>/assignments/<assignment_id>/submissions").done().
+		method("EnrollmentTermsCreateEnrollmentTerm").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/terms").done().
+		method("EnrollmentTermsUpdateEnrollmentTerm").setMethodVerb("GET", "PUT").setMethodEndPoint("", "accounts/<account_id>/terms/<id>").done().
+		method("EnrollmentTermsDeleteEnrollmentTerm").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "accounts/<account_id>/terms/<id>").done().
+		method("FilesSetUsageRights").setMethodVerb("GET", "PUT").setMethodEndPoint("", "courses/<course_id>/usage_rights").done().
+		method("FilesRemoveUsageRights").setMethodVerb("GET", "DELETE").setMethodEndPoint("", "courses/<course_id>/usage_rights").done().
+		method("UsersUploadAFile").setMethodVerb("GET", "POST").setMethodEndPoint("", "users/<user_id>/files").done().
+		method("UsersCreateAUser").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/users").done().
+		method("UsersSelfRegisterAUser").setMethodVerb("GET", "POST").setMethodEndPoint("", "accounts/<account_id>/self_registration").done().
+		method("DiscussionTopicsMarkTopicAsRead").setMethodVerb("GET", "PUT").done().
+		method("DiscussionTopicsMarkTopicAsUnread").setMethodVerb("GET", "DELETE").done().
+		method("DiscussionTopicsMarkAllEntriesAsRead").setMethodVerb("GET", "PUT").done().
+		method("DiscussionTopicsMarkAllEntriesAsUnread").setMethodVerb("GET", "DELETE").done().
+		method("DiscussionTopicsMarkEntryAsRead").setMethodVerb("GET", "PUT").done().
+		method("DiscussionTopicsMarkEntryAsUnread").setMethodVerb("GET", "DELETE").done().
+		method("SubmissionsMarkSubmissionAsRead").setMethodVerb("GET", "PUT").done().
+		method("SubmissionsMarkSubmissionAsUnread").setMethodVerb("GET", "DELETE").done().
+		method("ModulesMarkModuleItemRead").setMethodVerb("GET", "POST").done().
+		method("ModulesMarkModuleItemAsDoneNotDone").setMethodVerb("GET", "PUT").done()
 }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/zachdeibert/canvas-sync/canvas/canvastest"
+)
+
+func main() {
+	fixtures := flag.String("fixtures", "", "folder of recorded fixtures to serve instead of the default fixtures")
+	record := flag.String("record", "", "URL of a Canvas instance to proxy, recording the responses into the fixtures folder")
+	listen := flag.String("listen", "127.0.0.1:8080", "address to listen on when recording")
+	flag.Parse()
+	if len(*record) > 0 {
+		if len(*fixtures) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: --record needs a --fixtures folder to record into.")
+			os.Exit(1)
+		}
+		if err := recordFixtures(*record, *fixtures, *listen); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	f := canvastest.DefaultFixtures()
+	if len(*fixtures) > 0 {
+		var err error
+		if f, err = canvastest.LoadFixtures(*fixtures); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	s := canvastest.CreateServer(f)
+	defer s.Close()
+	fmt.Printf("Serving %d fixtures at %s with token %s\n", len(f.Responses), s.GetBaseURL(), canvastest.Token)
+	waitForInterrupt()
+}
+
+// recordFixtures proxies requests to a Canvas instance and records the responses
+func recordFixtures(target string, dir string, listen string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return err
+	}
+	recorder, err := canvastest.CreateRecorder(dir, target, nil)
+	if err != nil {
+		return err
+	}
+	local := fmt.Sprintf("http://%s", listen)
+	remote := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	proxy := httputil.NewSingleHostReverseProxy(u)
+	proxy.Transport = recorder
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = u.Host
+		// The response bodies are rewritten, so they can't be compressed
+		req.Header.Del("Accept-Encoding")
+	}
+	proxy.ModifyResponse = func(res *http.Response) error {
+		// Send the client back through the proxy when following links and redirects
+		for _, h := range []string{"Link", "Location"} {
+			if v := res.Header.Get(h); len(v) > 0 {
+				res.Header.Set(h, strings.ReplaceAll(v, remote, local))
+			}
+		}
+		return nil
+	}
+	fmt.Printf("Recording %s into %s; sync against %s/ and interrupt when done\n", remote, dir, local)
+	go func() {
+		if err := http.ListenAndServe(listen, proxy); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+	waitForInterrupt()
+	return nil
+}
+
+func waitForInterrupt() {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	<-sig
+}